@@ -17,8 +17,11 @@ package cmd
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/Gui774ume/fsprobe/pkg/model"
+	"github.com/Gui774ume/fsprobe/pkg/utils"
 )
 
 type EventsValue struct {
@@ -36,26 +39,11 @@ func (ev *EventsValue) String() string {
 }
 
 func (ev *EventsValue) Set(val string) error {
-	switch val {
-	case "open":
-		*ev.events = append(*ev.events, model.Open)
-	case "mkdir":
-		*ev.events = append(*ev.events, model.Mkdir)
-	case "link":
-		*ev.events = append(*ev.events, model.Link)
-	case "rename":
-		*ev.events = append(*ev.events, model.Rename)
-	case "setattr":
-		*ev.events = append(*ev.events, model.SetAttr)
-	case "unlink":
-		*ev.events = append(*ev.events, model.Unlink)
-	case "rmdir":
-		*ev.events = append(*ev.events, model.Rmdir)
-	case "modify":
-		*ev.events = append(*ev.events, model.Modify)
-	default:
-		return fmt.Errorf("unknown event type: %v", val)
+	evt, err := model.ParseEventName(val)
+	if err != nil {
+		return err
 	}
+	*ev.events = append(*ev.events, evt)
 	return nil
 }
 
@@ -103,3 +91,145 @@ func (drm *DentryResolutionModeValue) Set(val string) error {
 func (drm *DentryResolutionModeValue) Type() string {
 	return "string"
 }
+
+// BackpressurePolicyValue - Flag value used to parse a backpressure policy
+type BackpressurePolicyValue struct {
+	policy *model.BackpressurePolicy
+}
+
+func NewBackpressurePolicyValue(policy *model.BackpressurePolicy) *BackpressurePolicyValue {
+	return &BackpressurePolicyValue{
+		policy: policy,
+	}
+}
+
+func (bpv *BackpressurePolicyValue) String() string {
+	return bpv.policy.String()
+}
+
+func (bpv *BackpressurePolicyValue) Set(val string) error {
+	policy, err := model.ParseBackpressurePolicy(val)
+	if err != nil {
+		return err
+	}
+	*bpv.policy = policy
+	return nil
+}
+
+func (bpv *BackpressurePolicyValue) Type() string {
+	return "string"
+}
+
+// ByteSizeValue - Flag value used to parse a size in bytes, with an optional K, M or G suffix
+type ByteSizeValue struct {
+	size *int64
+}
+
+func NewByteSizeValue(size *int64) *ByteSizeValue {
+	return &ByteSizeValue{
+		size: size,
+	}
+}
+
+func (bsv *ByteSizeValue) String() string {
+	return strconv.FormatInt(*bsv.size, 10)
+}
+
+func (bsv *ByteSizeValue) Set(val string) error {
+	value := strings.ToUpper(strings.TrimSpace(val))
+	value = strings.TrimSuffix(value, "B")
+	multiplier := int64(1)
+	if len(value) > 0 {
+		switch value[len(value)-1] {
+		case 'K':
+			multiplier = 1 << 10
+		case 'M':
+			multiplier = 1 << 20
+		case 'G':
+			multiplier = 1 << 30
+		}
+		if multiplier > 1 {
+			value = value[:len(value)-1]
+		}
+	}
+	size, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || size < 0 {
+		return fmt.Errorf("invalid size: %v", val)
+	}
+	*bsv.size = size * multiplier
+	return nil
+}
+
+func (bsv *ByteSizeValue) Type() string {
+	return "size"
+}
+
+// Uint32SliceValue - Flag value used to parse a list of uint32, such as pids, uids or gids
+type Uint32SliceValue struct {
+	values *[]uint32
+}
+
+func NewUint32SliceValue(values *[]uint32) *Uint32SliceValue {
+	return &Uint32SliceValue{
+		values: values,
+	}
+}
+
+func (usv *Uint32SliceValue) String() string {
+	return fmt.Sprintf("%v", *usv.values)
+}
+
+func (usv *Uint32SliceValue) Set(val string) error {
+	for _, elem := range strings.Split(val, ",") {
+		value, err := strconv.ParseUint(strings.TrimSpace(elem), 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid value: %v", elem)
+		}
+		*usv.values = append(*usv.values, uint32(value))
+	}
+	return nil
+}
+
+func (usv *Uint32SliceValue) Type() string {
+	return "uint32"
+}
+
+// CgroupIDsValue - Flag value used to parse a list of cgroup IDs. A cgroup can be provided either with its ID, or
+// with the path to its cgroup v2 directory.
+type CgroupIDsValue struct {
+	ids *[]uint64
+}
+
+func NewCgroupIDsValue(ids *[]uint64) *CgroupIDsValue {
+	return &CgroupIDsValue{
+		ids: ids,
+	}
+}
+
+func (civ *CgroupIDsValue) String() string {
+	return fmt.Sprintf("%v", *civ.ids)
+}
+
+func (civ *CgroupIDsValue) Set(val string) error {
+	for _, elem := range strings.Split(val, ",") {
+		elem = strings.TrimSpace(elem)
+		if strings.HasPrefix(elem, "/") {
+			id, err := utils.GetCgroupID(elem)
+			if err != nil {
+				return err
+			}
+			*civ.ids = append(*civ.ids, id)
+			continue
+		}
+		id, err := strconv.ParseUint(elem, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid cgroup: %v", elem)
+		}
+		*civ.ids = append(*civ.ids, id)
+	}
+	return nil
+}
+
+func (civ *CgroupIDsValue) Type() string {
+	return "cgroup"
+}
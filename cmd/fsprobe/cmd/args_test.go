@@ -0,0 +1,65 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import "testing"
+
+func TestByteSizeValue(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+		invalid  bool
+	}{
+		{input: "0", expected: 0},
+		{input: "1024", expected: 1024},
+		{input: "10K", expected: 10 << 10},
+		{input: "10k", expected: 10 << 10},
+		{input: "10KB", expected: 10 << 10},
+		{input: "100M", expected: 100 << 20},
+		{input: "100mb", expected: 100 << 20},
+		{input: "2G", expected: 2 << 30},
+		{input: " 5M ", expected: 5 << 20},
+		{input: "512B", expected: 512},
+		{input: "", invalid: true},
+		{input: "M", invalid: true},
+		{input: "-1", invalid: true},
+		{input: "-1K", invalid: true},
+		{input: "1.5G", invalid: true},
+		{input: "10T", invalid: true},
+		{input: "ten", invalid: true},
+		{input: "10 M", invalid: true},
+	}
+	for _, tt := range tests {
+		size := int64(42)
+		err := NewByteSizeValue(&size).Set(tt.input)
+		if tt.invalid {
+			if err == nil {
+				t.Errorf("%q: expected an error, got %d", tt.input, size)
+			}
+			if size != 42 {
+				t.Errorf("%q: the size was modified on error: %d", tt.input, size)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %v", tt.input, err)
+			continue
+		}
+		if size != tt.expected {
+			t.Errorf("%q: expected %d, got %d", tt.input, tt.expected, size)
+		}
+	}
+}
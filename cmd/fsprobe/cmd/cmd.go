@@ -28,6 +28,7 @@ var FSProbeCmd = &cobra.Command{
 FSProbe relies on eBPF to capture file system events on dentry kernel structures.
 More information about the project can be found on github: https://github.com/Gui774ume/fsprobe`,
 	RunE:    runFSProbeCmd,
+	Args:    cobra.ArbitraryArgs,
 	Example: "sudo fsprobe /tmp",
 }
 
@@ -35,12 +36,12 @@ More information about the project can be found on github: https://github.com/Gu
 var options CLIOptions
 
 func init() {
-	FSProbeCmd.Flags().Var(
+	FSProbeCmd.PersistentFlags().Var(
 		NewDentryResolutionModeValue(&options.FSOptions.DentryResolutionMode),
 		"dentry-resolution-mode",
 		`In-kernel dentry resolution mode. Can be either "fragments",
 "single_fragment" or "perf_buffer"`)
-	FSProbeCmd.Flags().BoolVarP(
+	FSProbeCmd.PersistentFlags().BoolVarP(
 		&options.FSOptions.Recursive,
 		"recursive",
 		"r",
@@ -50,14 +51,14 @@ Watches will be set up recursively to an unlimited depth.
 Symbolic links are not traversed. Newly created subdirectories
 will also be watched. When this option is not provided, only
 the immediate children of a provided directory are watched`)
-	FSProbeCmd.Flags().BoolVar(
+	FSProbeCmd.PersistentFlags().BoolVar(
 		&options.FSOptions.PathsFiltering,
 		"paths-filtering",
 		true,
 		`When activated, FSProbe will only notify events on the paths 
 provided to the Watch function. When deactivated, FSProbe
 will notify events on the entire file system`)
-	FSProbeCmd.Flags().BoolVar(
+	FSProbeCmd.PersistentFlags().BoolVar(
 		&options.FSOptions.FollowRenames,
 		"follow",
 		true,
@@ -65,21 +66,54 @@ will notify events on the entire file system`)
 initially in a watched directory and were moved to a location
 that is not necessarily watched. In other words, files are followed
 even after a move`)
-	FSProbeCmd.Flags().VarP(
+	FSProbeCmd.PersistentFlags().BoolVar(
+		&options.FSOptions.TrackExecs,
+		"track-execs",
+		false,
+		`Traces the execve calls to resolve the executable and the
+arguments of the processes that exit before their first
+event is handled`)
+	FSProbeCmd.PersistentFlags().BoolVar(
+		&options.FSOptions.ContainerPaths,
+		"container-paths",
+		false,
+		`Adds the path of the files in the mount namespace of the process,
+and their path on the host (overlayfs upper or lower directory),
+to the events of the processes of other mount namespaces`)
+	FSProbeCmd.PersistentFlags().VarP(
 		NewEventsValue(&options.FSOptions.Events),
 		"event",
 		"e",
 		`Listens for specific event(s) only. This option can be specified
 more than once. If omitted, all the events will be activated except the modify one.
-Available options: open, mkdir, link, rename, setattr, unlink,
-rmdir, modify`)
-	FSProbeCmd.Flags().IntVarP(
+Available options: open, mkdir, link, symlink, rename, setattr,
+unlink, rmdir, modify`)
+	FSProbeCmd.PersistentFlags().IntVarP(
 		&options.FSOptions.UserSpaceChanSize,
 		"chan-size",
 		"s",
 		1000,
 		"User space channel size")
-	FSProbeCmd.Flags().IntVar(
+	FSProbeCmd.Flags().Var(
+		NewBackpressurePolicyValue(&options.FSOptions.Backpressure),
+		"backpressure",
+		`Behavior of the user space channel when it is full. Can be
+either "block", "drop", "drop_oldest" or "spill". Dropped
+events are reported separately from the events lost in the
+perf ring buffers`)
+	FSProbeCmd.Flags().StringVar(
+		&options.FSOptions.SpillDir,
+		"spill-dir",
+		"",
+		`Directory of the on-disk queue of the "spill" backpressure
+policy. Defaults to the temporary directory`)
+	FSProbeCmd.Flags().Int64Var(
+		&options.FSOptions.SpillMaxSize,
+		"spill-max-size",
+		64<<20,
+		`Maximum size in bytes of the on-disk queue of the "spill"
+backpressure policy`)
+	FSProbeCmd.PersistentFlags().IntVar(
 		&options.FSOptions.PerfBufferSize,
 		"perf-buffer-size",
 		128,
@@ -91,12 +125,148 @@ communication`)
 		"f",
 		"table",
 		`Defines the output format.
-Options are: table, json, none`)
+Options are: table, json (newline delimited), none`)
 	FSProbeCmd.Flags().StringVarP(
 		&options.OutputFilePath,
 		"output",
 		"o",
 		"",
 		`Outputs events to the provided file rather than
-stdout`)
+stdout. The file is created if needed, and events are
+appended to it. Events can also be sent to syslog
+("syslog:///dev/log", "syslog+udp://host:514",
+"syslog+tcp://host:601") or to journald ("journald://")`)
+	FSProbeCmd.Flags().Var(
+		NewByteSizeValue(&options.Rotation.MaxSize),
+		"rotate-size",
+		`Rotates the output file once it reaches the provided size
+(K, M and G suffixes are supported). Disabled by default`)
+	FSProbeCmd.Flags().DurationVar(
+		&options.Rotation.MaxAge,
+		"rotate-age",
+		0,
+		`Rotates the output file once it is older than the provided
+duration. Disabled by default`)
+	FSProbeCmd.Flags().IntVar(
+		&options.Rotation.Keep,
+		"rotate-keep",
+		0,
+		`Number of rotated output files to keep, the oldest ones are
+removed. 0 keeps all the rotated files`)
+	FSProbeCmd.Flags().BoolVar(
+		&options.Rotation.Compress,
+		"rotate-compress",
+		false,
+		`Compresses the rotated output files with gzip`)
+	FSProbeCmd.PersistentFlags().StringSliceVar(
+		&options.FSOptions.ExcludePaths,
+		"exclude-path",
+		nil,
+		`Excludes the provided paths, along with their subtrees, from
+the watched paths. This option can be specified more than once`)
+	FSProbeCmd.PersistentFlags().StringSliceVarP(
+		&options.FSOptions.ExcludePatterns,
+		"exclude",
+		"x",
+		nil,
+		`Excludes the paths matching the provided glob pattern, along
+with their subtrees, from the watched paths. Patterns without
+a "/" are matched against file names (".git", "node_modules",
+"*.cache"), the others against entire paths. This option can
+be specified more than once`)
+	FSProbeCmd.PersistentFlags().Var(
+		NewUint32SliceValue(&options.FSOptions.AllowProcesses.Pids),
+		"allow-pid",
+		`Only notifies the events of the provided pids (or tids).
+This option can be specified more than once`)
+	FSProbeCmd.PersistentFlags().Var(
+		NewUint32SliceValue(&options.FSOptions.DenyProcesses.Pids),
+		"deny-pid",
+		`Drops the events of the provided pids (or tids) in kernel
+space. This option can be specified more than once`)
+	FSProbeCmd.PersistentFlags().StringSliceVar(
+		&options.FSOptions.AllowProcesses.Comms,
+		"allow-comm",
+		nil,
+		`Only notifies the events of the provided process names.
+This option can be specified more than once`)
+	FSProbeCmd.PersistentFlags().StringSliceVar(
+		&options.FSOptions.DenyProcesses.Comms,
+		"deny-comm",
+		nil,
+		`Drops the events of the provided process names in kernel
+space. This option can be specified more than once`)
+	FSProbeCmd.PersistentFlags().Var(
+		NewUint32SliceValue(&options.FSOptions.AllowProcesses.UIDs),
+		"allow-uid",
+		`Only notifies the events of the provided uids. This option
+can be specified more than once`)
+	FSProbeCmd.PersistentFlags().Var(
+		NewUint32SliceValue(&options.FSOptions.DenyProcesses.UIDs),
+		"deny-uid",
+		`Drops the events of the provided uids in kernel space.
+This option can be specified more than once`)
+	FSProbeCmd.PersistentFlags().Var(
+		NewUint32SliceValue(&options.FSOptions.AllowProcesses.GIDs),
+		"allow-gid",
+		`Only notifies the events of the provided gids. This option
+can be specified more than once`)
+	FSProbeCmd.PersistentFlags().Var(
+		NewUint32SliceValue(&options.FSOptions.DenyProcesses.GIDs),
+		"deny-gid",
+		`Drops the events of the provided gids in kernel space.
+This option can be specified more than once`)
+	FSProbeCmd.PersistentFlags().Var(
+		NewCgroupIDsValue(&options.FSOptions.AllowProcesses.CgroupIDs),
+		"allow-cgroup",
+		`Only notifies the events of the provided cgroups. A cgroup
+is either a cgroup v2 ID or the path to its directory in the
+cgroup v2 hierarchy. This option can be specified more than once`)
+	FSProbeCmd.PersistentFlags().Var(
+		NewCgroupIDsValue(&options.FSOptions.DenyProcesses.CgroupIDs),
+		"deny-cgroup",
+		`Drops the events of the provided cgroups in kernel space.
+A cgroup is either a cgroup v2 ID or the path to its directory
+in the cgroup v2 hierarchy. This option can be specified more
+than once`)
+	FSProbeCmd.Flags().StringVar(
+		&options.Filter,
+		"filter",
+		"",
+		`Only outputs the events matching the provided expression.
+Example: 'uid != 0 && comm != "dpkg" && event in (open, rename)
+&& flags contains OWRONLY'. Available operators: ==, !=, <,
+<=, >, >=, in, contains, &&, ||, !`)
+	FSProbeCmd.PersistentFlags().DurationVar(
+		&options.StatsInterval,
+		"stats-interval",
+		0,
+		`Interval at which a summary of the statistics of FSProbe is
+logged to stderr (event counters, parse and resolution
+failures, lost events, resolver cache and channel fill
+levels). Disabled by default`)
+	FSProbeCmd.PersistentFlags().StringVar(
+		&options.MetricsListen,
+		"metrics-listen",
+		"",
+		`Address on which Prometheus metrics are served at /metrics
+(for example localhost:9090). Disabled by default`)
+	FSProbeCmd.PersistentFlags().BoolVar(
+		&options.FSOptions.RuntimeCompilation,
+		"runtime-compilation",
+		false,
+		`Compiles the eBPF programs at runtime against the headers
+of the running kernel (requires clang and llc). The embedded
+eBPF programs are used if the compilation fails`)
+	FSProbeCmd.PersistentFlags().StringVar(
+		&options.FSOptions.RuntimeCompilationCacheDir,
+		"runtime-compilation-cache-dir",
+		"/var/cache/fsprobe",
+		"Directory used to cache the eBPF programs compiled at runtime")
+	FSProbeCmd.PersistentFlags().StringVar(
+		&options.FSOptions.KernelHeadersPath,
+		"kernel-headers",
+		"",
+		`Path to the headers of the running kernel. Defaults to
+/lib/modules/$(uname -r)/build`)
 }
@@ -18,8 +18,11 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -51,8 +54,19 @@ func runFSProbeCmd(cmd *cobra.Command, args []string) error {
 		logrus.Fatalf("couldn't start watching the filesystem: %v", err)
 	}
 
-	// 5) Wait until interrupt signal
+	// 5) Periodically log the statistics of FSProbe and serve the metrics
+	stopStats := logStats(probe, options.StatsInterval)
+	metricsServer, err := serveMetrics(probe, options.MetricsListen)
+	if err != nil {
+		logrus.Fatalf("couldn't serve metrics: %v", err)
+	}
+
+	// 6) Wait until interrupt signal
 	wait()
+	close(stopStats)
+	if metricsServer != nil {
+		_ = metricsServer.Close()
+	}
 
 	// Stop fsprobe
 	if err := probe.Stop(); err != nil {
@@ -72,9 +86,58 @@ func sanitizeOptions(args []string) error {
 	if len(args) > 0 {
 		options.FSOptions.PathsFiltering = true
 	}
+	rotation := options.Rotation
+	if (options.OutputFilePath == "" || isOutputURI(options.OutputFilePath)) && (rotation.MaxSize > 0 || rotation.MaxAge > 0 || rotation.Keep > 0 || rotation.Compress) {
+		return errors.New("output file rotation requires an output file")
+	}
+	if rotation.MaxAge < 0 || rotation.Keep < 0 {
+		return errors.New("rotate-age and rotate-keep must be positive")
+	}
 	return nil
 }
 
+// logStats - Logs the statistics of FSProbe at the provided interval, until the returned channel is closed
+func logStats(probe *fsprobe.FSProbe, interval time.Duration) chan struct{} {
+	stop := make(chan struct{})
+	if interval <= 0 {
+		return stop
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				logrus.Infof("stats: %s", probe.Stats())
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return stop
+}
+
+// serveMetrics - Serves the metrics of FSProbe in the Prometheus text format on the provided address
+func serveMetrics(probe *fsprobe.FSProbe, addr string) (*http.Server, error) {
+	if addr == "" {
+		return nil, nil
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", probe.MetricsHandler())
+	server := &http.Server{Handler: mux}
+	go func() {
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			logrus.Errorf("metrics server stopped: %v", err)
+		}
+	}()
+	logrus.Infof("serving metrics on http://%s/metrics", listener.Addr())
+	return server, nil
+}
+
 // wait - Waits until an interrupt or kill signal is sent
 func wait() {
 	sig := make(chan os.Signal, 1)
@@ -0,0 +1,190 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// defaultJournalSocket - Socket of the native journald protocol
+const defaultJournalSocket = "/run/systemd/journal/socket"
+
+// JournaldOutput - Sends the events to journald with the native journal protocol, along with structured fields
+type JournaldOutput struct {
+	socket    string
+	conn      *net.UnixConn
+	formatter *eventFormatter
+}
+
+// NewJournaldOutput - Returns a journald output writer. The MESSAGE field of the events is formatted with the
+// provided format.
+func NewJournaldOutput(socket string, format string) (*JournaldOutput, error) {
+	jo := &JournaldOutput{
+		socket:    socket,
+		formatter: newEventFormatter(format),
+	}
+	if err := jo.connect(); err != nil {
+		return nil, err
+	}
+	return jo, nil
+}
+
+// connect - Connects to the journald socket
+func (jo *JournaldOutput) connect() error {
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: jo.socket, Net: "unixgram"})
+	if err != nil {
+		return errors.Wrapf(err, "couldn't connect to journald socket %s", jo.socket)
+	}
+	jo.conn = conn
+	return nil
+}
+
+// Write - Write the event to journald
+func (jo *JournaldOutput) Write(event *model.FSEvent) error {
+	msg, err := jo.formatter.format(event)
+	if err != nil {
+		return err
+	}
+	var b bytes.Buffer
+	appendJournalField(&b, "MESSAGE", msg)
+	appendJournalField(&b, "PRIORITY", strconv.Itoa(eventSeverity(event)))
+	appendJournalField(&b, "SYSLOG_IDENTIFIER", syslogAppName)
+	appendJournalField(&b, "FSPROBE_EVENT", string(event.EventType))
+	appendJournalField(&b, "FSPROBE_TIMESTAMP", strconv.FormatInt(event.Timestamp.UnixNano()/1000, 10))
+	appendJournalField(&b, "FSPROBE_PID", strconv.FormatUint(uint64(event.Pid), 10))
+	appendJournalField(&b, "FSPROBE_TID", strconv.FormatUint(uint64(event.Tid), 10))
+	appendJournalField(&b, "FSPROBE_UID", strconv.FormatUint(uint64(event.UID), 10))
+	appendJournalField(&b, "FSPROBE_GID", strconv.FormatUint(uint64(event.GID), 10))
+	appendJournalField(&b, "FSPROBE_COMM", event.Comm)
+	appendJournalField(&b, "FSPROBE_PPID", strconv.FormatUint(uint64(event.Ppid), 10))
+	if event.Exe != "" {
+		appendJournalField(&b, "FSPROBE_EXE", event.Exe)
+	}
+	if len(event.Argv) > 0 {
+		appendJournalField(&b, "FSPROBE_CMDLINE", strings.Join(event.Argv, " "))
+	}
+	if event.Cwd != "" {
+		appendJournalField(&b, "FSPROBE_CWD", event.Cwd)
+	}
+	if event.LoginUID != model.LoginUIDUnset {
+		appendJournalField(&b, "FSPROBE_LOGIN_UID", strconv.FormatUint(uint64(event.LoginUID), 10))
+	}
+	if event.CgroupPath != "" {
+		appendJournalField(&b, "FSPROBE_CGROUP", event.CgroupPath)
+	}
+	if event.ContainerID != "" {
+		appendJournalField(&b, "FSPROBE_CONTAINER_ID", event.ContainerID)
+	}
+	if event.SrcContainerPath != "" {
+		appendJournalField(&b, "FSPROBE_CONTAINER_PATH", event.SrcContainerPath)
+	}
+	if event.SrcHostPath != "" {
+		appendJournalField(&b, "FSPROBE_HOST_PATH", event.SrcHostPath)
+	}
+	appendJournalField(&b, "FSPROBE_RETVAL", strconv.Itoa(int(event.Retval)))
+	if event.Retval < 0 {
+		appendJournalField(&b, "FSPROBE_ERRNO", model.ErrValueToString(event.Retval))
+	}
+	appendJournalField(&b, "FSPROBE_FLAGS", event.PrintFlags())
+	appendJournalField(&b, "FSPROBE_MODE", event.PrintMode())
+	appendJournalField(&b, "FSPROBE_PATH", event.SrcFilename)
+	appendJournalField(&b, "FSPROBE_INODE", strconv.FormatUint(event.SrcInode, 10))
+	appendJournalField(&b, "FSPROBE_MOUNT_ID", strconv.FormatUint(uint64(event.SrcMountID), 10))
+	if event.TargetFilename != "" {
+		appendJournalField(&b, "FSPROBE_TARGET_PATH", event.TargetFilename)
+		appendJournalField(&b, "FSPROBE_TARGET_INODE", strconv.FormatUint(event.TargetInode, 10))
+	}
+	if event.MountPoint != "" {
+		appendJournalField(&b, "FSPROBE_MOUNT_POINT", event.MountPoint)
+		appendJournalField(&b, "FSPROBE_FS_TYPE", event.FSType)
+	}
+	return jo.send(b.Bytes())
+}
+
+// send - Sends a journal entry. The connection is re-established once if journald was restarted.
+func (jo *JournaldOutput) send(entry []byte) error {
+	if jo.conn != nil {
+		err := jo.write(entry)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, unix.ECONNREFUSED) && !errors.Is(err, unix.ENOTCONN) {
+			return err
+		}
+		jo.conn.Close()
+		jo.conn = nil
+	}
+	if err := jo.connect(); err != nil {
+		return err
+	}
+	return jo.write(entry)
+}
+
+// write - Writes a journal entry. Entries that don't fit in a datagram are sent through a sealed memfd.
+func (jo *JournaldOutput) write(entry []byte) error {
+	_, err := jo.conn.Write(entry)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, unix.EMSGSIZE) && !errors.Is(err, unix.ENOBUFS) {
+		return err
+	}
+	fd, err := unix.MemfdCreate("fsprobe-journal", unix.MFD_ALLOW_SEALING|unix.MFD_CLOEXEC)
+	if err != nil {
+		return errors.Wrap(err, "couldn't create memfd")
+	}
+	defer unix.Close(fd)
+	if _, err = unix.Write(fd, entry); err != nil {
+		return errors.Wrap(err, "couldn't write to memfd")
+	}
+	if _, err = unix.FcntlInt(uintptr(fd), unix.F_ADD_SEALS, unix.F_SEAL_SHRINK|unix.F_SEAL_GROW|unix.F_SEAL_WRITE|unix.F_SEAL_SEAL); err != nil {
+		return errors.Wrap(err, "couldn't seal memfd")
+	}
+	_, _, err = jo.conn.WriteMsgUnix(nil, unix.UnixRights(fd), nil)
+	return err
+}
+
+// Close - Closes the journald socket
+func (jo *JournaldOutput) Close() error {
+	if jo.conn == nil {
+		return nil
+	}
+	return jo.conn.Close()
+}
+
+// appendJournalField - Appends a field to a journal entry. Values that contain a newline are serialized with their
+// size, as required by the native journal protocol.
+func appendJournalField(b *bytes.Buffer, key string, value string) {
+	if !strings.ContainsRune(value, '\n') {
+		fmt.Fprintf(b, "%s=%s\n", key, value)
+		return
+	}
+	b.WriteString(key)
+	b.WriteByte('\n')
+	_ = binary.Write(b, binary.LittleEndian, uint64(len(value)))
+	b.WriteString(value)
+	b.WriteByte('\n')
+}
@@ -15,12 +15,40 @@ limitations under the License.
 */
 package cmd
 
-import "github.com/Gui774ume/fsprobe/pkg/model"
+import (
+	"time"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
 
 // CLIOptions - Command line options
 type CLIOptions struct {
 	Format         string
 	OutputFilePath string
+	Rotation       RotationOptions
+	Filter         string
+	StatsInterval  time.Duration
+	MetricsListen  string
 	Paths          []string
 	FSOptions      model.FSProbeOptions
+	Serve          ServeOptions
+	Record         RecordOptions
+	Run            RunOptions
+}
+
+// ServeOptions - Options of the serve command
+type ServeOptions struct {
+	Listen     []string
+	GRPCListen []string
+	SocketMode string
+}
+
+// RecordOptions - Options of the record command
+type RecordOptions struct {
+	Path string
+}
+
+// RunOptions - Options of the run command
+type RunOptions struct {
+	Summary bool
 }
@@ -19,11 +19,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"io"
+	"net/url"
 	"os"
+	"strings"
 	"sync"
 
+	"github.com/Gui774ume/fsprobe/pkg/filter"
 	"github.com/Gui774ume/fsprobe/pkg/model"
 )
 
@@ -34,14 +38,26 @@ type Output struct {
 	ctx      context.Context
 	cancel   context.CancelFunc
 	writer   OutputWriter
+	closer   io.Closer
+	filter   *filter.Filter
 }
 
 // NewOutput - Returns an output instance configured with the requested format & output
 func NewOutput(options CLIOptions) (*Output, error) {
-	writer, err := newOutputWriter(options)
+	writer, closer, err := newOutputWriter(options)
 	if err != nil {
 		return nil, err
 	}
+	var evtFilter *filter.Filter
+	if options.Filter != "" {
+		evtFilter, err = filter.Parse(options.Filter)
+		if err != nil {
+			if closer != nil {
+				closer.Close()
+			}
+			return nil, err
+		}
+	}
 	ctx, cancel := context.WithCancel(context.Background())
 	output := Output{
 		EvtChan:  make(chan *model.FSEvent, options.FSOptions.UserSpaceChanSize),
@@ -50,6 +66,8 @@ func NewOutput(options CLIOptions) (*Output, error) {
 		ctx:      ctx,
 		cancel:   cancel,
 		writer:   writer,
+		closer:   closer,
+		filter:   evtFilter,
 	}
 	output.Start()
 	return &output, nil
@@ -73,7 +91,12 @@ func (o *Output) Callback() {
 				o.wg.Done()
 				return
 			}
-			logrus.Warnf("lost %v events from %v", lost.Count, lost.Map)
+			switch lost.Reason {
+			case model.LostReasonUserSpace:
+				logrus.Warnf("dropped %v events in user space", lost.Count)
+			default:
+				logrus.Warnf("lost %v events from %v", lost.Count, lost.Map)
+			}
 			break
 		case evt, ok = <-o.EvtChan:
 			if !ok {
@@ -82,6 +105,10 @@ func (o *Output) Callback() {
 				return
 			}
 			count++
+			// Drop the events that don't match the filter
+			if !o.filter.Match(evt) {
+				break
+			}
 			// Handle event
 			if err := o.writer.Write(evt); err != nil {
 				logrus.Errorf("couldn't write event to output: %v", err)
@@ -100,6 +127,11 @@ func (o *Output) Close() {
 	close(o.EvtChan)
 	close(o.LostChan)
 	o.wg.Wait()
+	if o.closer != nil {
+		if err := o.closer.Close(); err != nil {
+			logrus.Errorf("couldn't close output: %v", err)
+		}
+	}
 }
 
 // OutputWriter - Data output interface
@@ -107,28 +139,92 @@ type OutputWriter interface {
 	Write(event *model.FSEvent) error
 }
 
-func newOutputWriter(options CLIOptions) (OutputWriter, error) {
-	var writer io.Writer
-	var err error
+// newOutputWriter - Returns the output writer of the requested format, along with the output file to close when the
+// output is closed
+func newOutputWriter(options CLIOptions) (OutputWriter, io.Closer, error) {
+	if options.Format == "none" {
+		return DummyOutput{}, nil, nil
+	}
+	if isOutputURI(options.OutputFilePath) {
+		return newRemoteOutputWriter(options.OutputFilePath, options.Format)
+	}
+	var header []byte
+	if options.Format != "json" {
+		header = []byte(tableHeader())
+	}
+	var writer io.Writer = os.Stdout
+	var closer io.Closer
 	if options.OutputFilePath == "" {
-		writer = os.Stdout
+		if _, err := writer.Write(header); err != nil {
+			return nil, nil, err
+		}
 	} else {
-		writer, err = os.Open(options.OutputFilePath)
+		// The header of the table is written at the beginning of each new output file
+		file, err := NewRotatingFile(options.OutputFilePath, options.Rotation, header)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+		writer, closer = file, file
 	}
 	switch options.Format {
 	case "json":
-		return JSONOutput{output: writer}, nil
-	case "none":
-		return DummyOutput{}, nil
+		return JSONOutput{output: writer}, closer, nil
+	default:
+		return NewTableOutput(writer), closer, nil
+	}
+}
+
+// isOutputURI - Returns true if the output is a syslog or journald URI rather than a file
+func isOutputURI(output string) bool {
+	return strings.Contains(output, "://")
+}
+
+// newRemoteOutputWriter - Returns the output writer of a syslog or journald URI:
+//   - syslog:///dev/log (unix socket, /dev/log by default)
+//   - syslog+udp://host:port
+//   - syslog+tcp://host:port
+//   - journald:// (or journald:///path/to/journal/socket)
+func newRemoteOutputWriter(uri string, format string) (OutputWriter, io.Closer, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "invalid output %s", uri)
+	}
+	switch u.Scheme {
+	case "syslog", "syslog+unix":
+		path := u.Path
+		if path == "" {
+			path = defaultSyslogSocket
+		}
+		so, err := NewSyslogOutput("unix", path, format)
+		if err != nil {
+			return nil, nil, err
+		}
+		return so, so, nil
+	case "syslog+udp", "syslog+tcp":
+		if u.Host == "" {
+			return nil, nil, errors.Errorf("missing syslog server address in %s", uri)
+		}
+		so, err := NewSyslogOutput(strings.TrimPrefix(u.Scheme, "syslog+"), u.Host, format)
+		if err != nil {
+			return nil, nil, err
+		}
+		return so, so, nil
+	case "journald":
+		path := u.Path
+		if path == "" {
+			path = defaultJournalSocket
+		}
+		jo, err := NewJournaldOutput(path, format)
+		if err != nil {
+			return nil, nil, err
+		}
+		return jo, jo, nil
 	default:
-		return NewTableOutput(writer), nil
+		return nil, nil, errors.Errorf("unknown output scheme: %s", u.Scheme)
 	}
 }
 
-// JSONOutput - JSON output writer
+// JSONOutput - Newline delimited JSON output writer, see model.JSONSchemaVersion
 type JSONOutput struct {
 	output io.Writer
 }
@@ -139,12 +235,25 @@ func (so JSONOutput) Write(event *model.FSEvent) error {
 	if err != nil {
 		return err
 	}
-	if _, err := so.output.Write(data); err != nil {
+	// Write the event and its newline at once, so that a rotation never splits a line
+	if _, err := so.output.Write(append(data, '\n')); err != nil {
 		return err
 	}
 	return nil
 }
 
+const (
+	// tableFormat - Format of a line of the table output
+	tableFormat = "%7v %7v %6v %6v %6v %6v %6v %16v %6v %7v %6v %6v %16v %s\n"
+	// tableTimestampFormat - Format of the timestamps of the table output
+	tableTimestampFormat = "3:04PM"
+)
+
+// tableHeader - Returns the header of the table output
+func tableHeader() string {
+	return fmt.Sprintf(tableFormat, "EVT", "TS", "PID", "PPID", "TID", "UID", "GID", "CMD", "INODE", "MOUNTID", "RET", "MODE", "FLAG", "PATH")
+}
+
 // TableOutput - Table output writer
 type TableOutput struct {
 	output io.Writer
@@ -152,23 +261,24 @@ type TableOutput struct {
 	tsFmt  string
 }
 
+// NewTableOutput - Returns a table output writer. The header of the table isn't printed, see PrintHeader.
 func NewTableOutput(writer io.Writer) TableOutput {
-	out := TableOutput{
+	return TableOutput{
 		output: writer,
-		fmt:    "%7v %7v %6v %6v %6v %6v %16v %6v %7v %6v %6v %16v %s\n",
-		tsFmt:  "3:04PM",
+		fmt:    tableFormat,
+		tsFmt:  tableTimestampFormat,
 	}
-	out.PrintHeader()
-	return out
 }
 
 // Write - Write the event to the output writer
 func (to TableOutput) Write(event *model.FSEvent) error {
-	fmt.Printf(
+	_, err := fmt.Fprintf(
+		to.output,
 		to.fmt,
 		event.EventType,
 		event.Timestamp.Format(to.tsFmt),
 		event.Pid,
+		event.Ppid,
 		event.Tid,
 		event.UID,
 		event.GID,
@@ -180,12 +290,13 @@ func (to TableOutput) Write(event *model.FSEvent) error {
 		event.PrintFlags(),
 		event.PrintFilenames(),
 	)
-	return nil
+	return err
 }
 
 // PrintHeader - Prints table header
-func (to TableOutput) PrintHeader() {
-	fmt.Printf(to.fmt, "EVT", "TS", "PID", "TID", "UID", "GID", "CMD", "INODE", "MOUNTID", "RET", "MODE", "FLAG", "PATH")
+func (to TableOutput) PrintHeader() error {
+	_, err := io.WriteString(to.output, tableHeader())
+	return err
 }
 
 // DummyOutput - Dummy output for the none format
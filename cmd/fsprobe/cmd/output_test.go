@@ -0,0 +1,170 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+func testEvent() *model.FSEvent {
+	return &model.FSEvent{
+		Timestamp:      time.Date(2020, 6, 7, 13, 25, 41, 123456789, time.UTC),
+		Pid:            2134,
+		Tid:            2134,
+		UID:            1000,
+		Comm:           "mv",
+		Ppid:           2001,
+		Exe:            "/usr/bin/mv",
+		LoginUID:       model.LoginUIDUnset,
+		SrcFilename:    "/tmp/a",
+		TargetFilename: "/tmp/b \"quoted\"",
+		EventType:      model.Rename,
+	}
+}
+
+// listenUnixgram - Returns a unix datagram socket listening in a temporary directory
+func listenUnixgram(t *testing.T) (*net.UnixConn, string) {
+	path := filepath.Join(t.TempDir(), "sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn, path
+}
+
+func readDatagram(t *testing.T, conn *net.UnixConn) string {
+	buf := make([]byte, 65536)
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, err := conn.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(buf[:n])
+}
+
+var syslogMessage = regexp.MustCompile(`^<30>1 2020-06-07T13:25:41\.123456Z \S+ fsprobe \d+ rename \[fsprobe@32473 event="rename" pid="2134" ppid="2001" uid="1000" comm="mv" retval="0" path="/tmp/a" target_path="/tmp/b \\"quoted\\"" exe="/usr/bin/mv"\] (.*)$`)
+
+func TestSyslogOutputUnix(t *testing.T) {
+	conn, path := listenUnixgram(t)
+	writer, closer, err := newOutputWriter(CLIOptions{Format: "json", OutputFilePath: "syslog://" + path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closer.Close()
+	if err := writer.Write(testEvent()); err != nil {
+		t.Fatal(err)
+	}
+	msg := readDatagram(t, conn)
+	match := syslogMessage.FindStringSubmatch(msg)
+	if match == nil {
+		t.Fatalf("invalid syslog message: %s", msg)
+	}
+	if !strings.HasPrefix(match[1], `{"schema_version":1,`) {
+		t.Errorf("unexpected message: %s", match[1])
+	}
+}
+
+func TestSyslogOutputTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	writer, closer, err := newOutputWriter(CLIOptions{Format: "table", OutputFilePath: "syslog+tcp://" + listener.Addr().String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closer.Close()
+	for i := 0; i < 2; i++ {
+		if err := writer.Write(testEvent()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	conn, err := listener.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	reader := bufio.NewReader(conn)
+	for i := 0; i < 2; i++ {
+		// Octet counting framing
+		size, err := reader.ReadString(' ')
+		if err != nil {
+			t.Fatal(err)
+		}
+		length, err := strconv.Atoi(strings.TrimSuffix(size, " "))
+		if err != nil {
+			t.Fatalf("invalid frame length %q", size)
+		}
+		msg := make([]byte, length)
+		if _, err := io.ReadFull(reader, msg); err != nil {
+			t.Fatal(err)
+		}
+		match := syslogMessage.FindStringSubmatch(string(msg))
+		if match == nil {
+			t.Fatalf("invalid syslog message: %s", msg)
+		}
+		if !strings.HasPrefix(match[1], "rename ") || !strings.HasSuffix(match[1], `/tmp/a -> /tmp/b "quoted"`) {
+			t.Errorf("unexpected message: %s", match[1])
+		}
+	}
+}
+
+func TestJournaldOutput(t *testing.T) {
+	conn, path := listenUnixgram(t)
+	writer, closer, err := newOutputWriter(CLIOptions{Format: "table", OutputFilePath: "journald://" + path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closer.Close()
+	evt := testEvent()
+	evt.Comm = "multi\nline"
+	evt.Retval = -2
+	if err := writer.Write(evt); err != nil {
+		t.Fatal(err)
+	}
+	entry := readDatagram(t, conn)
+	for _, field := range []string{
+		"PRIORITY=4\n",
+		"SYSLOG_IDENTIFIER=fsprobe\n",
+		"FSPROBE_EVENT=rename\n",
+		"FSPROBE_PID=2134\n",
+		"FSPROBE_PPID=2001\n",
+		"FSPROBE_EXE=/usr/bin/mv\n",
+		"FSPROBE_ERRNO=ENOENT\n",
+		"FSPROBE_PATH=/tmp/a\n",
+		"FSPROBE_TARGET_PATH=/tmp/b \"quoted\"\n",
+		// Values with a newline are prefixed with their little endian size
+		"FSPROBE_COMM\n\x0a\x00\x00\x00\x00\x00\x00\x00multi\nline\n",
+	} {
+		if !strings.Contains(entry, field) {
+			t.Errorf("missing field %q in %q", field, entry)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/Gui774ume/fsprobe/pkg/filter"
+	"github.com/Gui774ume/fsprobe/pkg/fsprobe"
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// recordCmd - Records the raw samples sent by the kernel to a capture file
+var recordCmd = &cobra.Command{
+	Use:   "record -w capture.fsp [paths]",
+	Short: "Records the raw events sent by the kernel to a capture file",
+	Long: `Records the raw samples sent by the kernel to a capture file, along with the boot time,
+the options and the mount points needed to decode them. Captures can be replayed
+without eBPF with "fsprobe replay".`,
+	RunE:    runRecordCmd,
+	Args:    cobra.ArbitraryArgs,
+	Example: "sudo fsprobe record -w capture.fsp /tmp",
+}
+
+// replayCmd - Replays a capture file without eBPF
+var replayCmd = &cobra.Command{
+	Use:   "replay capture.fsp",
+	Short: "Replays a capture file recorded with fsprobe record",
+	Long: `Decodes the raw samples of a capture file recorded with "fsprobe record" and outputs the
+resulting events, without loading eBPF. The kernel-space options (dentry resolution mode,
+recursive mode, excludes, ...) are read from the capture. Use "-" to read the capture
+from stdin.
+
+The paths of the "fragments" and "single_fragment" dentry resolution modes are read
+from eBPF maps that aren't recorded: they are replaced by *ERROR*.`,
+	RunE:    runReplayCmd,
+	Args:    cobra.ExactArgs(1),
+	Example: "fsprobe replay capture.fsp --filter 'uid != 0' -f json",
+}
+
+func init() {
+	recordCmd.Flags().StringVarP(
+		&options.Record.Path,
+		"write",
+		"w",
+		"",
+		"Path of the capture file, the file is overwritten if it exists")
+	_ = recordCmd.MarkFlagRequired("write")
+	FSProbeCmd.AddCommand(recordCmd)
+
+	replayCmd.Flags().StringVarP(
+		&options.Format,
+		"format",
+		"f",
+		"table",
+		`Defines the output format.
+Options are: table, json (newline delimited), none`)
+	replayCmd.Flags().StringVarP(
+		&options.OutputFilePath,
+		"output",
+		"o",
+		"",
+		`Outputs events to the provided file rather than stdout`)
+	replayCmd.Flags().StringVar(
+		&options.Filter,
+		"filter",
+		"",
+		`Only outputs the events matching the provided expression`)
+	FSProbeCmd.AddCommand(replayCmd)
+}
+
+func runRecordCmd(cmd *cobra.Command, args []string) error {
+	if err := sanitizeOptions(args); err != nil {
+		return err
+	}
+
+	// 1) Create the capture file
+	file, err := os.OpenFile(options.Record.Path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0640)
+	if err != nil {
+		return errors.Wrap(err, "couldn't create capture file")
+	}
+	recorder, err := model.NewCaptureWriter(file)
+	if err != nil {
+		file.Close()
+		return errors.Wrap(err, "couldn't write capture file")
+	}
+	options.FSOptions.Recorder = recorder
+
+	// 2) Start FSProbe, the events are only recorded
+	probe := fsprobe.NewFSProbeWithOptions(options.FSOptions)
+	if err := probe.Watch(args...); err != nil {
+		logrus.Fatalf("couldn't start watching the filesystem: %v", err)
+	}
+	logrus.Infof("recording to %s", options.Record.Path)
+
+	// 3) Periodically log the statistics of FSProbe and serve the metrics
+	stopStats := logStats(probe, options.StatsInterval)
+	metricsServer, err := serveMetrics(probe, options.MetricsListen)
+	if err != nil {
+		logrus.Fatalf("couldn't serve metrics: %v", err)
+	}
+
+	// 4) Wait until interrupt signal
+	wait()
+	close(stopStats)
+	if metricsServer != nil {
+		_ = metricsServer.Close()
+	}
+
+	// Stop fsprobe and flush the capture
+	if err := probe.Stop(); err != nil {
+		logrus.Fatalf("couldn't gracefully shutdown fsprobe: %v", err)
+	}
+	if err := recorder.Close(); err != nil {
+		return errors.Wrap(err, "couldn't write capture file")
+	}
+	var count uint64
+	for _, c := range probe.Stats().Events {
+		count += c
+	}
+	logrus.Printf("%v events recorded", count)
+	return nil
+}
+
+func runReplayCmd(cmd *cobra.Command, args []string) error {
+	// 1) Open the capture
+	input := os.Stdin
+	if args[0] != "-" {
+		file, err := os.Open(args[0])
+		if err != nil {
+			return errors.Wrap(err, "couldn't open capture file")
+		}
+		defer file.Close()
+		input = file
+	}
+	reader, err := model.NewCaptureReader(input)
+	if err != nil {
+		return err
+	}
+
+	// 2) Prepare the output
+	writer, closer, err := newOutputWriter(options)
+	if err != nil {
+		return errors.Wrap(err, "couldn't create FSEvent output")
+	}
+	if closer != nil {
+		defer closer.Close()
+	}
+	subscribeOptions := fsprobe.SubscribeOptions{
+		Events:       options.FSOptions.Events,
+		Backpressure: model.BackpressureBlock,
+	}
+	if options.Filter != "" {
+		if subscribeOptions.Filter, err = filter.Parse(options.Filter); err != nil {
+			return err
+		}
+	}
+
+	// 3) Replay the capture, the stream is closed at the end of the capture
+	probe := fsprobe.NewFSProbeWithOptions(options.FSOptions)
+	stream := probe.SubscribeWithOptions(subscribeOptions)
+	done := make(chan error, 1)
+	go func() {
+		done <- probe.Replay(context.Background(), reader)
+	}()
+	var count int
+	for {
+		evt, err := stream.Next(context.Background())
+		if err != nil {
+			break
+		}
+		count++
+		if err := writer.Write(evt); err != nil {
+			logrus.Errorf("couldn't write event to output: %v", err)
+		}
+	}
+	if lost := stream.Lost(); lost > 0 {
+		logrus.Warnf("%v events were lost while recording", lost)
+	}
+	if failures := probe.Stats().ParseFailures; failures > 0 {
+		logrus.Warnf("%v samples couldn't be decoded", failures)
+	}
+	logrus.Printf("%v events replayed", count)
+	return <-done
+}
@@ -0,0 +1,250 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// outputFileMode - Permissions of the output files
+	outputFileMode = 0640
+	// rotationTimeFormat - Format of the timestamp appended to the name of the rotated files
+	rotationTimeFormat = "20060102T150405.000"
+)
+
+// RotationOptions - Rotation options of the output file
+type RotationOptions struct {
+	// MaxSize - Size in bytes after which the output file is rotated. 0 disables size-based rotation.
+	MaxSize int64
+	// MaxAge - Age after which the output file is rotated. 0 disables time-based rotation.
+	MaxAge time.Duration
+	// Keep - Number of rotated files to keep. 0 keeps all the rotated files.
+	Keep int
+	// Compress - Compresses the rotated files with gzip
+	Compress bool
+}
+
+// RotatingFile - Output file that is rotated by size and by age. Rotated files are renamed with a timestamp suffix,
+// optionally compressed, and the oldest ones are removed according to the retention count.
+type RotatingFile struct {
+	sync.Mutex
+	path     string
+	options  RotationOptions
+	header   []byte
+	file     *os.File
+	size     int64
+	openedAt time.Time
+	// millLock - Serializes the compression and cleanup of the rotated files
+	millLock sync.Mutex
+	millWG   sync.WaitGroup
+}
+
+// NewRotatingFile - Creates or appends to the output file at the provided path. header is written at the beginning
+// of each new file.
+func NewRotatingFile(path string, options RotationOptions, header []byte) (*RotatingFile, error) {
+	rf := &RotatingFile{
+		path:    path,
+		options: options,
+		header:  header,
+	}
+	if err := rf.open(); err != nil {
+		return nil, err
+	}
+	return rf, nil
+}
+
+// open - Opens the output file in append mode, and creates it if needed. The caller must hold the lock.
+func (rf *RotatingFile) open() error {
+	file, err := os.OpenFile(rf.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, outputFileMode)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't open output file %s", rf.path)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return errors.Wrapf(err, "couldn't stat output file %s", rf.path)
+	}
+	rf.file = file
+	rf.size = info.Size()
+	rf.openedAt = time.Now()
+	if rf.size == 0 && len(rf.header) > 0 {
+		n, err := rf.file.Write(rf.header)
+		rf.size += int64(n)
+		if err != nil {
+			return errors.Wrapf(err, "couldn't write header to %s", rf.path)
+		}
+	}
+	return nil
+}
+
+// Write - Writes to the output file, and rotates it first if needed
+func (rf *RotatingFile) Write(p []byte) (int, error) {
+	rf.Lock()
+	defer rf.Unlock()
+	if rf.file == nil {
+		return 0, os.ErrClosed
+	}
+	if rf.shouldRotate(int64(len(p))) {
+		if err := rf.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := rf.file.Write(p)
+	rf.size += int64(n)
+	return n, err
+}
+
+// shouldRotate - Returns true if writing n bytes requires a rotation. The caller must hold the lock.
+func (rf *RotatingFile) shouldRotate(n int64) bool {
+	if rf.size <= int64(len(rf.header)) {
+		// Never rotate an empty file
+		return false
+	}
+	if rf.options.MaxSize > 0 && rf.size+n > rf.options.MaxSize {
+		return true
+	}
+	if rf.options.MaxAge > 0 && time.Since(rf.openedAt) >= rf.options.MaxAge {
+		return true
+	}
+	return false
+}
+
+// rotate - Renames the current output file and opens a new one. The caller must hold the lock.
+func (rf *RotatingFile) rotate() error {
+	if err := rf.file.Close(); err != nil {
+		logrus.Warnf("couldn't close output file %s: %v", rf.path, err)
+	}
+	rf.file = nil
+	rotated := rf.rotatedPath(time.Now())
+	if err := os.Rename(rf.path, rotated); err != nil {
+		return errors.Wrapf(err, "couldn't rotate output file %s", rf.path)
+	}
+	if err := rf.open(); err != nil {
+		return err
+	}
+	rf.millWG.Add(1)
+	go rf.mill(rotated)
+	return nil
+}
+
+// rotatedPath - Returns an unused name for a file rotated at the provided time. The timestamp is bumped when a file
+// was already rotated during the same millisecond, so that the names keep sorting chronologically.
+func (rf *RotatingFile) rotatedPath(now time.Time) string {
+	for {
+		rotated := rf.path + "." + now.Format(rotationTimeFormat)
+		if !fileExists(rotated) && !fileExists(rotated+".gz") {
+			return rotated
+		}
+		now = now.Add(time.Millisecond)
+	}
+}
+
+// mill - Compresses the rotated file and removes the rotated files that exceed the retention count
+func (rf *RotatingFile) mill(rotated string) {
+	defer rf.millWG.Done()
+	rf.millLock.Lock()
+	defer rf.millLock.Unlock()
+	// The rotated file might already have been removed by the retention of a more recent rotation
+	if rf.options.Compress && fileExists(rotated) {
+		if err := compressFile(rotated); err != nil {
+			logrus.Warnf("couldn't compress rotated output file %s: %v", rotated, err)
+		}
+	}
+	if rf.options.Keep <= 0 {
+		return
+	}
+	backups := rf.rotatedFiles()
+	for len(backups) > rf.options.Keep {
+		if err := os.Remove(backups[0]); err != nil {
+			logrus.Warnf("couldn't remove rotated output file %s: %v", backups[0], err)
+		}
+		backups = backups[1:]
+	}
+}
+
+// rotatedFiles - Returns the rotated files of the output file, from the oldest to the most recent
+func (rf *RotatingFile) rotatedFiles() []string {
+	matches, err := filepath.Glob(rf.path + ".*")
+	if err != nil {
+		return nil
+	}
+	var backups []string
+	for _, match := range matches {
+		suffix := strings.TrimSuffix(strings.TrimPrefix(match, rf.path+"."), ".gz")
+		if _, err := time.Parse(rotationTimeFormat, suffix); err != nil {
+			continue
+		}
+		backups = append(backups, match)
+	}
+	// The timestamp format sorts chronologically
+	sort.Strings(backups)
+	return backups
+}
+
+// Close - Closes the output file and waits for the pending compressions
+func (rf *RotatingFile) Close() error {
+	rf.Lock()
+	defer rf.Unlock()
+	var err error
+	if rf.file != nil {
+		err = rf.file.Close()
+		rf.file = nil
+	}
+	rf.millWG.Wait()
+	return err
+}
+
+// compressFile - Compresses a file with gzip and removes the original file
+func compressFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(path+".gz", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, outputFileMode)
+	if err != nil {
+		return err
+	}
+	gz := gzip.NewWriter(dst)
+	if _, err = io.Copy(gz, src); err == nil {
+		err = gz.Close()
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path + ".gz")
+		return err
+	}
+	return os.Remove(path)
+}
+
+// fileExists - Returns true if the provided path exists
+func fileExists(path string) bool {
+	_, err := os.Lstat(path)
+	return err == nil
+}
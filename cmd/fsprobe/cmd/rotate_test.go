@@ -0,0 +1,224 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeLines - Writes count numbered lines of 10 bytes, starting at the provided line number
+func writeLines(t *testing.T, rf *RotatingFile, start int, count int) []string {
+	var lines []string
+	for i := start; i < start+count; i++ {
+		line := fmt.Sprintf("line %04d\n", i)
+		if _, err := rf.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// readRotatedFile - Returns the content of a rotated file, decompressed if needed
+func readRotatedFile(t *testing.T, path string) string {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if !strings.HasSuffix(path, ".gz") {
+		data, err := ioutil.ReadAll(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatalf("%s: %v", path, err)
+	}
+	data, err := ioutil.ReadAll(gz)
+	if err != nil {
+		t.Fatalf("%s: %v", path, err)
+	}
+	return string(data)
+}
+
+// checkRotatedNames - Checks that the rotated files are named after the output file and a rotation timestamp
+func checkRotatedNames(t *testing.T, output string, rotated []string, compressed bool) {
+	for _, p := range rotated {
+		suffix := strings.TrimPrefix(p, output+".")
+		if compressed {
+			if !strings.HasSuffix(suffix, ".gz") {
+				t.Errorf("%s isn't compressed", p)
+			}
+			suffix = strings.TrimSuffix(suffix, ".gz")
+		}
+		if _, err := time.Parse(rotationTimeFormat, suffix); err != nil {
+			t.Errorf("unexpected rotated file name %s: %v", p, err)
+		}
+	}
+}
+
+func TestRotatingFileSize(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "events.json")
+	header := "# header\n"
+	rf, err := NewRotatingFile(output, RotationOptions{MaxSize: 50}, []byte(header))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 9 bytes of header and 4 lines of 10 bytes fit in 50 bytes
+	lines := writeLines(t, rf, 0, 10)
+	if err := rf.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	rotated := rf.rotatedFiles()
+	if len(rotated) != 2 {
+		t.Fatalf("expected 2 rotated files, got %v", rotated)
+	}
+	checkRotatedNames(t, output, rotated, false)
+	expected := []string{
+		header + strings.Join(lines[0:4], ""),
+		header + strings.Join(lines[4:8], ""),
+	}
+	for i, p := range rotated {
+		if content := readRotatedFile(t, p); content != expected[i] {
+			t.Errorf("%s: expected %q, got %q", p, expected[i], content)
+		}
+	}
+	if content := readRotatedFile(t, output); content != header+strings.Join(lines[8:], "") {
+		t.Errorf("unexpected content of the output file: %q", content)
+	}
+}
+
+func TestRotatingFileAge(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "events.json")
+	rf, err := NewRotatingFile(output, RotationOptions{MaxAge: 100 * time.Millisecond}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := writeLines(t, rf, 0, 3)
+	time.Sleep(150 * time.Millisecond)
+	second := writeLines(t, rf, 3, 2)
+	if err := rf.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	rotated := rf.rotatedFiles()
+	if len(rotated) != 1 {
+		t.Fatalf("expected 1 rotated file, got %v", rotated)
+	}
+	checkRotatedNames(t, output, rotated, false)
+	if content := readRotatedFile(t, rotated[0]); content != strings.Join(first, "") {
+		t.Errorf("unexpected content of the rotated file: %q", content)
+	}
+	if content := readRotatedFile(t, output); content != strings.Join(second, "") {
+		t.Errorf("unexpected content of the output file: %q", content)
+	}
+}
+
+func TestRotatingFileAgeEmpty(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "events.json")
+	rf, err := NewRotatingFile(output, RotationOptions{MaxAge: 10 * time.Millisecond}, []byte("# header\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	// A file that only holds its header is never rotated
+	writeLines(t, rf, 0, 1)
+	if err := rf.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if rotated := rf.rotatedFiles(); len(rotated) != 0 {
+		t.Errorf("expected no rotated file, got %v", rotated)
+	}
+}
+
+func TestRotatingFileCompressKeep(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "events.json")
+	rf, err := NewRotatingFile(output, RotationOptions{MaxSize: 20, Keep: 2, Compress: true}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each file holds 2 lines: 5 rotations, only the 2 most recent rotated files are kept
+	lines := writeLines(t, rf, 0, 12)
+	if err := rf.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	rotated := rf.rotatedFiles()
+	if len(rotated) != 2 {
+		t.Fatalf("expected 2 rotated files, got %v", rotated)
+	}
+	checkRotatedNames(t, output, rotated, true)
+	expected := []string{
+		strings.Join(lines[6:8], ""),
+		strings.Join(lines[8:10], ""),
+	}
+	for i, p := range rotated {
+		if content := readRotatedFile(t, p); content != expected[i] {
+			t.Errorf("%s: expected %q, got %q", p, expected[i], content)
+		}
+	}
+	if content := readRotatedFile(t, output); content != strings.Join(lines[10:], "") {
+		t.Errorf("unexpected content of the output file: %q", content)
+	}
+	// The uncompressed rotated files are removed
+	matches, err := filepath.Glob(output + ".*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 2 {
+		t.Errorf("unexpected files next to the output file: %v", matches)
+	}
+}
+
+func TestRotatingFileAppend(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "events.json")
+	if err := ioutil.WriteFile(output, []byte("previous run\n"), 0640); err != nil {
+		t.Fatal(err)
+	}
+	rf, err := NewRotatingFile(output, RotationOptions{MaxSize: 30}, []byte("# header\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The existing content counts in the size of the file, and the header isn't written again
+	lines := writeLines(t, rf, 0, 2)
+	if err := rf.Close(); err != nil {
+		t.Fatal(err)
+	}
+	rotated := rf.rotatedFiles()
+	if len(rotated) != 1 {
+		t.Fatalf("expected 1 rotated file, got %v", rotated)
+	}
+	if content := readRotatedFile(t, rotated[0]); content != "previous run\n"+lines[0] {
+		t.Errorf("unexpected content of the rotated file: %q", content)
+	}
+	if content := readRotatedFile(t, output); content != "# header\n"+lines[1] {
+		t.Errorf("unexpected content of the output file: %q", content)
+	}
+	if _, err := rf.Write([]byte("closed")); err != os.ErrClosed {
+		t.Errorf("expected os.ErrClosed after Close, got %v", err)
+	}
+}
@@ -0,0 +1,256 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"runtime"
+	"sort"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"golang.org/x/sys/unix"
+
+	"github.com/Gui774ume/fsprobe/pkg/filter"
+	"github.com/Gui774ume/fsprobe/pkg/fsprobe"
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// runCmd - Runs a command and outputs the file system events of its process tree
+var runCmd = &cobra.Command{
+	Use:   "run -- command [args]",
+	Short: "Runs a command and outputs the file system events of the command and its descendants",
+	Long: `Runs a command and outputs the file system events of the command and of all the processes
+it forks. The process tree is tracked in kernel space: the events of the other processes
+are dropped before they reach user space, and no path needs to be watched. fsprobe exits
+with the exit status of the command.
+
+SIGTERM is forwarded to the command. SIGINT is ignored by fsprobe, the command receives
+it from the terminal.`,
+	RunE:    runRunCmd,
+	Args:    cobra.MinimumNArgs(1),
+	Example: "sudo fsprobe run --summary -o events.log -- make -j8",
+}
+
+func init() {
+	runCmd.Flags().StringVarP(
+		&options.Format,
+		"format",
+		"f",
+		"table",
+		`Defines the output format.
+Options are: table, json (newline delimited), none`)
+	runCmd.Flags().StringVarP(
+		&options.OutputFilePath,
+		"output",
+		"o",
+		"",
+		`Outputs events to the provided file rather than stdout`)
+	runCmd.Flags().StringVar(
+		&options.Filter,
+		"filter",
+		"",
+		`Only outputs the events matching the provided expression`)
+	runCmd.Flags().BoolVar(
+		&options.Run.Summary,
+		"summary",
+		false,
+		`Prints the files touched by the command, grouped by operation, on stderr when the command exits`)
+	// The flags of the command aren't parsed by fsprobe
+	runCmd.Flags().SetInterspersed(false)
+	FSProbeCmd.AddCommand(runCmd)
+}
+
+func runRunCmd(cmd *cobra.Command, args []string) error {
+	// 1) Prepare the command and the output
+	child := exec.Command(args[0], args[1:]...)
+	if child.Err != nil {
+		return child.Err
+	}
+	child.Stdin, child.Stdout, child.Stderr = os.Stdin, os.Stdout, os.Stderr
+	writer, closer, err := newOutputWriter(options)
+	if err != nil {
+		return errors.Wrap(err, "couldn't create FSEvent output")
+	}
+	if closer != nil {
+		defer closer.Close()
+	}
+	subscribeOptions := fsprobe.SubscribeOptions{
+		Events:       options.FSOptions.Events,
+		Backpressure: model.BackpressureBlock,
+	}
+	if options.Filter != "" {
+		if subscribeOptions.Filter, err = filter.Parse(options.Filter); err != nil {
+			return err
+		}
+	}
+
+	// 2) The command is forked from a dedicated thread: the thread is added to the allow list, and the command
+	// inherits its filter in kernel space as soon as it is forked. The thread is never unlocked so that it is
+	// destroyed with the goroutine, instead of running the other goroutines of fsprobe while it is allowed.
+	threads := make(chan int)
+	proceed := make(chan bool)
+	started := make(chan *os.Process, 1)
+	result := make(chan error, 1)
+	go func() {
+		runtime.LockOSThread()
+		threads <- unix.Gettid()
+		if !<-proceed {
+			return
+		}
+		if err := child.Start(); err != nil {
+			result <- err
+			return
+		}
+		started <- child.Process
+		result <- child.Wait()
+	}()
+
+	// 3) Start FSProbe, without paths filtering
+	options.FSOptions.PathsFiltering = false
+	options.FSOptions.FollowForks = true
+	tid := uint32(<-threads)
+	options.FSOptions.AllowProcesses.Pids = append(options.FSOptions.AllowProcesses.Pids, tid)
+	probe := fsprobe.NewFSProbeWithOptions(options.FSOptions)
+	stream := probe.SubscribeWithOptions(subscribeOptions)
+	if err := probe.Start(); err != nil {
+		proceed <- false
+		return errors.Wrap(err, "couldn't start fsprobe")
+	}
+	summary := newRunSummary()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			evt, err := stream.Next(context.Background())
+			if err != nil {
+				return
+			}
+			summary.Add(evt)
+			if err := writer.Write(evt); err != nil {
+				logrus.Errorf("couldn't write event to output: %v", err)
+			}
+		}
+	}()
+
+	// 4) Run the command until it exits
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sig)
+	proceed <- true
+	var process *os.Process
+	var waitErr error
+	for exited := false; !exited; {
+		select {
+		case process = <-started:
+			// Allow the command itself, so that the thread can be removed from the allow list without emptying it
+			if err := probe.AllowProcesses(model.ProcessFilter{Pids: []uint32{uint32(process.Pid)}}); err != nil {
+				logrus.Warnf("couldn't allow pid %d: %v", process.Pid, err)
+			} else if err := probe.RemoveProcessFilters(model.ProcessFilter{Pids: []uint32{tid}}); err != nil {
+				logrus.Warnf("couldn't remove tid %d from the allow list: %v", tid, err)
+			}
+		case s := <-sig:
+			if s == syscall.SIGTERM && process != nil {
+				_ = process.Signal(s)
+			}
+		case waitErr = <-result:
+			exited = true
+		}
+	}
+
+	// 5) Stop FSProbe, the pending events are flushed before the stream is closed
+	if err := probe.Stop(); err != nil {
+		logrus.Errorf("couldn't gracefully shutdown fsprobe: %v", err)
+	}
+	<-done
+	if lost := stream.Lost(); lost > 0 {
+		logrus.Warnf("%v events were lost", lost)
+	}
+	if options.Run.Summary {
+		summary.Write(os.Stderr)
+	}
+
+	// 6) Exit with the exit status of the command
+	if waitErr == nil {
+		return nil
+	}
+	exitErr, ok := waitErr.(*exec.ExitError)
+	if !ok {
+		return errors.Wrap(waitErr, "couldn't run command")
+	}
+	if closer != nil {
+		closer.Close()
+	}
+	if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		os.Exit(128 + int(status.Signal()))
+	}
+	os.Exit(exitErr.ExitCode())
+	return nil
+}
+
+// runSummary - Files touched by a command, grouped by operation
+type runSummary struct {
+	files map[model.EventName]map[string]int
+}
+
+// newRunSummary - Returns an empty summary
+func newRunSummary() *runSummary {
+	return &runSummary{
+		files: make(map[model.EventName]map[string]int),
+	}
+}
+
+// Add - Adds the files of an event to the summary. The failed operations are reported with their error.
+func (rs *runSummary) Add(evt *model.FSEvent) {
+	files, ok := rs.files[evt.EventType]
+	if !ok {
+		files = make(map[string]int)
+		rs.files[evt.EventType] = files
+	}
+	name := evt.PrintFilenames()
+	if evt.Retval < 0 {
+		name = fmt.Sprintf("%s (%s)", name, model.ErrValueToString(evt.Retval))
+	}
+	files[name]++
+}
+
+// Write - Writes the summary, the operations and the files are sorted by name
+func (rs *runSummary) Write(w io.Writer) {
+	events := make([]string, 0, len(rs.files))
+	for evt := range rs.files {
+		events = append(events, string(evt))
+	}
+	sort.Strings(events)
+	for _, evt := range events {
+		files := rs.files[model.EventName(evt)]
+		names := make([]string, 0, len(files))
+		for name := range files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Fprintf(w, "%s (%d)\n", evt, len(names))
+		for _, name := range names {
+			fmt.Fprintf(w, "  %6d  %s\n", files[name], name)
+		}
+	}
+}
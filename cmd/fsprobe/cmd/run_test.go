@@ -0,0 +1,43 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+func TestRunSummary(t *testing.T) {
+	summary := newRunSummary()
+	summary.Add(&model.FSEvent{EventType: model.Open, SrcFilename: "/etc/passwd"})
+	summary.Add(&model.FSEvent{EventType: model.Open, SrcFilename: "/etc/passwd"})
+	summary.Add(&model.FSEvent{EventType: model.Open, SrcFilename: "/etc/missing", Retval: -2})
+	summary.Add(testEvent())
+
+	var buf bytes.Buffer
+	summary.Write(&buf)
+	expected := `open (2)
+       1  /etc/missing (ENOENT)
+       2  /etc/passwd
+rename (1)
+       1  /tmp/a -> /tmp/b "quoted"
+`
+	if buf.String() != expected {
+		t.Errorf("unexpected summary:\n%s", buf.String())
+	}
+}
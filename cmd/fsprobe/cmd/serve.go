@@ -0,0 +1,166 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/Gui774ume/fsprobe/pkg/client"
+	"github.com/Gui774ume/fsprobe/pkg/fsprobe"
+	"github.com/Gui774ume/fsprobe/pkg/server"
+)
+
+// serveCmd - Runs a shared FSProbe instance and streams its events to the clients of a unix or TCP socket
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Streams the events of a shared FSProbe instance to socket and gRPC clients",
+	Long: `Runs a shared FSProbe instance and streams its events to the clients of a unix or TCP socket.
+
+Each client sends a subscription request as a single JSON line, for example:
+{"paths": ["/tmp"], "events": ["open", "rename"], "filter": "uid != 0"}
+and then receives the matching events as newline delimited JSON. The paths of a client
+are watched until it disconnects.
+
+The same instance is also exposed through a gRPC API (see pkg/api and pkg/client).`,
+	RunE:    runServeCmd,
+	Args:    cobra.NoArgs,
+	Example: "sudo fsprobe serve --listen unix:///run/fsprobe.sock",
+}
+
+func init() {
+	serveCmd.Flags().StringSliceVar(
+		&options.Serve.Listen,
+		"listen",
+		[]string{"unix:///run/fsprobe.sock"},
+		`Addresses on which the clients are accepted, either
+unix:///path/to/socket or tcp://host:port. This option can
+be specified more than once`)
+	serveCmd.Flags().StringSliceVar(
+		&options.Serve.GRPCListen,
+		"grpc-listen",
+		[]string{client.DefaultAddress},
+		`Addresses on which the gRPC API is served, either
+unix:///path/to/socket or tcp://host:port. This option can
+be specified more than once`)
+	serveCmd.Flags().StringVar(
+		&options.Serve.SocketMode,
+		"socket-mode",
+		"0660",
+		`Permissions of the unix sockets. Any user that can connect
+to the socket can watch any path`)
+	FSProbeCmd.AddCommand(serveCmd)
+}
+
+func runServeCmd(cmd *cobra.Command, args []string) error {
+	socketMode, err := strconv.ParseUint(options.Serve.SocketMode, 8, 32)
+	if err != nil {
+		return errors.Errorf("invalid socket mode: %s", options.Serve.SocketMode)
+	}
+
+	// 1) Start FSProbe, the paths are watched when the clients subscribe
+	probe := fsprobe.NewFSProbeWithOptions(options.FSOptions)
+	if err := probe.Start(); err != nil {
+		logrus.Fatalf("couldn't start fsprobe: %v", err)
+	}
+
+	// 2) Periodically log the statistics of FSProbe and serve the metrics
+	stopStats := logStats(probe, options.StatsInterval)
+	metricsServer, err := serveMetrics(probe, options.MetricsListen)
+	if err != nil {
+		logrus.Fatalf("couldn't serve metrics: %v", err)
+	}
+
+	// 3) Accept clients
+	srv := server.NewServer(probe)
+	for _, addr := range options.Serve.Listen {
+		listener, err := listen(addr, os.FileMode(socketMode))
+		if err != nil {
+			logrus.Fatalf("couldn't listen on %s: %v", addr, err)
+		}
+		logrus.Infof("accepting clients on %s", addr)
+		go func(addr string) {
+			if err := srv.Serve(listener); err != nil && err != server.ErrServerClosed {
+				logrus.Errorf("stopped accepting clients on %s: %v", addr, err)
+			}
+		}(addr)
+	}
+
+	grpcServer := srv.NewGRPCServer()
+	for _, addr := range options.Serve.GRPCListen {
+		listener, err := listen(addr, os.FileMode(socketMode))
+		if err != nil {
+			logrus.Fatalf("couldn't listen on %s: %v", addr, err)
+		}
+		logrus.Infof("serving the gRPC API on %s", addr)
+		go func(addr string) {
+			if err := grpcServer.Serve(listener); err != nil {
+				logrus.Errorf("stopped serving the gRPC API on %s: %v", addr, err)
+			}
+		}(addr)
+	}
+
+	// 4) Wait until interrupt signal
+	wait()
+	close(stopStats)
+	if metricsServer != nil {
+		_ = metricsServer.Close()
+	}
+	_ = srv.Close()
+	grpcServer.Stop()
+
+	// Stop fsprobe
+	if err := probe.Stop(); err != nil {
+		logrus.Fatalf("couldn't gracefully shutdown fsprobe: %v", err)
+	}
+	return nil
+}
+
+// listen - Listens on a unix:// or tcp:// address. Stale unix sockets are removed.
+func listen(addr string, socketMode os.FileMode) (net.Listener, error) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "unix":
+		if u.Path == "" {
+			return nil, errors.New("missing socket path")
+		}
+		if info, err := os.Lstat(u.Path); err == nil && info.Mode()&os.ModeSocket != 0 {
+			_ = os.Remove(u.Path)
+		}
+		listener, err := net.Listen("unix", u.Path)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.Chmod(u.Path, socketMode); err != nil {
+			listener.Close()
+			return nil, err
+		}
+		return listener, nil
+	case "tcp":
+		return net.Listen("tcp", u.Host)
+	default:
+		return nil, errors.Errorf("unknown scheme: %s", u.Scheme)
+	}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+const (
+	// defaultSyslogSocket - Default syslog socket of the syslog:// output
+	defaultSyslogSocket = "/dev/log"
+	// syslogAppName - APP-NAME of the syslog messages
+	syslogAppName = "fsprobe"
+	// syslogFacility - Facility of the syslog messages (daemon)
+	syslogFacility = 3
+	// syslogSDID - ID of the structured data element of the syslog messages, under the private enterprise number
+	// reserved for documentation (RFC 5612)
+	syslogSDID = "fsprobe@32473"
+	// syslogTimeFormat - RFC 5424 timestamps are limited to microseconds
+	syslogTimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// Syslog and journald severities
+const (
+	severityWarning = 4
+	severityInfo    = 6
+)
+
+// eventSeverity - Returns the severity of an event: warning when the syscall failed, info otherwise
+func eventSeverity(event *model.FSEvent) int {
+	if event.Retval < 0 {
+		return severityWarning
+	}
+	return severityInfo
+}
+
+// eventFormatter - Formats the message of an event with the table or the JSON output writer
+type eventFormatter struct {
+	buf    bytes.Buffer
+	writer OutputWriter
+}
+
+func newEventFormatter(format string) *eventFormatter {
+	f := &eventFormatter{}
+	switch format {
+	case "json":
+		f.writer = JSONOutput{output: &f.buf}
+	default:
+		f.writer = NewTableOutput(&f.buf)
+	}
+	return f
+}
+
+// format - Returns the message of an event, without the padding of the table and the trailing newline
+func (f *eventFormatter) format(event *model.FSEvent) (string, error) {
+	f.buf.Reset()
+	if err := f.writer.Write(event); err != nil {
+		return "", err
+	}
+	return strings.TrimLeft(strings.TrimSuffix(f.buf.String(), "\n"), " "), nil
+}
+
+// SyslogOutput - Sends the events as RFC 5424 syslog messages over a unix socket, UDP or TCP
+type SyslogOutput struct {
+	network   string
+	address   string
+	conn      net.Conn
+	stream    bool
+	hostname  string
+	pid       string
+	formatter *eventFormatter
+}
+
+// NewSyslogOutput - Returns a syslog output writer. network is either "unix" (datagram with a fallback to stream
+// sockets), "udp" or "tcp". The message of the events is formatted with the provided format.
+func NewSyslogOutput(network string, address string, format string) (*SyslogOutput, error) {
+	so := &SyslogOutput{
+		network:   network,
+		address:   address,
+		hostname:  model.Hostname(),
+		pid:       strconv.Itoa(os.Getpid()),
+		formatter: newEventFormatter(format),
+	}
+	if so.hostname == "" {
+		so.hostname = "-"
+	}
+	if err := so.connect(); err != nil {
+		return nil, err
+	}
+	return so, nil
+}
+
+// connect - Connects to the syslog server
+func (so *SyslogOutput) connect() error {
+	var err error
+	switch so.network {
+	case "unix":
+		if so.conn, err = net.Dial("unixgram", so.address); err == nil {
+			so.stream = false
+			return nil
+		}
+		so.conn, err = net.Dial("unix", so.address)
+		so.stream = true
+	case "tcp":
+		so.conn, err = net.Dial("tcp", so.address)
+		so.stream = true
+	default:
+		so.conn, err = net.Dial(so.network, so.address)
+		so.stream = false
+	}
+	if err != nil {
+		so.conn = nil
+		return errors.Wrapf(err, "couldn't connect to syslog server %s", so.address)
+	}
+	return nil
+}
+
+// Write - Write the event to the syslog server. The connection is re-established once if the write fails.
+func (so *SyslogOutput) Write(event *model.FSEvent) error {
+	msg, err := so.message(event)
+	if err != nil {
+		return err
+	}
+	if so.conn != nil {
+		if _, err = so.conn.Write(msg); err == nil {
+			return nil
+		}
+		so.conn.Close()
+		so.conn = nil
+	}
+	if err := so.connect(); err != nil {
+		return err
+	}
+	_, err = so.conn.Write(msg)
+	return err
+}
+
+// message - Returns the RFC 5424 message of an event. Messages sent over stream sockets are framed with octet
+// counting (RFC 6587).
+func (so *SyslogOutput) message(event *model.FSEvent) ([]byte, error) {
+	text, err := so.formatter.format(event)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(
+		&b,
+		"<%d>1 %s %s %s %s %s ",
+		syslogFacility*8+eventSeverity(event),
+		event.Timestamp.Format(syslogTimeFormat),
+		so.hostname,
+		syslogAppName,
+		so.pid,
+		event.EventType,
+	)
+	fmt.Fprintf(&b, "[%s event=\"%s\" pid=\"%d\" ppid=\"%d\" uid=\"%d\" comm=\"%s\" retval=\"%d\" path=\"%s\"",
+		syslogSDID,
+		event.EventType,
+		event.Pid,
+		event.Ppid,
+		event.UID,
+		escapeSDParam(event.Comm),
+		event.Retval,
+		escapeSDParam(event.SrcFilename),
+	)
+	if event.TargetFilename != "" {
+		fmt.Fprintf(&b, " target_path=\"%s\"", escapeSDParam(event.TargetFilename))
+	}
+	if event.Exe != "" {
+		fmt.Fprintf(&b, " exe=\"%s\"", escapeSDParam(event.Exe))
+	}
+	if event.ContainerID != "" {
+		fmt.Fprintf(&b, " container_id=\"%s\"", event.ContainerID)
+	}
+	b.WriteString("] ")
+	b.WriteString(text)
+	if !so.stream {
+		return b.Bytes(), nil
+	}
+	return append([]byte(strconv.Itoa(b.Len())+" "), b.Bytes()...), nil
+}
+
+// Close - Closes the connection to the syslog server
+func (so *SyslogOutput) Close() error {
+	if so.conn == nil {
+		return nil
+	}
+	return so.conn.Close()
+}
+
+// sdParamEscaper - Escapes the characters that aren't allowed in the parameter values of the structured data
+var sdParamEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+func escapeSDParam(value string) string {
+	return sdParamEscaper.Replace(strings.ToValidUTF8(value, "?"))
+}
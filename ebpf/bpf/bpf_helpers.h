@@ -64,6 +64,8 @@ static int (*bpf_get_current_comm)(void *buf, int buf_size) =
 	(void *)BPF_FUNC_get_current_comm;
 static unsigned long long (*bpf_get_current_task)(void) =
 	(void *)BPF_FUNC_get_current_task;
+static unsigned long long (*bpf_get_current_cgroup_id)(void) =
+	(void *)BPF_FUNC_get_current_cgroup_id;
 static unsigned long long (*bpf_perf_event_read)(void *map,
 												 unsigned long long flags) =
 	(void *)BPF_FUNC_perf_event_read;
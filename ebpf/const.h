@@ -46,4 +46,34 @@ __attribute__((always_inline)) static u64 load_recursive_mode() {
     return recursive_mode;
 }
 
+// load_vfsmount_mnt_id_offset - Loads the offset of mount->mnt_id relative to mount->mnt, computed at load time
+__attribute__((always_inline)) static s64 load_vfsmount_mnt_id_offset() {
+    s64 vfsmount_mnt_id_offset = 0;
+    LOAD_CONSTANT("vfsmount_mnt_id_offset", vfsmount_mnt_id_offset);
+    return vfsmount_mnt_id_offset;
+}
+
+// load_sb_mounts_mnt_id_offset - Loads the offset of mount->mnt_id relative to the mount pointed by
+// super_block->s_mounts, computed at load time
+__attribute__((always_inline)) static s64 load_sb_mounts_mnt_id_offset() {
+    s64 sb_mounts_mnt_id_offset = 0;
+    LOAD_CONSTANT("sb_mounts_mnt_id_offset", sb_mounts_mnt_id_offset);
+    return sb_mounts_mnt_id_offset;
+}
+
+// load_sb_mounts_mnt_mountpoint_offset - Loads the offset of mount->mnt_mountpoint relative to the mount pointed by
+// super_block->s_mounts, computed at load time
+__attribute__((always_inline)) static s64 load_sb_mounts_mnt_mountpoint_offset() {
+    s64 sb_mounts_mnt_mountpoint_offset = 0;
+    LOAD_CONSTANT("sb_mounts_mnt_mountpoint_offset", sb_mounts_mnt_mountpoint_offset);
+    return sb_mounts_mnt_mountpoint_offset;
+}
+
+// load_mnt_ns_inum_offset - Loads the offset of mnt_namespace->ns.inum, computed at load time
+__attribute__((always_inline)) static s64 load_mnt_ns_inum_offset() {
+    s64 mnt_ns_inum_offset = 0;
+    LOAD_CONSTANT("mnt_ns_inum_offset", mnt_ns_inum_offset);
+    return mnt_ns_inum_offset;
+}
+
 #endif
@@ -44,7 +44,8 @@ __attribute__((always_inline)) void write_inode_short_ino(struct inode *inode, u
     bpf_probe_read(ino, sizeof(u32), &inode->i_ino);
 }
 
-// get_inode_mount_id - Returns the mount id of an inode structure
+// get_inode_mount_id - Returns the mount id of an inode structure. An inode doesn't know through which mount point it was
+// reached: the ID of the first (oldest) mount of its superblock is returned, user space watches inodes on all of them.
 __attribute__((always_inline)) int get_inode_mount_id(struct inode *dir)
 {
     // Mount ID
@@ -55,8 +56,8 @@ __attribute__((always_inline)) int get_inode_mount_id(struct inode *dir)
     struct list_head s_mounts;
     bpf_probe_read(&s_mounts, sizeof(s_mounts), &spb->s_mounts);
 
-    bpf_probe_read(&mount_id, sizeof(int), (void *)s_mounts.next + 172);
-    // bpf_probe_read(&mount_id, sizeof(int), &((struct mount *) s_mounts.next)->mnt_id);
+    // The offset of mnt_id is computed at load time from the kernel BTF type information
+    bpf_probe_read(&mount_id, sizeof(int), (void *)s_mounts.next + load_sb_mounts_mnt_id_offset());
 
     return mount_id;
 }
@@ -103,8 +104,8 @@ __attribute__((always_inline)) struct dentry *get_inode_mountpoint(struct inode
     struct list_head s_mounts;
     bpf_probe_read(&s_mounts, sizeof(s_mounts), &spb->s_mounts);
 
-    // bpf_probe_read(&mountpoint, sizeof(mountpoint), (void *) s_mounts.next - offsetof(struct mount, mnt_instance) + offsetof(struct mount, mnt_mountpoint));
-    bpf_probe_read(&mountpoint, sizeof(mountpoint), (void *) s_mounts.next - 88);
+    // The offset of mnt_mountpoint is computed at load time from the kernel BTF type information
+    bpf_probe_read(&mountpoint, sizeof(mountpoint), (void *) s_mounts.next + load_sb_mounts_mnt_mountpoint_offset());
 
     return mountpoint;
 }
@@ -139,7 +140,9 @@ __attribute__((always_inline)) struct dentry *get_file_dentry(struct file *file)
 // @path_builder: pointer to the fs event wrapper that contains an initialized path builder buffer
 // @cursor: pointer to the position in the buffer where the path should be written
 // @dentry: pointer to the dentry to resolve
-__attribute__((always_inline)) static u32 build_path(struct fs_event_wrapper_t *path_builder, u32 *cursor, struct dentry *dentry, u32 *last_inode)
+// @mount_id: mount ID of the dentry to resolve
+// @last_inode: pointer to the inode at which the resolution stopped
+__attribute__((always_inline)) static u32 build_path(struct fs_event_wrapper_t *path_builder, u32 *cursor, struct dentry *dentry, u32 mount_id, u64 *last_inode)
 {
     struct qstr qstr;
     struct dentry *d_parent;
@@ -147,6 +150,8 @@ __attribute__((always_inline)) static u32 build_path(struct fs_event_wrapper_t *
     u32 path_len = 0;
     u32 offset = 0;
     struct inode *inode_tmp;
+    struct path_key_t key = {};
+    key.mount_id = mount_id;
 
     #pragma unroll
     for (int i = 0; i < PATH_BUILDER_MAX_DEPTH; i++)
@@ -160,8 +165,9 @@ __attribute__((always_inline)) static u32 build_path(struct fs_event_wrapper_t *
 
         // Check if we can stop resolution early
         write_dentry_inode(dentry, &inode_tmp);
-        write_inode_short_ino(inode_tmp, last_inode);
-        if (bpf_map_lookup_elem(&cached_inodes, last_inode) != NULL) {
+        write_inode_ino(inode_tmp, &key.ino);
+        *last_inode = key.ino;
+        if (bpf_map_lookup_elem(&cached_inodes, &key) != NULL) {
             return path_len;
         }
 
@@ -255,15 +261,18 @@ __attribute__((always_inline)) static u32 resolve_perf_buffer(struct pt_regs *ct
     u32 path_len = 0;
     // Resolve paths
     if ((flag & RESOLVE_SRC) == RESOLVE_SRC) {
-        path_len = build_path(path_builder, &cache->cursor, cache->src_dentry, &cache->fs_event.src_path_key);
+        path_len = build_path(path_builder, &cache->cursor, cache->src_dentry, cache->fs_event.src_mount_id, &cache->fs_event.src_path_key);
         cache->fs_event.src_path_length = path_len;
     }
     if ((flag & RESOLVE_TARGET) == RESOLVE_TARGET) {
         if (cache->fs_event.event == EVENT_RENAME || cache->fs_event.event == EVENT_LINK) {
             // Make sure to resolve the new inode regardless of the cache
-            bpf_map_delete_elem(&cached_inodes, &cache->fs_event.target_inode);
+            struct path_key_t target_key = {};
+            target_key.ino = cache->fs_event.target_inode;
+            target_key.mount_id = cache->fs_event.target_mount_id;
+            bpf_map_delete_elem(&cached_inodes, &target_key);
         }
-        path_len = build_path(path_builder, &cache->cursor, cache->target_dentry, &cache->fs_event.target_path_key);
+        path_len = build_path(path_builder, &cache->cursor, cache->target_dentry, cache->fs_event.target_mount_id, &cache->fs_event.target_path_key);
         cache->fs_event.target_path_length = path_len;
     }
     if ((flag & EMIT_EVENT) == EMIT_EVENT) {
@@ -310,8 +319,8 @@ __attribute__((always_inline)) static u32 resolve_fragments(struct pt_regs *ctx,
 }
 
 // resolve_single_fragment - Resolves the paths of an event using the single fragment method. This method resolves the each path in
-// one entry of the single_fragments hashmap. The key used to refer to each entry is a random number sent back to user space
-// through the fs_events perf event buffer.
+// one entry of the single_fragments hashmap. Each entry is keyed by the mount ID of the path and a number sent back to user space
+// through the fs_events perf event buffer (the inode of the path, or a random number for the source of a rename or a link).
 // @ctx: pointer to the registers context structure used to send the perf event.
 // @cache: pointer to the dentry_cache_t structure that contains the source and target dentry to resolve
 // @fs_event: pointer to an fs_event structure on the stack of the eBPF program that will be used to send the perf event
@@ -322,33 +331,38 @@ __attribute__((always_inline)) static u32 resolve_single_fragment(struct pt_regs
     if (!path_builder)
         return 0;
     u32 path_len = 0;
-    u32 dummy_inode = 0;
+    u64 dummy_inode = 0;
+    struct path_key_t key = {};
     // Resolve paths
     if ((flag & RESOLVE_SRC) == RESOLVE_SRC) {
         if (cache->fs_event.event != EVENT_RENAME && cache->fs_event.event != EVENT_LINK) {
             cache->fs_event.src_path_key = cache->fs_event.src_inode;
         }
+        key.ino = cache->fs_event.src_path_key;
+        key.mount_id = cache->fs_event.src_mount_id;
         // Only resolve if necessary
-        if (bpf_map_lookup_elem(&single_fragments, &cache->fs_event.src_path_key) == NULL) {
-            path_len = build_path(path_builder, &cache->cursor, cache->src_dentry, &dummy_inode);
+        if (bpf_map_lookup_elem(&single_fragments, &key) == NULL) {
+            path_len = build_path(path_builder, &cache->cursor, cache->src_dentry, cache->fs_event.src_mount_id, &dummy_inode);
             cache->fs_event.src_path_length = path_len;
             // Save fragment
-            bpf_map_update_elem(&single_fragments, &cache->fs_event.src_path_key, path_builder->buff, BPF_ANY);
+            bpf_map_update_elem(&single_fragments, &key, path_builder->buff, BPF_ANY);
         }
     }
     if ((flag & RESOLVE_TARGET) == RESOLVE_TARGET) {
         cache->fs_event.target_path_key = cache->fs_event.target_inode;
+        key.ino = cache->fs_event.target_path_key;
+        key.mount_id = cache->fs_event.target_mount_id;
         if (cache->fs_event.event == EVENT_RENAME || cache->fs_event.event == EVENT_LINK) {
             // Make sure to resolve the new inode regardless of the cache
-            bpf_map_delete_elem(&single_fragments, &cache->fs_event.target_path_key);
+            bpf_map_delete_elem(&single_fragments, &key);
         }
-        if (bpf_map_lookup_elem(&single_fragments, &cache->fs_event.target_path_key) == NULL) {
+        if (bpf_map_lookup_elem(&single_fragments, &key) == NULL) {
             // Reset cursor
             cache->cursor = 0;
-            path_len = build_path(path_builder, &cache->cursor, cache->target_dentry, &dummy_inode);
+            path_len = build_path(path_builder, &cache->cursor, cache->target_dentry, cache->fs_event.target_mount_id, &dummy_inode);
             cache->fs_event.target_path_length = path_len;
             // Save fragment
-            bpf_map_update_elem(&single_fragments, &cache->fs_event.target_path_key, path_builder->buff, BPF_ANY);
+            bpf_map_update_elem(&single_fragments, &key, path_builder->buff, BPF_ANY);
         }
     }
     if ((flag & EMIT_EVENT) == EMIT_EVENT) {
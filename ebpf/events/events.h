@@ -16,6 +16,7 @@ limitations under the License.
 #ifndef _EVENTS_H_
 #define _EVENTS_H_
 
+#include "exec.h"
 #include "link.h"
 #include "mkdir.h"
 #include "modify.h"
@@ -23,6 +24,7 @@ limitations under the License.
 #include "rename.h"
 #include "rmdir.h"
 #include "setattr.h"
+#include "symlink.h"
 #include "unlink.h"
 
 #endif
@@ -0,0 +1,74 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+#ifndef _EXEC_H_
+#define _EXEC_H_
+
+// sched_process_exec_args - Arguments of the sched/sched_process_exec tracepoint, see
+// /sys/kernel/debug/tracing/events/sched/sched_process_exec/format
+struct sched_process_exec_args
+{
+    unsigned short common_type;
+    unsigned char common_flags;
+    unsigned char common_preempt_count;
+    int common_pid;
+
+    int data_loc_filename;
+    pid_t pid;
+    pid_t old_pid;
+};
+
+// trace_process_exec - Sends the executable path and the arguments of a new program to user space. The events of
+// the processes dropped by the process filters aren't sent.
+// @args: arguments of the tracepoint
+__attribute__((always_inline)) static int trace_process_exec(struct sched_process_exec_args *args)
+{
+    u32 key = 0;
+    struct exec_event_t *evt = bpf_map_lookup_elem(&exec_event_builder, &key);
+    if (!evt)
+        return 0;
+    fill_process_data(&evt->process_data);
+    if (!filter_process(&evt->process_data))
+        return 0;
+
+    // Executable path, the lower 16 bits of the __data_loc field are its offset in the arguments of the tracepoint
+    int len = bpf_probe_read_str(&evt->filename, sizeof(evt->filename), (void *)args + (args->data_loc_filename & 0xFFFF));
+    evt->filename_length = len > 0 ? len : 0;
+
+    // Arguments, the memory of the new program is already set up
+    struct task_struct *task = (struct task_struct *)bpf_get_current_task();
+    struct mm_struct *mm;
+    unsigned long arg_start, arg_end;
+    bpf_probe_read(&mm, sizeof(mm), &task->mm);
+    bpf_probe_read(&arg_start, sizeof(arg_start), &mm->arg_start);
+    bpf_probe_read(&arg_end, sizeof(arg_end), &mm->arg_end);
+    u32 args_len = arg_end - arg_start;
+    if (args_len >= EXEC_ARGS_LEN) {
+        // The arguments are truncated, user space reads them from /proc when the process is still alive
+        args_len = EXEC_ARGS_LEN - 1;
+    }
+    // & (EXEC_ARGS_LEN - 1) is required by the verifier to bound the size of the copy
+    args_len &= EXEC_ARGS_LEN - 1;
+    if (args_len > 0) {
+        bpf_probe_read(&evt->args, args_len, (void *)arg_start);
+    }
+    evt->args_length = args_len;
+
+    u32 cpu = bpf_get_smp_processor_id();
+    bpf_perf_event_output(args, &process_events, cpu, evt, sizeof(*evt));
+    return 0;
+}
+
+#endif
@@ -48,6 +48,8 @@ __attribute__((always_inline)) static int trace_link(struct pt_regs *ctx, struct
     data_cache->src_dentry = old_dentry;
     data_cache->target_dir = new_dir;
     data_cache->target_dentry = new_dentry;
+    // Add new mount ID, the target filter needs it
+    data_cache->fs_event.target_mount_id = get_inode_mount_id(new_dir);
 
     // Filter
     if (filter(data_cache, FILTER_SRC) || filter(data_cache, FILTER_TARGET)) {
@@ -70,11 +70,14 @@ __attribute__((always_inline)) static int trace_mkdir_ret(struct pt_regs *ctx)
     // Resolve paths
     resolve_paths(ctx, data_cache, RESOLVE_SRC | EMIT_EVENT);
 
-    // Check recursive mode and insert inode if necessary
+    // Check recursive mode and insert inode if necessary, unless the name of the directory was excluded
     u64 recursive = load_recursive_mode();
-    if (recursive) {
+    if (recursive && !is_excluded_dentry(data_cache->src_dentry)) {
         u8 value = 0;
-        bpf_map_update_elem(&inodes_filter, &data_cache->fs_event.src_inode, &value, BPF_ANY);
+        struct path_key_t filter_key = {};
+        filter_key.ino = data_cache->fs_event.src_inode;
+        filter_key.mount_id = data_cache->fs_event.src_mount_id;
+        bpf_map_update_elem(&inodes_filter, &filter_key, &value, BPF_ANY);
     }
 
     bpf_map_delete_elem(&dentry_cache, &key);
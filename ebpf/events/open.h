@@ -41,7 +41,7 @@ __attribute__((always_inline)) static int trace_open(struct pt_regs *ctx, struct
     // Mount ID
     struct vfsmount *mnt;
     bpf_probe_read(&mnt, sizeof(struct vfsmount *), &path->mnt);
-    bpf_probe_read(&data_cache->fs_event.src_mount_id, sizeof(int), (void *)mnt + 252);
+    bpf_probe_read(&data_cache->fs_event.src_mount_id, sizeof(int), (void *)mnt + load_vfsmount_mnt_id_offset());
 
     // Dentry data
     data_cache->src_dentry = dentry;
@@ -47,6 +47,8 @@ __attribute__((always_inline)) static int trace_rename(struct pt_regs *ctx, stru
     data_cache->src_dentry = old_dentry;
     data_cache->target_dir = new_dir;
     data_cache->target_dentry = new_dentry;
+    // Add new mount ID, the target filter needs it
+    data_cache->fs_event.target_mount_id = get_inode_mount_id(new_dir);
 
     // Filter
     if (filter(data_cache, FILTER_SRC) ||filter(data_cache, FILTER_TARGET) ) {
@@ -80,7 +82,10 @@ __attribute__((always_inline)) static int trace_rename_ret(struct pt_regs *ctx)
     u64 follow_mode = load_follow_mode();
     if (follow_mode) {
         u8 value = 0;
-        bpf_map_update_elem(&inodes_filter, &data_cache->fs_event.target_inode, &value, BPF_ANY);
+        struct path_key_t filter_key = {};
+        filter_key.ino = data_cache->fs_event.target_inode;
+        filter_key.mount_id = data_cache->fs_event.target_mount_id;
+        bpf_map_update_elem(&inodes_filter, &filter_key, &value, BPF_ANY);
     }
 
     // Delete cache entry
@@ -0,0 +1,120 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+#ifndef _SYMLINK_H_
+#define _SYMLINK_H_
+
+// trace_symlink - Traces a file system symlink event.
+// @ctx: registers context
+// @dir: pointer to the inode of the containing directory
+// @dentry: pointer to the dentry structure of the new symlink
+// @oldname: pointer to the link target string
+__attribute__((always_inline)) static int trace_symlink(struct pt_regs *ctx, struct inode *dir, struct dentry *dentry, const char *oldname)
+{
+    u32 cpu = bpf_get_smp_processor_id();
+    struct dentry_cache_t *data_cache = bpf_map_lookup_elem(&dentry_cache_builder, &cpu);
+    if (!data_cache)
+        return 0;
+    // Reset pathname keys (could mess up resolution if there was some leftover data)
+    data_cache->fs_event.src_path_key = 0;
+    data_cache->fs_event.target_path_key = 0;
+    data_cache->cursor = 0;
+    // Add process data
+    u64 key = fill_process_data(&data_cache->fs_event.process_data);
+    // Probe type
+    data_cache->fs_event.event = EVENT_SYMLINK;
+
+    // Mount ID
+    data_cache->fs_event.src_mount_id = get_inode_mount_id(dir);
+
+    // The link target isn't a dentry, it is sent after the paths of the event
+    data_cache->fs_event.target_inode = 0;
+    data_cache->fs_event.target_mount_id = 0;
+    data_cache->symlink_target = oldname;
+
+    // Dentry data
+    data_cache->src_dentry = dentry;
+
+    // Filter
+    if (!filter(data_cache, FILTER_SRC))
+        return 0;
+
+    // Send to cache
+    bpf_map_update_elem(&dentry_cache, &key, data_cache, BPF_ANY);
+    return 0;
+}
+
+// emit_symlink_event - Appends the link target of a symlink event to the paths of the event, and sends the event. Only
+// the perf buffer resolution mode sends the paths along with the event, the other modes send the link target right
+// after the event with the symlink_builder map.
+// @ctx: pointer to the registers context structure used to send the perf event.
+// @cache: pointer to the dentry_cache_t structure of the symlink event
+__attribute__((always_inline)) static int emit_symlink_event(struct pt_regs *ctx, struct dentry_cache_t *cache)
+{
+    u32 cpu = bpf_get_smp_processor_id();
+    int copied = 0;
+    if (load_dentry_resolution_mode() != DENTRY_RESOLUTION_PERF_BUFFER) {
+        u32 key = 0;
+        struct symlink_event_t *symlink = bpf_map_lookup_elem(&symlink_builder, &key);
+        if (!symlink)
+            return 0;
+        copied = bpf_probe_read_str(&symlink->target, NAME_MAX, (void *) cache->symlink_target);
+        if (copied < 0)
+            copied = 0;
+        cache->fs_event.target_path_length = copied & NAME_MAX;
+        bpf_probe_read(&symlink->evt, sizeof(cache->fs_event), &cache->fs_event);
+        bpf_perf_event_output(ctx, &fs_events, cpu, symlink, sizeof(struct fs_event_t) + (copied & NAME_MAX));
+        return 0;
+    }
+
+    struct fs_event_wrapper_t *path_builder = bpf_map_lookup_elem(&paths_builder, &cache->fs_event.process_data.tid);
+    if (!path_builder)
+        return 0;
+    u32 offset = 0;
+    bpf_probe_read(&offset, sizeof(u32), &cache->cursor);
+    // The link target is truncated to NAME_MAX bytes, see build_path for the masks required by the verifier
+    copied = bpf_probe_read_str(&path_builder->buff[offset & (PATH_BUFFER_SIZE - NAME_MAX - 1)], NAME_MAX, (void *) cache->symlink_target);
+    if (copied < 0)
+        copied = 0;
+    cache->fs_event.target_path_length = copied & NAME_MAX;
+    cache->cursor += cache->fs_event.target_path_length;
+
+    bpf_probe_read(&path_builder->evt, sizeof(cache->fs_event), &cache->fs_event);
+    bpf_perf_event_output(ctx, &fs_events, cpu, path_builder, sizeof(struct fs_event_t) + (cache->cursor & (PATH_BUFFER_SIZE - NAME_MAX - 1)));
+    return 0;
+}
+
+// trace_symlink_ret - Traces the return of a file system symlink event.
+// @ctx: registers context
+__attribute__((always_inline)) static int trace_symlink_ret(struct pt_regs *ctx)
+{
+    u64 key = bpf_get_current_pid_tgid();
+    struct dentry_cache_t *data_cache = bpf_map_lookup_elem(&dentry_cache, &key);
+    if (!data_cache)
+        return 0;
+    data_cache->fs_event.retval = PT_REGS_RC(ctx);
+
+    // Add inode data
+    data_cache->fs_event.src_inode = get_dentry_ino(data_cache->src_dentry);
+
+    // Resolve the path of the new symlink, and send it along with its link target
+    resolve_paths(ctx, data_cache, RESOLVE_SRC);
+    emit_symlink_event(ctx, data_cache);
+
+    bpf_map_delete_elem(&dentry_cache, &key);
+    return 0;
+}
+
+#endif
@@ -19,38 +19,104 @@ limitations under the License.
 #define FILTER_SRC     1 << 1
 #define FILTER_TARGET  1 << 2
 
-__attribute__((always_inline)) static int filter_src(struct dentry_cache_t *data_cache)
+// filter_dentry - Looks for the provided dentry, or its parent, in the inodes_filter map
+// @dentry: pointer to the dentry to look for
+// @key: pointer to the inodes_filter key, prefilled with the inode and mount ID of the dentry
+__attribute__((always_inline)) static int filter_dentry(struct dentry *dentry, struct path_key_t *key)
 {
-    // Look for the inode in the cached_inodes map
-    if (bpf_map_lookup_elem(&inodes_filter, &data_cache->fs_event.src_inode) == NULL) {
+    // Look for the inode in the inodes_filter map
+    if (bpf_map_lookup_elem(&inodes_filter, key) == NULL) {
         // Look for the parent inode
         struct dentry *d_parent;
-        bpf_probe_read(&d_parent, sizeof(d_parent), &data_cache->src_dentry->d_parent);
-        u32 ino = get_dentry_ino(d_parent);
-        if (bpf_map_lookup_elem(&inodes_filter, &ino) == NULL) {
+        bpf_probe_read(&d_parent, sizeof(d_parent), &dentry->d_parent);
+        key->ino = get_dentry_ino(d_parent);
+        if (bpf_map_lookup_elem(&inodes_filter, key) == NULL) {
             return 0;
         }
     }
     return 1;
 }
 
+__attribute__((always_inline)) static int filter_src(struct dentry_cache_t *data_cache)
+{
+    struct path_key_t key = {};
+    key.ino = data_cache->fs_event.src_inode;
+    key.mount_id = data_cache->fs_event.src_mount_id;
+    return filter_dentry(data_cache->src_dentry, &key);
+}
+
 __attribute__((always_inline)) static int filter_target(struct dentry_cache_t *data_cache)
 {
-    // Look for the inode in the cached_inodes map
-    if (bpf_map_lookup_elem(&inodes_filter, &data_cache->fs_event.target_inode) == NULL) {
-        // Look for the parent inode
-        struct dentry *d_parent;
-        bpf_probe_read(&d_parent, sizeof(d_parent), &data_cache->target_dentry->d_parent);
-        u32 ino = get_dentry_ino(d_parent);
-        if (bpf_map_lookup_elem(&inodes_filter, &ino) == NULL) {
-            return 0;
-        }
+    struct path_key_t key = {};
+    key.ino = data_cache->fs_event.target_inode;
+    key.mount_id = data_cache->fs_event.target_mount_id;
+    return filter_dentry(data_cache->target_dentry, &key);
+}
+
+// is_excluded_dentry - Returns 1 if the name of the provided dentry is in the excluded_names map
+// @dentry: pointer to the dentry to check
+__attribute__((always_inline)) static int is_excluded_dentry(struct dentry *dentry)
+{
+    struct excluded_name_t key = {};
+    struct qstr qstr;
+    bpf_probe_read(&qstr, sizeof(qstr), &dentry->d_name);
+    bpf_probe_read_str(&key.name, sizeof(key.name), (void *) qstr.name);
+    return bpf_map_lookup_elem(&excluded_names, &key) != NULL;
+}
+
+// process_filter_verdict - Returns 1 if the event should be kept according to a process filter
+// @action: action found in the process filter map, NULL if the process isn't in the map
+// @type: type of the process filter
+__attribute__((always_inline)) static int process_filter_verdict(u8 *action, u32 type)
+{
+    if (action != NULL) {
+        return (*action & PROCESS_FILTER_ACTION_MASK) == PROCESS_FILTER_ALLOW;
+    }
+    // The process isn't in the filter, drop the event if an allow list was pushed for this type of filter
+    u32 *allow_count = bpf_map_lookup_elem(&process_filters_allow_count, &type);
+    return allow_count == NULL || *allow_count == 0;
+}
+
+// filter_process - Checks the process context of an event against the process filters
+// @process_data: pointer to the process context of the event
+__attribute__((always_inline)) static int filter_process(struct process_ctx_t *process_data)
+{
+    // Pid (tgid) & tid
+    u8 *action = bpf_map_lookup_elem(&pid_filter, &process_data->pid);
+    if (action == NULL) {
+        action = bpf_map_lookup_elem(&pid_filter, &process_data->tid);
+    }
+    if (!process_filter_verdict(action, PROCESS_FILTER_PID)) {
+        return 0;
+    }
+    // Comm
+    action = bpf_map_lookup_elem(&comm_filter, process_data->comm);
+    if (!process_filter_verdict(action, PROCESS_FILTER_COMM)) {
+        return 0;
+    }
+    // UID & GID
+    action = bpf_map_lookup_elem(&uid_filter, &process_data->uid);
+    if (!process_filter_verdict(action, PROCESS_FILTER_UID)) {
+        return 0;
+    }
+    action = bpf_map_lookup_elem(&gid_filter, &process_data->gid);
+    if (!process_filter_verdict(action, PROCESS_FILTER_GID)) {
+        return 0;
+    }
+    // Cgroup
+    u64 cgroup_id = bpf_get_current_cgroup_id();
+    action = bpf_map_lookup_elem(&cgroup_filter, &cgroup_id);
+    if (!process_filter_verdict(action, PROCESS_FILTER_CGROUP)) {
+        return 0;
     }
     return 1;
 }
 
 __attribute__((always_inline)) static int filter(struct dentry_cache_t *data_cache, u8 flag)
 {
+    if (!filter_process(&data_cache->fs_event.process_data)) {
+        return 0;
+    }
     u64 inode_filtering_mode = load_inode_filtering_mode();
     if (inode_filtering_mode == 0) {
         return 1;
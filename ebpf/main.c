@@ -100,6 +100,23 @@ int kretprobe_vfs_link(struct pt_regs *ctx)
     return trace_link_ret(ctx);
 }
 
+// SYMLINK
+
+SEC("kprobe/vfs_symlink")
+int kprobe_vfs_symlink(struct pt_regs *ctx)
+{
+    struct inode *dir = (struct inode *)PT_REGS_PARM1(ctx);
+    struct dentry *dentry = (struct dentry *)PT_REGS_PARM2(ctx);
+    const char *oldname = (const char *)PT_REGS_PARM3(ctx);
+    return trace_symlink(ctx, dir, dentry, oldname);
+}
+
+SEC("kretprobe/vfs_symlink")
+int kretprobe_vfs_symlink(struct pt_regs *ctx)
+{
+    return trace_symlink_ret(ctx);
+}
+
 // RENAME
 
 SEC("kprobe/vfs_rename")
@@ -149,5 +166,27 @@ int kretprobe_security_inode_setattr(struct pt_regs *ctx)
     return trace_setattr_ret(ctx);
 }
 
+// PROCESS TREE
+
+SEC("tracepoint/sched/sched_process_fork")
+int tracepoint_sched_process_fork(struct sched_process_fork_args *args)
+{
+    return trace_process_fork(args);
+}
+
+SEC("tracepoint/sched/sched_process_exit")
+int tracepoint_sched_process_exit(void *args)
+{
+    return trace_process_exit();
+}
+
+// PROCESS CONTEXT
+
+SEC("tracepoint/sched/sched_process_exec")
+int tracepoint_sched_process_exec(struct sched_process_exec_args *args)
+{
+    return trace_process_exec(args);
+}
+
 char _license[] SEC("license") = "GPL";
 __u32 _version SEC("version") = 0xFFFFFFFE;
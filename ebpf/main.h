@@ -68,9 +68,10 @@ limitations under the License.
 #include "bpf/bpf.h"
 #include "bpf/bpf_map.h"
 #include "bpf/bpf_helpers.h"
+#include "const.h"
 #include "process.h"
 #include "structs.h"
-#include "const.h"
 #include "dentry.h"
 #include "filter.h"
+#include "process_tree.h"
 #include "events/events.h"
@@ -29,6 +29,11 @@ struct process_ctx_t
     u32 uid;
     u32 gid;
     char comm[TASK_COMM_LEN];
+    u32 ppid;
+    u32 mnt_ns;
+    u32 pid_ns;
+    u32 padding;
+    u64 cgroup_id;
 };
 
 // fill_process_data - Fills the provided process_ctx_t with the process context available from eBPF
@@ -42,10 +47,30 @@ __attribute__((always_inline)) static u64 fill_process_data(struct process_ctx_t
     data->pid = id >> 32;
     data->tid = id;
 
-    // UID & GID
+    // UID & GID (the helper returns gid << 32 | uid)
     u64 userid = bpf_get_current_uid_gid();
-    data->uid = userid >> 32;
-    data->gid = userid;
+    data->uid = userid;
+    data->gid = userid >> 32;
+
+    // Ppid (tgid of the real parent)
+    struct task_struct *task = (struct task_struct *)bpf_get_current_task();
+    struct task_struct *parent;
+    bpf_probe_read(&parent, sizeof(parent), &task->real_parent);
+    bpf_probe_read(&data->ppid, sizeof(data->ppid), &parent->tgid);
+
+    // Namespaces, struct mnt_namespace isn't exported by the kernel headers
+    struct nsproxy *nsproxy;
+    bpf_probe_read(&nsproxy, sizeof(nsproxy), &task->nsproxy);
+    struct mnt_namespace *mnt_ns;
+    bpf_probe_read(&mnt_ns, sizeof(mnt_ns), &nsproxy->mnt_ns);
+    bpf_probe_read(&data->mnt_ns, sizeof(data->mnt_ns), (void *)mnt_ns + load_mnt_ns_inum_offset());
+    // pid_ns_for_children is the pid namespace of the task, unless it called unshare(CLONE_NEWPID)
+    struct pid_namespace *pid_ns;
+    bpf_probe_read(&pid_ns, sizeof(pid_ns), &nsproxy->pid_ns_for_children);
+    bpf_probe_read(&data->pid_ns, sizeof(data->pid_ns), &pid_ns->ns.inum);
+
+    // Cgroup v2
+    data->cgroup_id = bpf_get_current_cgroup_id();
     return id;
 }
 
@@ -0,0 +1,69 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+#ifndef _PROCESS_TREE_H_
+#define _PROCESS_TREE_H_
+
+// sched_process_fork_args - Arguments of the sched/sched_process_fork tracepoint, see
+// /sys/kernel/debug/tracing/events/sched/sched_process_fork/format
+struct sched_process_fork_args
+{
+    unsigned short common_type;
+    unsigned char common_flags;
+    unsigned char common_preempt_count;
+    int common_pid;
+
+    char parent_comm[TASK_COMM_LEN];
+    pid_t parent_pid;
+    char child_comm[TASK_COMM_LEN];
+    pid_t child_pid;
+};
+
+// trace_process_fork - Adds the new task to the pid filter when the task that forked it is in the pid filter, so
+// that the process filters apply to the entire process tree
+// @args: arguments of the tracepoint
+__attribute__((always_inline)) static int trace_process_fork(struct sched_process_fork_args *args)
+{
+    // The tracepoint runs in the context of the parent, look for its pid (tgid) and then for its tid
+    u64 id = bpf_get_current_pid_tgid();
+    u32 pid = id >> 32;
+    u8 *action = bpf_map_lookup_elem(&pid_filter, &pid);
+    if (action == NULL) {
+        u32 tid = id;
+        action = bpf_map_lookup_elem(&pid_filter, &tid);
+        if (action == NULL) {
+            return 0;
+        }
+    }
+    u32 child_pid = args->child_pid;
+    u8 child_action = (*action & PROCESS_FILTER_ACTION_MASK) | PROCESS_FILTER_INHERITED;
+    bpf_map_update_elem(&pid_filter, &child_pid, &child_action, BPF_NOEXIST);
+    return 0;
+}
+
+// trace_process_exit - Removes the exiting task from the pid filter if it was added by trace_process_fork. The
+// entries pushed by user space are left untouched.
+__attribute__((always_inline)) static int trace_process_exit()
+{
+    u32 tid = bpf_get_current_pid_tgid();
+    u8 *action = bpf_map_lookup_elem(&pid_filter, &tid);
+    if (action == NULL || (*action & PROCESS_FILTER_INHERITED) == 0) {
+        return 0;
+    }
+    bpf_map_delete_elem(&pid_filter, &tid);
+    return 0;
+}
+
+#endif
@@ -27,6 +27,7 @@ enum event_type
     EVENT_RMDIR,
     EVENT_MODIFY,
     EVENT_SETATTR,
+    EVENT_SYMLINK,
 };
 
 // fs_event_t - File system event structure
@@ -35,8 +36,8 @@ struct fs_event_t
     struct process_ctx_t process_data;
     int flags;
     int mode;
-    u32 src_path_key;
-    u32 target_path_key;
+    u64 src_path_key;
+    u64 target_path_key;
     u64 src_inode;
     u32 src_path_length;
     int src_mount_id;
@@ -57,6 +58,41 @@ struct bpf_map_def SEC("maps/fs_events") fs_events = {
     .namespace = "",
 };
 
+// EXEC_FILENAME_LEN - Maximum size of the executable path sent with an exec event
+#define EXEC_FILENAME_LEN 256
+// EXEC_ARGS_LEN - Maximum size of the arguments sent with an exec event, must be a power of 2
+#define EXEC_ARGS_LEN 512
+
+// exec_event_t - Process execution event structure, used to populate the process cache of user space
+struct exec_event_t
+{
+    struct process_ctx_t process_data;
+    u32 filename_length;
+    u32 args_length;
+    char filename[EXEC_FILENAME_LEN];
+    char args[EXEC_ARGS_LEN];
+};
+
+// process_events - Perf buffer used to send process execution events back to user space
+struct bpf_map_def SEC("maps/process_events") process_events = {
+    .type = BPF_MAP_TYPE_PERF_EVENT_ARRAY,
+    .key_size = 0,
+    .value_size = 0,
+    .max_entries = 0,
+    .pinning = PIN_NONE,
+    .namespace = "",
+};
+
+// exec_event_builder - Map used to build exec events, they don't fit on the stack of the eBPF program
+struct bpf_map_def SEC("maps/exec_event_builder") exec_event_builder = {
+    .type = BPF_MAP_TYPE_PERCPU_ARRAY,
+    .key_size = sizeof(u32),
+    .value_size = sizeof(struct exec_event_t),
+    .max_entries = 1,
+    .pinning = PIN_NONE,
+    .namespace = "",
+};
+
 // dentry_cache_t - Dentry cache structure used to cache context between kprobes entry and return
 struct dentry_cache_t
 {
@@ -65,6 +101,7 @@ struct dentry_cache_t
     struct dentry *src_dentry;
     struct inode *target_dir;
     struct dentry *target_dentry;
+    const char *symlink_target;
     u32 cursor;
 };
 
@@ -132,24 +169,151 @@ struct bpf_map_def SEC("maps/paths_builder") paths_builder = {
     .namespace = "",
 };
 
+// symlink_event_t - Symlink event structure of the resolution modes that don't send the paths with the events, the
+// link target is sent right after the event
+struct symlink_event_t {
+    struct fs_event_t evt;
+    char target[NAME_MAX + 1];
+};
+
+// symlink_builder - Map used to build the symlink events of the fragments and single fragment resolution modes
+struct bpf_map_def SEC("maps/symlink_builder") symlink_builder = {
+    .type = BPF_MAP_TYPE_PERCPU_ARRAY,
+    .key_size = sizeof(u32),
+    .value_size = sizeof(struct symlink_event_t),
+    .max_entries = 1,
+    .pinning = PIN_NONE,
+    .namespace = "",
+};
+
+// cached_inodes - Map used by the perf buffer method to know which inodes were already resolved in user space
 struct bpf_map_def SEC("maps/cached_inodes") cached_inodes = {
     .type = BPF_MAP_TYPE_LRU_HASH,
-    .key_size = sizeof(u32),
+    .key_size = sizeof(struct path_key_t),
     .value_size = sizeof(u8),
     .max_entries = 40000,
     .pinning = PIN_NONE,
     .namespace = "",
 };
 
+// inodes_filter - Map used to filter events on the (inode, mount ID) couples pushed by user space
 struct bpf_map_def SEC("maps/inodes_filter") inodes_filter = {
     .type = BPF_MAP_TYPE_LRU_HASH,
-    .key_size = sizeof(u32),
+    .key_size = sizeof(struct path_key_t),
     .value_size = sizeof(u8),
     .max_entries = 120000,
     .pinning = PIN_NONE,
     .namespace = "",
 };
 
+// EXCLUDED_NAME_SIZE - Maximum size (including the trailing NULL character) of the directory names that can be
+// excluded in kernel space
+#define EXCLUDED_NAME_SIZE 64
+
+// excluded_name_t - Structure used as the key of the excluded_names map
+struct excluded_name_t {
+    char name[EXCLUDED_NAME_SIZE];
+};
+
+// excluded_names - Map used to prevent the recursive mode from watching the new directories whose names were pushed
+// by user space
+struct bpf_map_def SEC("maps/excluded_names") excluded_names = {
+    .type = BPF_MAP_TYPE_HASH,
+    .key_size = sizeof(struct excluded_name_t),
+    .value_size = sizeof(u8),
+    .max_entries = 1024,
+    .pinning = PIN_NONE,
+    .namespace = "",
+};
+
+// PROCESS_FILTER_ALLOW - Process filter action used to allow the events of a process
+#define PROCESS_FILTER_ALLOW 1
+// PROCESS_FILTER_DENY - Process filter action used to drop the events of a process
+#define PROCESS_FILTER_DENY 2
+// PROCESS_FILTER_ACTION_MASK - Mask of the action of a process filter entry
+#define PROCESS_FILTER_ACTION_MASK 3
+// PROCESS_FILTER_INHERITED - Flag of the pid filter entries added in kernel space when a filtered process forks. Those
+// entries are removed when the task exits.
+#define PROCESS_FILTER_INHERITED 1 << 7
+
+// process_filter_type - Defines the type of a process filter
+enum process_filter_type
+{
+    PROCESS_FILTER_PID,
+    PROCESS_FILTER_COMM,
+    PROCESS_FILTER_UID,
+    PROCESS_FILTER_GID,
+    PROCESS_FILTER_CGROUP,
+    PROCESS_FILTER_MAX,
+};
+
+// comm_key_t - Structure used as the key of the comm_filter map
+struct comm_key_t {
+    char comm[TASK_COMM_LEN];
+};
+
+// pid_filter - Map used to allow or deny the events of the pids and tids pushed by user space. When the process tree
+// probes are activated, the descendants of those pids are added to the map when they are forked.
+struct bpf_map_def SEC("maps/pid_filter") pid_filter = {
+    .type = BPF_MAP_TYPE_HASH,
+    .key_size = sizeof(u32),
+    .value_size = sizeof(u8),
+    .max_entries = 16384,
+    .pinning = PIN_NONE,
+    .namespace = "",
+};
+
+// comm_filter - Map used to allow or deny the events of the process names pushed by user space
+struct bpf_map_def SEC("maps/comm_filter") comm_filter = {
+    .type = BPF_MAP_TYPE_HASH,
+    .key_size = sizeof(struct comm_key_t),
+    .value_size = sizeof(u8),
+    .max_entries = 4096,
+    .pinning = PIN_NONE,
+    .namespace = "",
+};
+
+// uid_filter - Map used to allow or deny the events of the uids pushed by user space
+struct bpf_map_def SEC("maps/uid_filter") uid_filter = {
+    .type = BPF_MAP_TYPE_HASH,
+    .key_size = sizeof(u32),
+    .value_size = sizeof(u8),
+    .max_entries = 4096,
+    .pinning = PIN_NONE,
+    .namespace = "",
+};
+
+// gid_filter - Map used to allow or deny the events of the gids pushed by user space
+struct bpf_map_def SEC("maps/gid_filter") gid_filter = {
+    .type = BPF_MAP_TYPE_HASH,
+    .key_size = sizeof(u32),
+    .value_size = sizeof(u8),
+    .max_entries = 4096,
+    .pinning = PIN_NONE,
+    .namespace = "",
+};
+
+// cgroup_filter - Map used to allow or deny the events of the cgroup IDs pushed by user space
+struct bpf_map_def SEC("maps/cgroup_filter") cgroup_filter = {
+    .type = BPF_MAP_TYPE_HASH,
+    .key_size = sizeof(u64),
+    .value_size = sizeof(u8),
+    .max_entries = 4096,
+    .pinning = PIN_NONE,
+    .namespace = "",
+};
+
+// process_filters_allow_count - Number of allow entries of each process filter type. When an allow list isn't empty,
+// the events of the processes that aren't in it are dropped. This map is maintained by user space.
+struct bpf_map_def SEC("maps/process_filters_allow_count") process_filters_allow_count = {
+    .type = BPF_MAP_TYPE_ARRAY,
+    .key_size = sizeof(u32),
+    .value_size = sizeof(u32),
+    .max_entries = PROCESS_FILTER_MAX,
+    .pinning = PIN_NONE,
+    .namespace = "",
+};
+
 // SINGLE_FRAGMENTS_SIZE - See the comment about PATH_BUFFER_SIZE. The same condition applies, however those map values
 // will only hold one path at a time. Therefore we can choose: 2**12 + NAME_MAX = 4096 + 255 = 4351
 #define SINGLE_FRAGMENTS_SIZE 4351
@@ -160,10 +324,10 @@ struct single_fragment_t
     char name[SINGLE_FRAGMENTS_SIZE];
 };
 
-// path_fragments - Map used to store path fragments. The user space program will recover the fragments from this map.
+// single_fragments - Map used to store single fragments. The user space program will recover the fragments from this map.
 struct bpf_map_def SEC("maps/single_fragments") single_fragments = {
     .type = BPF_MAP_TYPE_LRU_HASH,
-    .key_size = sizeof(u32),
+    .key_size = sizeof(struct path_key_t),
     .value_size = sizeof(struct single_fragment_t),
     .max_entries = 40000,
     .pinning = PIN_NONE,
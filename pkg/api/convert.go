@@ -0,0 +1,197 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package api contains the protobuf schema and the gRPC service of FSProbe. The code is generated with
+// `make build-api`.
+package api
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// NewFSEvent - Returns the protobuf representation of an event
+func NewFSEvent(evt *model.FSEvent) *FSEvent {
+	pe := &FSEvent{
+		Timestamp:           timestamppb.New(evt.Timestamp),
+		EventType:           string(evt.EventType),
+		Pid:                 evt.Pid,
+		Tid:                 evt.Tid,
+		Uid:                 evt.UID,
+		Gid:                 evt.GID,
+		Comm:                evt.Comm,
+		Ppid:                evt.Ppid,
+		Exe:                 evt.Exe,
+		Argv:                evt.Argv,
+		Cwd:                 evt.Cwd,
+		LoginUid:            evt.LoginUID,
+		CgroupId:            evt.CgroupID,
+		CgroupPath:          evt.CgroupPath,
+		ContainerId:         evt.ContainerID,
+		MntNs:               evt.MntNs,
+		PidNs:               evt.PidNs,
+		NsPid:               evt.NsPid,
+		NsTid:               evt.NsTid,
+		SrcContainerPath:    evt.SrcContainerPath,
+		SrcHostPath:         evt.SrcHostPath,
+		TargetContainerPath: evt.TargetContainerPath,
+		TargetHostPath:      evt.TargetHostPath,
+		Flags:               evt.Flags,
+		Mode:                evt.Mode,
+		Retval:              evt.Retval,
+		SrcInode:            evt.SrcInode,
+		SrcFilename:         evt.SrcFilename,
+		SrcMountId:          evt.SrcMountID,
+		TargetInode:         evt.TargetInode,
+		TargetFilename:      evt.TargetFilename,
+		TargetMountId:       evt.TargetMountID,
+		MountPoint:          evt.MountPoint,
+		FsType:              evt.FSType,
+		Device:              evt.Device,
+	}
+	switch evt.EventType {
+	case model.Open:
+		pe.DecodedFlags = model.OpenFlagsToStrings(evt.Flags)
+	case model.SetAttr:
+		pe.DecodedFlags = model.SetAttrFlagsToString(evt.Flags)
+	}
+	if evt.Retval < 0 {
+		pe.Errno = model.ErrValueToString(evt.Retval)
+	}
+	return pe
+}
+
+// ToModel - Returns the FSEvent described by the protobuf representation
+func (x *FSEvent) ToModel() *model.FSEvent {
+	evt := &model.FSEvent{
+		EventType:           model.EventName(x.GetEventType()),
+		Pid:                 x.GetPid(),
+		Tid:                 x.GetTid(),
+		UID:                 x.GetUid(),
+		GID:                 x.GetGid(),
+		Comm:                x.GetComm(),
+		Ppid:                x.GetPpid(),
+		Exe:                 x.GetExe(),
+		Argv:                x.GetArgv(),
+		Cwd:                 x.GetCwd(),
+		LoginUID:            x.GetLoginUid(),
+		CgroupID:            x.GetCgroupId(),
+		CgroupPath:          x.GetCgroupPath(),
+		ContainerID:         x.GetContainerId(),
+		MntNs:               x.GetMntNs(),
+		PidNs:               x.GetPidNs(),
+		NsPid:               x.GetNsPid(),
+		NsTid:               x.GetNsTid(),
+		SrcContainerPath:    x.GetSrcContainerPath(),
+		SrcHostPath:         x.GetSrcHostPath(),
+		TargetContainerPath: x.GetTargetContainerPath(),
+		TargetHostPath:      x.GetTargetHostPath(),
+		Flags:               x.GetFlags(),
+		Mode:                x.GetMode(),
+		Retval:              x.GetRetval(),
+		SrcInode:            x.GetSrcInode(),
+		SrcFilename:         x.GetSrcFilename(),
+		SrcMountID:          x.GetSrcMountId(),
+		TargetInode:         x.GetTargetInode(),
+		TargetFilename:      x.GetTargetFilename(),
+		TargetMountID:       x.GetTargetMountId(),
+		MountPoint:          x.GetMountPoint(),
+		FSType:              x.GetFsType(),
+		Device:              x.GetDevice(),
+	}
+	if x.GetTimestamp() != nil {
+		evt.Timestamp = x.GetTimestamp().AsTime()
+	}
+	return evt
+}
+
+// NewLostEvt - Returns the protobuf representation of a lost event notification
+func NewLostEvt(lost *model.LostEvt) *LostEvt {
+	return &LostEvt{
+		Map:    lost.Map,
+		Count:  lost.Count,
+		Reason: string(lost.Reason),
+	}
+}
+
+// ToModel - Returns the LostEvt described by the protobuf representation
+func (x *LostEvt) ToModel() *model.LostEvt {
+	return &model.LostEvt{
+		Map:    x.GetMap(),
+		Count:  x.GetCount(),
+		Reason: model.LostReason(x.GetReason()),
+	}
+}
+
+// NewGetStatsResponse - Returns the protobuf representation of the statistics of FSProbe
+func NewGetStatsResponse(stats model.Stats) *GetStatsResponse {
+	resp := &GetStatsResponse{
+		Events:             make(map[string]uint64, len(stats.Events)),
+		ParseFailures:      stats.ParseFailures,
+		ResolutionFailures: stats.ResolutionFailures,
+		LostSamples:        stats.LostSamples,
+		UserSpaceDrops:     stats.UserSpaceDrops,
+		ResolverCache: &CacheStats{
+			Hits:      stats.ResolverCache.Hits,
+			Misses:    stats.ResolverCache.Misses,
+			Evictions: stats.ResolverCache.Evictions,
+		},
+	}
+	for name, count := range stats.Events {
+		resp.Events[string(name)] = count
+	}
+	for _, c := range stats.Channels {
+		resp.Channels = append(resp.Channels, &ChannelStats{
+			Name:    c.Name,
+			Len:     uint64(c.Len),
+			Cap:     uint64(c.Cap),
+			Dropped: c.Dropped,
+		})
+	}
+	return resp
+}
+
+// ToModel - Returns the statistics described by the protobuf representation
+func (x *GetStatsResponse) ToModel() model.Stats {
+	stats := model.Stats{
+		Events:             make(map[model.EventName]uint64, len(x.GetEvents())),
+		ParseFailures:      x.GetParseFailures(),
+		ResolutionFailures: x.GetResolutionFailures(),
+		LostSamples:        make(map[string]uint64, len(x.GetLostSamples())),
+		UserSpaceDrops:     x.GetUserSpaceDrops(),
+		ResolverCache: model.CacheStats{
+			Hits:      x.GetResolverCache().GetHits(),
+			Misses:    x.GetResolverCache().GetMisses(),
+			Evictions: x.GetResolverCache().GetEvictions(),
+		},
+	}
+	for name, count := range x.GetEvents() {
+		stats.Events[model.EventName(name)] = count
+	}
+	for name, count := range x.GetLostSamples() {
+		stats.LostSamples[name] = count
+	}
+	for _, c := range x.GetChannels() {
+		stats.Channels = append(stats.Channels, model.ChannelStats{
+			Name:    c.GetName(),
+			Len:     int(c.GetLen()),
+			Cap:     int(c.GetCap()),
+			Dropped: c.GetDropped(),
+		})
+	}
+	return stats
+}
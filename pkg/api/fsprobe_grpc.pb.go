@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: fsprobe.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// FSProbeClient is the client API for FSProbe service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FSProbeClient interface {
+	// Watch - Watches the provided paths until they are unwatched. Paths are reference counted.
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*WatchResponse, error)
+	// Unwatch - Releases the paths watched with Watch
+	Unwatch(ctx context.Context, in *UnwatchRequest, opts ...grpc.CallOption) (*UnwatchResponse, error)
+	// ListWatches - Lists the watched paths
+	ListWatches(ctx context.Context, in *ListWatchesRequest, opts ...grpc.CallOption) (*ListWatchesResponse, error)
+	// StreamEvents - Streams the events matching the request, along with lost event notifications
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (FSProbe_StreamEventsClient, error)
+	// GetStats - Returns the self-monitoring statistics of FSProbe
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
+}
+
+type fSProbeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFSProbeClient(cc grpc.ClientConnInterface) FSProbeClient {
+	return &fSProbeClient{cc}
+}
+
+func (c *fSProbeClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*WatchResponse, error) {
+	out := new(WatchResponse)
+	err := c.cc.Invoke(ctx, "/fsprobe.v1.FSProbe/Watch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fSProbeClient) Unwatch(ctx context.Context, in *UnwatchRequest, opts ...grpc.CallOption) (*UnwatchResponse, error) {
+	out := new(UnwatchResponse)
+	err := c.cc.Invoke(ctx, "/fsprobe.v1.FSProbe/Unwatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fSProbeClient) ListWatches(ctx context.Context, in *ListWatchesRequest, opts ...grpc.CallOption) (*ListWatchesResponse, error) {
+	out := new(ListWatchesResponse)
+	err := c.cc.Invoke(ctx, "/fsprobe.v1.FSProbe/ListWatches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fSProbeClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (FSProbe_StreamEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FSProbe_ServiceDesc.Streams[0], "/fsprobe.v1.FSProbe/StreamEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fSProbeStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FSProbe_StreamEventsClient interface {
+	Recv() (*StreamEventsResponse, error)
+	grpc.ClientStream
+}
+
+type fSProbeStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *fSProbeStreamEventsClient) Recv() (*StreamEventsResponse, error) {
+	m := new(StreamEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *fSProbeClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
+	out := new(GetStatsResponse)
+	err := c.cc.Invoke(ctx, "/fsprobe.v1.FSProbe/GetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FSProbeServer is the server API for FSProbe service.
+// All implementations must embed UnimplementedFSProbeServer
+// for forward compatibility
+type FSProbeServer interface {
+	// Watch - Watches the provided paths until they are unwatched. Paths are reference counted.
+	Watch(context.Context, *WatchRequest) (*WatchResponse, error)
+	// Unwatch - Releases the paths watched with Watch
+	Unwatch(context.Context, *UnwatchRequest) (*UnwatchResponse, error)
+	// ListWatches - Lists the watched paths
+	ListWatches(context.Context, *ListWatchesRequest) (*ListWatchesResponse, error)
+	// StreamEvents - Streams the events matching the request, along with lost event notifications
+	StreamEvents(*StreamEventsRequest, FSProbe_StreamEventsServer) error
+	// GetStats - Returns the self-monitoring statistics of FSProbe
+	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
+	mustEmbedUnimplementedFSProbeServer()
+}
+
+// UnimplementedFSProbeServer must be embedded to have forward compatible implementations.
+type UnimplementedFSProbeServer struct {
+}
+
+func (UnimplementedFSProbeServer) Watch(context.Context, *WatchRequest) (*WatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedFSProbeServer) Unwatch(context.Context, *UnwatchRequest) (*UnwatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unwatch not implemented")
+}
+func (UnimplementedFSProbeServer) ListWatches(context.Context, *ListWatchesRequest) (*ListWatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatches not implemented")
+}
+func (UnimplementedFSProbeServer) StreamEvents(*StreamEventsRequest, FSProbe_StreamEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedFSProbeServer) GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedFSProbeServer) mustEmbedUnimplementedFSProbeServer() {}
+
+// UnsafeFSProbeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FSProbeServer will
+// result in compilation errors.
+type UnsafeFSProbeServer interface {
+	mustEmbedUnimplementedFSProbeServer()
+}
+
+func RegisterFSProbeServer(s grpc.ServiceRegistrar, srv FSProbeServer) {
+	s.RegisterService(&FSProbe_ServiceDesc, srv)
+}
+
+func _FSProbe_Watch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FSProbeServer).Watch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fsprobe.v1.FSProbe/Watch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FSProbeServer).Watch(ctx, req.(*WatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FSProbe_Unwatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnwatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FSProbeServer).Unwatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fsprobe.v1.FSProbe/Unwatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FSProbeServer).Unwatch(ctx, req.(*UnwatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FSProbe_ListWatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FSProbeServer).ListWatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fsprobe.v1.FSProbe/ListWatches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FSProbeServer).ListWatches(ctx, req.(*ListWatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FSProbe_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FSProbeServer).StreamEvents(m, &fSProbeStreamEventsServer{stream})
+}
+
+type FSProbe_StreamEventsServer interface {
+	Send(*StreamEventsResponse) error
+	grpc.ServerStream
+}
+
+type fSProbeStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *fSProbeStreamEventsServer) Send(m *StreamEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FSProbe_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FSProbeServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fsprobe.v1.FSProbe/GetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FSProbeServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FSProbe_ServiceDesc is the grpc.ServiceDesc for FSProbe service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FSProbe_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fsprobe.v1.FSProbe",
+	HandlerType: (*FSProbeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Watch",
+			Handler:    _FSProbe_Watch_Handler,
+		},
+		{
+			MethodName: "Unwatch",
+			Handler:    _FSProbe_Unwatch_Handler,
+		},
+		{
+			MethodName: "ListWatches",
+			Handler:    _FSProbe_ListWatches_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _FSProbe_GetStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _FSProbe_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "fsprobe.proto",
+}
@@ -0,0 +1,973 @@
+// Code generated by go-bindata. DO NOT EDIT.
+// sources:
+// ebpf/bpf/bpf.h
+// ebpf/bpf/bpf_helpers.h
+// ebpf/bpf/bpf_map.h
+// ebpf/const.h
+// ebpf/dentry.h
+// ebpf/events/events.h
+// ebpf/events/exec.h
+// ebpf/events/link.h
+// ebpf/events/mkdir.h
+// ebpf/events/modify.h
+// ebpf/events/open.h
+// ebpf/events/rename.h
+// ebpf/events/rmdir.h
+// ebpf/events/setattr.h
+// ebpf/events/symlink.h
+// ebpf/events/unlink.h
+// ebpf/filter.h
+// ebpf/main.c
+// ebpf/main.h
+// ebpf/process.h
+// ebpf/process_tree.h
+// ebpf/structs.h
+
+package sources
+
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func bindataRead(data []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+
+type asset struct {
+	bytes []byte
+	info  fileInfoEx
+}
+
+type fileInfoEx interface {
+	os.FileInfo
+	MD5Checksum() string
+}
+
+type bindataFileInfo struct {
+	name        string
+	size        int64
+	mode        os.FileMode
+	modTime     time.Time
+	md5checksum string
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) MD5Checksum() string {
+	return fi.md5checksum
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _bindataBpfBpfH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x7f\x73\xdb\x38\x92\x3f\xfc\xb7\xfd\x2a\x70\x35\x55\x37\x96\x1f\xf9\x67\x3c\xd9\xac\xbd\x49\x45\x96\xe8\x44\x1b\x59\xd2\x57\x92\x93\xcc\xcd\x4d\xb1\x28\x12\x92\x38\xa6\x48\x2e\x01\xfa\xc7\xee\xcd\x7b\x7f\xea\xd3\x00\x48\x90\xa2\x1c\x3b\x3b\x7b\x77\xcf\x53\xdf\x64\x77\x22\x02\x8d\xee\x46\x03\x68\x34\x1a\x0d\xe0\x68\x9f\x4d\xc7\xbd\xaf\x07\x83\xd0\xe7\xb1\xe0\x07\xfd\x80\xc7\x32\x5c\x84\x3c\x3b\x67\x1f\xc6\x83\x83\xd3\xc3\x63\xf6\xa5\x3f\xfb\xc8\x06\x61\x9c\x3f\x1c\x88\x47\xe1\x7b\x51\x74\x10\x27\x92\xb3\xfd\xa3\xdd\xa3\x7d\xd6\x4d\xd2\xc7\x2c\x5c\xae\x24\xdb\xf3\x5b\xec\xf4\xf8\xe4\xe4\xe0\xf4\xf8\xe4\x8c\x8d\x07\x37\xd7\xcb\x2c\x0c\xda\x6c\x25\x65\x7a\x7e\x74\x94\x46\xf9\x1a\x09\x87\x7e\xb2\xde\x65\xfb\xbb\x6c\x9f\xcd\x56\xa1\x60\x69\x96\x2c\x33\x6f\xcd\x42\xc1\x16\x19\xe7\x4c\x24\x0b\x79\xef\x65\xfc\x82\x3d\x26\x39\xf3\xbd\x98\x65\x3c\x08\x85\xcc\xc2\x79\x2e\x39\x0b\x25\xf3\xe2\xe0\x28\xc9\x80\x60\x9d\x04\xe1\xe2\x11\x69\x79\x1c\xf0\x8c\xc9\x15\x67\x92\x67\x6b\xc1\x92\x05\xbb\xe3\x99\x08\x93\x98\x9d\xe2\x03\x39\x1f\x86\x37\xec\x03\x8f\x79\xe6\x45\x6c\x9c\xcf\xa3\xd0\x07\x0e\x5d\x75\xe6\x09\x96\x22\x51\xac\x78\xc0\xe6\x8f\x84\xeb\x0a\x0c\x4d\x35\x43\xec\x2a\xc9\xe3\xc0\x93\x61\x12\x1f\xee\xa2\xf6\x3f\x84\x8b\x38\xe0\x0b\xe6\xde\x74\xc6\x7d\xd7\x1d\xf4\x87\x37\x5f\xdd\xcb\xf1\x95\xfb\xd1\x75\x77\x7f\x08\xf8\x22\x8c\x79\x73\xe6\xee\x0f\x61\xec\x47\x79\xc0\xd9\x5f\x22\x08\xf6\x48\x3e\xa6\x5c\x1c\xae\xde\x6d\x64\xcc\xd3\x85\xeb\x27\xeb\x75\x12\x23\x17\x02\x77\x1e\x24\x8f\x03\x1e\xb0\x30\x16\x32\xcb\x7d\xf0\xc3\x04\x97\x6c\xee\x09\x1e\xb0\x24\x66\x32\x49\x51\x65\x3f\xf2\x84\x08\x7d\x76\x39\xbe\x02\xb7\x28\x6b\x17\xa1\x6c\x2e\x90\x65\x78\x05\x7b\x9d\xc1\xcd\xeb\x33\x76\xfc\x70\xfc\x27\x76\xb4\xcf\xbc\x28\x67\xeb\x24\xe0\x2c\x8c\x59\x90\xe4\xf3\x88\xb3\xfb\x24\x0b\xd8\x7d\x18\xc8\x95\xc1\x1a\x05\x47\x51\xf0\xc0\x16\x21\x8f\x82\x0d\x7c\xbd\x2f\xec\xf8\xe1\xe4\x0d\x63\x40\x67\xa3\xd8\x7b\x7d\x76\x30\x0f\x65\xab\x5e\xe0\x6b\xa7\xd7\x63\xc7\x0f\xfe\x31\x0a\xf0\x07\x3f\xca\x45\x78\xc7\x99\x17\x04\x86\x9e\x17\xe5\x47\xbf\xad\xd3\x2d\x04\xaf\x47\x9f\xd9\xf1\xc3\xfc\x98\x08\xae\x93\x3b\x96\xf1\x25\x93\x09\xfd\x53\x03\xed\x4c\xa6\x1f\x0b\x52\x22\x5c\xc6\x8c\x93\x70\xc3\x78\xc9\xbc\x2c\x94\xab\x35\x97\xa1\xcf\xc4\x2a\x5c\x48\xa6\x3a\xb9\x66\xc1\x5f\x79\xf1\x92\x33\xc0\x7a\x71\xcc\x85\x80\xc0\x3d\xd0\x08\x85\xe4\x59\x9d\x27\x67\x88\x2a\x05\xc7\x4a\x0a\x8b\xc8\x5b\x0a\xb6\x48\x32\xbb\xbc\x9f\xc4\xba\xc3\x9e\xd7\x4b\xcf\x46\xee\xc0\x41\x93\x10\x9f\x0a\x50\xa2\x4a\x51\x28\x65\xc4\x0f\x14\x96\x86\x52\x97\x54\xea\x4d\xad\xd4\x3c\x5c\x6e\x29\x72\x35\x19\x5d\x83\x54\x41\x74\x33\xf7\xb2\xc8\xbd\x74\x48\x12\x68\x08\x1e\xfb\x49\x10\xc6\xcb\x8d\xb6\xf8\xeb\x10\x1c\xfc\xa4\xda\xe2\xb7\x7c\x9d\xb2\x7f\x7b\xbb\x01\x33\x98\xb1\xe3\x07\x4f\xc1\x0c\x66\xd0\x03\x79\x8c\xc6\xe0\x41\x9b\xfd\xf8\x97\x1f\x37\xe1\x9d\xb2\x7d\x07\x4e\x1d\xfe\xed\x66\x81\xe9\x07\x50\x78\x4d\xd2\xc3\xef\x50\x30\x05\xcf\x7e\x7c\xf7\x63\x9b\x21\x25\x66\x0f\x6f\x5e\x37\x14\x04\xa9\x3f\xe9\x82\x4e\xa5\xe0\x5b\x94\x74\xb6\x96\xa4\x4a\xa9\x3e\x3c\x1d\x58\x24\x9b\xab\x34\xa5\xf6\x0d\x34\xb8\x45\xa8\xb9\x46\xdd\xce\x60\xc0\x8e\x1f\xde\x10\xfc\x22\x8f\xf5\x80\xf6\xa2\xa8\x0e\xe9\x7c\xed\x83\x91\x3f\x57\x21\x33\x2e\xf3\x2c\x36\x7d\x79\x62\x3a\x6d\x9c\xaf\xe7\x3c\xa3\x46\xe4\x71\xbe\xde\xfd\xc7\xee\x0e\x70\x4c\x9c\x0f\xee\x31\x7b\xcb\x8e\xdb\xe5\xf7\x89\xf5\xfb\xd4\xfa\xfd\xca\xfa\x7d\x66\xfd\xfe\xc9\xfa\xfd\xda\xfa\xfd\x27\xeb\xf7\x1b\xeb\xf7\x9f\xad\xdf\x27\x20\xec\xba\xd7\x1d\xa5\x3f\x27\xce\x87\xf6\xee\xef\x17\xc4\x3a\x54\xdb\xca\x13\xec\xe4\x98\x2d\xb5\x62\x4f\xf3\x2c\x4d\x04\x67\x4a\xbb\x14\x23\x52\x60\xd6\x60\x42\x7a\xfe\x2d\x5b\x64\xde\x9a\x1f\xda\xa2\xb2\x70\xb3\x0a\xa5\xdd\x5d\xa5\x63\x19\xf4\x70\x18\x8b\x18\x42\x71\xdd\xfc\x0d\xf3\x93\x80\x5f\xec\xec\x50\x2f\x4c\x52\x7c\x01\xa1\xca\x0b\x84\x74\xa1\x6e\xce\xd9\xd9\x05\xf2\x03\x2e\x4a\x4e\x4a\x30\x91\xf9\x15\x30\x91\xe4\x99\xcf\xeb\x80\xe2\xe4\x35\x4b\x16\x0b\x43\x4b\x77\xc0\x64\xb1\x80\xce\xd7\x20\xaf\x4e\x59\xb8\x5e\xd7\x40\xc2\xf5\x9a\x07\xa1\x27\x39\xf3\x93\x58\x48\x2f\x26\x70\x2d\xb9\x4f\xfc\x91\x54\x56\xcc\x3c\xea\x82\xd7\x9d\xb1\x3b\xfb\x79\xec\xb8\x83\xf1\xb5\x3b\x9b\xf4\x1d\xc6\x63\x99\x3d\xa2\x84\x25\x81\x28\x5d\xbb\x32\x0b\xb9\x7b\xcb\x1f\xb5\x24\x5e\x9d\xb2\x34\xe3\x8b\xf0\x21\xe2\x31\xd5\x22\x4f\xa1\x9a\x5e\x9d\x92\x82\xeb\x5c\xb9\xfd\xa1\x33\x6b\xb3\x93\xd3\x37\x76\xc2\x6b\xcd\x39\x84\xe5\x49\xef\x97\xd3\xe3\x5f\x2f\x94\x72\xec\x64\xf3\x50\x66\x5e\xf6\xc8\x44\xf8\x77\x6e\x71\x8c\xb6\xd6\xf6\x07\xc3\x8c\xe8\xc5\x81\x68\x33\xc1\x39\x38\xdb\x3b\x6d\xb1\xb5\x17\x1f\xa4\xde\x92\x13\x9d\x80\x4b\x2f\x8c\xc4\xa1\xe9\xcc\x00\x72\xfd\x75\x60\x3a\x35\xea\xdb\x9d\x38\x9d\x99\xa3\x7b\x1a\x12\x06\xa3\xd1\xa7\x9b\xb1\xeb\x0c\x9c\x6b\x2b\xf5\x66\xdc\xeb\xcc\x9c\x7a\x6a\xcf\x19\x38\x9b\xa9\x1f\x9c\x99\x3b\x74\xbe\xce\xdc\x4f\xce\xcf\x3a\x79\x3c\x19\x7d\x70\x07\xa3\x4e\x4f\x7f\x8f\x2e\xff\xea\x8e\xfb\x43\xeb\xeb\x83\x33\xd3\x5f\x04\xdb\x99\xcd\x3a\xdd\x8f\x76\x4a\xcf\xa9\xa7\xcc\x9c\xe9\xcc\x9d\xdc\x18\x2c\x54\xae\xa0\xdd\xef\x35\x71\xd4\xef\xd5\x81\xaf\x7a\xee\xe5\xcf\x9b\xd0\xb5\x64\xcd\xa1\xdb\x1f\x5e\x8d\x90\x71\x65\x32\x88\xe8\xff\xb9\x71\x26\xa6\xa6\x93\xce\x17\x77\x36\xe9\x74\x9d\xf1\xa8\x3f\x9c\xb9\xa3\xb1\x33\x54\x23\xb5\x68\x80\xb5\x97\xba\x30\x75\xec\x56\xa0\x5e\x77\x33\x9c\x8e\x9d\x6e\xbb\x96\xfa\xb1\x33\xfd\x58\x4f\xeb\x4c\x26\x9d\x9f\xeb\x89\xc4\x4a\x73\x8e\x33\xb9\x72\x9d\xcf\xce\x70\xb6\x35\xbf\x3b\xbe\x69\xa4\xa4\xb3\x1a\x8b\x4d\x67\x9d\xee\x27\x55\xd9\x7a\x56\xf7\xc3\x64\x74\x33\x6e\xa6\x36\x98\x34\x93\x42\xfa\x13\x9c\x98\x31\x59\x4f\x27\x1a\xee\x88\x20\xa7\xf5\x4c\xd0\xd9\x96\xd7\x73\x3e\x5f\x77\xc6\xf5\xd4\xe9\xa8\xfb\xa9\x21\xb9\x3b\xbe\xa1\xd4\x4a\x4b\xc2\x6a\xaf\x34\x25\x35\xc1\x66\x5b\x96\xc9\xc0\x8e\xde\xd5\x1f\xcc\x9c\xc9\x46\xee\xa7\xf1\x64\x74\xe9\x6c\x24\x4f\xbb\x1f\x9d\x9e\xdb\x1d\x4c\xb7\xe4\x74\xba\x95\xa1\x43\xa8\xca\x2e\xb8\x91\xf5\xb5\x37\xde\x48\x2b\x7b\xc8\x46\x96\x6e\xca\xe9\xa7\xcb\xad\x59\xa3\xee\xa7\x8d\xbc\xc1\x97\x99\xdb\x1f\x36\x26\x8f\x6e\x36\xa9\x00\xfc\xeb\x75\x7f\x33\x03\x12\x73\x47\xe3\xe9\x66\xc6\xa7\xa7\x78\xea\x39\x9f\xfb\x5d\x67\x23\x77\xfa\xc9\xbd\x9e\x7e\xd8\x48\xae\x0e\xda\x6d\x38\x89\x95\x4e\xaf\x37\xa9\x75\x03\x4f\x4a\xcf\x5f\x55\x3a\x82\x2e\x01\x2d\xef\xf6\x87\x1f\x26\xce\x74\xda\xde\xcc\x71\xb6\x65\x10\xa5\x8a\x66\xd6\xb9\x35\x69\x28\x19\xb8\xd3\xd9\xc4\xe9\x5c\xbb\xe3\xce\x64\xea\x4c\x1a\xb3\x3e\x3b\x93\x5e\xbf\x3b\xab\x22\xab\xc8\x48\x49\xa6\x19\x10\xd5\x38\x73\x2f\xfb\xc3\xde\x66\xfa\xeb\x2d\xe9\x67\x6e\x77\x34\x1c\x3a\x4d\xa8\x5e\x6f\xcf\x3a\x73\xc7\xa3\xe9\x6c\x2b\x29\x3b\xb3\x34\x51\xd4\x5c\x41\xcd\x4f\x0d\x53\x37\x68\xac\x7c\xd6\x5c\x0a\x53\xab\xbf\xcc\x92\x3c\x3d\x98\xa7\x0b\xa6\x1a\x54\x2f\x51\x72\x2c\x28\xc3\x98\x15\x5d\x42\xa1\x33\xd3\xaf\x5e\xc5\x0f\x47\x43\x67\x2f\xe0\x0b\x2f\x8f\x64\xeb\x9c\x0d\x13\xb6\xc8\x33\xb9\xe2\x19\x7a\x88\x59\xde\x0b\xe6\x45\x51\x72\xaf\xf0\x61\x69\x2d\xf2\xb9\xcc\x38\x3f\xd4\x48\x40\x02\x8b\xcf\xc1\xe8\x8b\x3b\xfa\xec\x4c\x26\xfd\x9e\x73\xce\xfa\x58\x54\x89\x7c\x7e\xa0\x38\xa4\x25\xaf\x17\x45\x82\x89\x64\xcd\x6d\xf4\x6d\xe0\x00\x5a\xfd\xad\xa8\x84\x42\x57\x8d\x3d\xaa\xa5\xa2\x4c\x6c\x6c\x1a\xb6\x89\x85\xeb\x9b\xc1\xac\xff\x72\xfa\x9e\x64\x55\xdc\x6c\xc9\xa5\x60\x59\x1e\x83\x21\x2f\x08\x42\xb2\xd4\x65\xf2\x04\xaf\x86\x9d\x51\x1c\x3d\xb2\x24\xb6\xc0\x4a\x19\x62\x15\xc7\x75\x5b\xa9\x4f\xcf\x10\xbe\x0f\xe5\xca\xb4\x0a\x4b\xb2\x46\xc1\x52\xf3\x82\x0e\xeb\x10\x0a\x5a\xef\xc6\x09\xb5\x99\xa1\x56\xfa\x11\x9e\xc4\x74\x1f\x46\x11\x10\x65\x3c\xe2\x9e\xe0\x2c\x89\x82\x82\x61\x58\xdc\xba\x3f\xa1\xba\x31\xbf\x47\x7d\x0e\x59\xc7\xee\x63\x30\xdf\x65\xc2\xd6\x9e\xf4\x57\xa6\xea\xd7\x79\x24\xc3\x34\x2a\xaa\x2e\x18\xdc\x2f\x2f\xa8\xbc\xcd\x2a\x35\xa5\xaa\x31\x9b\xad\xf8\x23\xe1\xe2\x0f\xdc\xcf\xa5\xea\x8d\x57\xfd\xab\x11\x4b\xb2\x80\x93\x4b\x69\x4f\xae\xb0\x7c\xa0\xb6\xbc\xe7\x99\x45\x68\x11\x66\x42\xb6\xa9\x31\xe9\x67\x0b\xe0\xb3\xb2\x1d\x05\x84\x65\x75\x96\x0a\x1d\x5d\x58\xae\x78\x6c\xc3\x03\x85\xdd\x4f\x21\xb2\x3a\x0c\x4b\xbd\x8c\xc7\xa6\x67\x41\x46\xec\x0b\x40\xfc\x55\x18\x05\x59\x09\xcb\xd6\xde\x2d\x17\x2c\xe0\x7e\x48\x7e\xaf\xbd\x28\xbc\xe5\x2c\x0d\xfd\x5b\xb4\xef\xac\x3b\x66\xdd\x0e\xda\x51\x24\xfe\x2d\x9b\x87\x71\x40\x15\xd0\xc8\x0d\x0e\x34\x87\x47\xee\x0d\x9f\x43\xb4\xc9\x1d\xcf\xb2\x10\x0e\x20\x69\x5a\xa7\x63\x98\x45\x57\x63\x4a\xbc\x49\xc6\x2a\x9d\x4b\xf5\x54\x2c\xba\x1e\x99\x67\x37\x77\x18\x5b\x22\x12\x87\x9b\xf8\xa8\xb7\x05\x09\x17\xf1\x8f\x52\x61\x21\x24\x9a\xbf\x46\x04\xce\xc3\xc9\x39\x10\xf9\xcb\x2c\x3d\x61\x7b\x8a\x23\x14\x10\xac\xd3\x66\x97\x2d\x76\xf0\x0e\xd9\x8c\x81\x50\x7a\xca\xf6\x0a\x56\x01\xc4\xba\x16\x80\x02\x79\x65\x23\x61\xbd\x4a\xbe\x82\x38\xab\x23\x71\x6a\x40\x0a\xec\x27\xb6\x47\xf5\x21\x3c\x57\x2d\xa3\xa4\xf8\x1d\xda\x33\x8c\x35\x8c\xcc\xc2\xe5\x12\x0b\x6f\xd5\x5b\xd0\x76\xc9\x82\x5d\xb5\x7b\xed\x4e\xfb\x12\x15\xa6\xce\x48\x3d\x94\xea\x1b\x2a\xb5\xc3\xae\x58\x88\xe6\x56\xdd\xb3\xad\x30\x17\x18\x42\xc1\x1c\x85\xa1\x5a\x04\x3d\xac\x47\x63\xe0\xc9\x92\xf5\x72\x6d\xe6\x3c\xaf\x68\x57\x93\x04\xd5\x4e\x14\x31\x1e\x85\xcb\x70\x5e\x1f\xcb\xc5\xb8\xc8\xf8\xd2\xcb\x82\x48\x7b\xd0\xb4\x63\x82\x56\xd3\x8b\x8c\xfc\xc4\x8c\x7b\x59\x14\x96\x8a\x49\x1c\xee\xda\x2b\xf7\x46\xa5\xb4\x77\x72\xc3\xfe\xf2\x17\x76\xdc\x6a\x04\x53\x4d\xab\x61\x4e\x5a\xb4\x1c\xee\x2f\x34\xc8\x74\x36\xe9\x77\x67\x6e\x67\xd0\xff\x30\xbc\x76\x86\xe4\xb9\xd9\x98\x06\xb1\x9a\x33\x93\x20\x49\x0f\x7c\xde\xf1\x8c\x1c\xe7\xec\x3e\x8c\x22\x96\xf2\x6c\x91\x64\x6b\x06\xbf\xb5\x2f\x99\x17\x85\xcb\x78\x8d\x56\xf7\x57\x5c\x0d\x48\x4f\x40\xba\x10\xfd\x2d\xcf\x62\x4e\x5a\x14\xa3\x6f\xce\x79\xcc\xe6\x79\x18\x49\x52\x67\xac\x3b\x1a\x5e\xf5\x3f\xb8\xce\xd5\x55\xbf\xdb\xc7\x3a\xe6\x66\x48\xdc\x91\xf5\xdb\x75\xa6\x53\x16\x27\x12\x0e\x60\x9a\x03\xd1\x46\x30\xa3\xfa\x63\x55\x07\xa6\x04\x40\x5a\xf3\xb4\x49\x74\x1b\x15\x2e\x85\x07\xc1\xdc\x43\xd3\x60\xed\x1c\x05\xe1\x7a\xfd\xfa\xec\xe0\x9d\xf1\x5a\xbc\x7d\x4b\x22\x1b\x4f\x9d\x9b\xde\x88\x16\x11\x57\xbd\x76\x15\x34\x5c\xaf\xd9\xdb\xb7\x6c\x11\xd4\xc9\x56\x0a\xb1\x93\x2a\x25\xda\x61\xd8\x42\x06\x9e\xaf\xb6\x05\xa5\x29\xa4\xfe\x41\xc6\x23\x4f\x86\x77\x1c\x42\xd0\xce\x11\x99\x14\xb3\x1a\xa6\x6a\xe3\xfe\xda\xdd\xc2\x0d\x70\x6b\x5e\x4a\x0f\x6d\x83\x13\xc0\xb4\x7c\x1d\x4d\x67\xf8\x33\x3b\xde\x81\x0f\xc3\xcf\x38\xdc\x2e\x98\xf0\x78\xc4\xa9\xd9\x93\x8c\xe5\x69\x80\x54\xfe\x10\x0a\x89\x0e\x50\x2b\x3e\x1c\x39\x5f\xfb\xd3\x19\x3b\xd9\x82\x21\x5c\x60\x9f\x23\x08\x03\x28\x46\x42\x52\xc7\xa0\xca\x9f\x2a\x3f\x4a\x9d\x9a\x41\xb3\x7f\xb4\xa5\x86\xca\xe6\xde\x56\xb9\x2b\x77\x38\x72\xc7\x13\x07\x23\xad\x6b\x06\xcf\x71\x0b\x0d\xd7\x8f\x85\xe4\x5e\x80\xd9\x6f\xe5\xdd\x81\x16\xac\x16\xe0\x49\x62\x36\x98\xdc\xb0\x08\xbc\x92\x1a\xe3\x66\x72\x2e\x16\x97\x83\xc9\x8d\xfb\x8b\x5e\xfa\xfe\x8a\x25\x2b\x5b\x7b\x69\x9b\xe5\x82\x33\x0f\x83\xc8\x4f\xf3\x02\x07\x0a\xdf\xaf\x42\x7f\x45\xbb\x42\xf0\x04\x71\xd2\x49\x66\xac\xcd\xb9\x94\x5a\x45\x0e\x13\xc9\x69\x64\x52\xe1\x38\x09\xb8\x60\x7b\x6a\x6b\x05\x0c\xd2\x46\x13\xa5\xb6\x80\x0b\x03\x68\xce\xd9\x3a\xb9\xe3\x01\x88\x78\x7e\x96\x08\xc1\x82\x70\xb1\xe0\x34\x3b\x1a\x0e\x1a\x95\xcf\x70\xe4\x76\x47\xd7\xd7\xa3\xa1\x0b\x30\x2d\x9a\x13\x12\xcd\x34\xe5\x3e\x36\xa8\xe2\x7c\xed\x11\x3d\x16\xe4\x19\x18\x58\x7b\xa9\x6a\x63\x28\xdc\x4d\x8c\x37\xd7\x1d\x77\x38\x2a\x35\xd9\x69\xab\xa1\xd1\x4a\xff\xcb\x26\x06\x72\xcb\x40\x67\x38\xdd\x59\xff\xb3\xad\x11\x2b\x80\xf0\xed\x0c\x3b\xd7\x8e\x3b\x70\x86\xec\xe4\xf5\x0d\x51\xb9\x2a\xa8\x78\xbe\xcf\x85\x00\xbf\x70\xbe\x25\xf3\xdf\xb8\x2f\x37\x69\x4d\x7a\xa3\xe1\xe0\x67\x43\xe2\x55\x5d\xe9\x7e\x99\xd8\xd9\x67\xad\x82\x06\x91\x20\x8f\xac\x4b\x6d\x2e\x64\x92\x71\xd2\x7c\x81\x1b\x06\xff\x8f\x1e\xc4\x61\xd9\xbb\xd2\x24\x8c\xb5\x5f\xb4\x4a\x42\x79\x63\x2e\x6f\xfa\x83\x9e\xdb\xef\x19\x52\x3f\xb5\xac\x85\xaa\xa2\x63\x90\xbb\x42\x7a\x32\x17\x70\x43\xc1\x63\x29\x78\xc6\x44\xea\xf9\x18\xb2\x3c\x60\x5e\xcc\xf8\x3a\x95\x8f\xda\x05\x2a\x13\x16\xaa\x6d\x51\xa4\x10\x27\x1e\x93\x19\xc0\xe1\xc4\xbc\x1c\xd7\x19\x70\x9d\xeb\xf1\xec\x67\xed\x31\x87\x66\x83\x1a\xbf\xf3\xa2\x30\x28\x6a\x47\xfd\x56\xd7\x70\x0b\x92\xcf\x9d\x41\xbf\xc7\xde\xb2\x13\x85\xc4\x4f\xf2\x88\x86\xfe\x92\xcb\x02\x4d\x9b\x2d\xbc\x28\x9a\xc3\xa9\x0d\x2e\xd3\x6d\x0c\xf5\xc7\xec\x2d\x3b\x6d\x57\x96\x88\x20\x59\x00\x4c\xfb\xff\xe1\xb0\xd3\x63\xdb\xd7\x5b\x95\x18\x44\xa5\x1c\xcd\x4a\x74\x17\xbb\x3b\x66\xd7\x05\xd6\x62\x56\xb0\xf4\xcb\x06\xde\x5f\x09\x16\x1d\xfd\x1f\xbb\x3b\xf0\x7c\xbf\x3e\xd3\x1a\xfa\xa2\xf8\x0e\xd3\x8b\xdd\x9d\xdf\x2f\x88\x41\x05\xab\xbd\x0b\x19\xfb\xc7\xee\x8e\xe2\x6a\x77\xe7\x1f\x3b\xf4\x07\xfb\x92\x71\x12\x3f\xae\x93\x5c\x30\xcd\x31\xcd\xd1\xf3\xc7\x27\xb4\xd9\x8e\xf6\x56\x1b\x1f\xe4\xc5\xce\x0e\x5c\xf7\x31\x47\x83\x6e\x78\x28\xed\x12\xb7\xfc\xd1\x85\x33\x5a\x95\xc0\x2f\x14\xb9\xe5\x8f\x30\x0a\xe6\x8f\x92\x0b\x1b\xfa\xce\x8b\x72\xae\xe0\x99\x76\xc7\xab\x02\x94\xd1\x58\x64\xed\x3d\xb8\xe8\x6b\x21\x17\x17\x54\x64\xed\x3d\xe8\xdd\x18\x14\xd4\x59\x28\xea\x41\x3d\x56\x8b\xa6\x2e\xa9\x85\x8b\x9d\xa3\x52\xb5\xea\xda\xd3\x9c\xc8\x83\x5d\x08\x6d\x87\x19\xfd\x61\x2c\x02\x6f\x9e\xdc\xf1\x43\x93\x59\xa2\x0c\xe3\x98\x67\x2e\x21\x0e\xc8\xa3\xbf\x08\xd4\xd0\x83\x22\xd0\x4b\x57\x82\xa9\xf3\x02\x2d\xe7\x42\xcb\x11\x2f\xa5\xce\xdb\xe3\x8b\x05\xf7\x31\x39\xb3\x04\x8b\xda\x70\x51\x70\x54\xd7\x77\xd8\xf8\xe2\xb2\x55\xe1\x8a\xba\x17\xd8\x89\xbd\x35\xff\xa5\xae\xb6\x7e\xbd\xa8\xc8\x22\x5c\x84\x71\xc0\x1f\x88\x6f\xfd\x1b\x22\x8c\xb9\x0c\xf8\x1d\x8c\x20\x3d\xb3\x2a\xb5\x8b\x2e\x67\x75\x2f\xf6\x9c\x9e\xb5\x5f\x31\x02\x6a\xed\x48\x32\xa3\x6f\xb2\xf8\x78\x40\xbd\xfb\x96\x3f\x82\xcb\x72\x10\xd4\xf2\xa9\x67\x5c\x6c\xa6\xc7\xfc\x41\xba\xba\xf0\xef\xe5\x60\xa1\x66\xbc\xa8\x33\xaf\x24\xf6\xad\x1a\x6c\xd8\xaf\x36\xff\x85\x53\xf7\x82\x35\x0c\x8d\x22\xd7\x2e\x82\x3d\x31\xd7\x8f\xe5\x66\xa5\x91\x23\x36\x93\x23\x15\x6e\x51\xb6\x5a\x94\x2c\xdd\x88\xdf\xf1\xe8\x62\x47\x8d\x97\x3b\x9e\xcd\x13\x11\xca\x47\x46\xc9\x3a\x94\x43\xd9\xd6\x16\x65\x94\xd3\xa3\xb2\x3a\xce\x48\x9d\xcf\xf3\xc5\xa2\x84\xaf\x30\x90\x2c\xdd\x79\xbe\xa0\x2a\x12\xa8\xc8\xd3\x34\x0a\x79\x50\x2b\x03\xde\x60\x99\xbb\x7a\x5b\x9e\x3a\x35\x59\xef\x3c\x50\xc6\x6a\x21\x90\xb7\xb7\x69\x96\xcc\xb9\x5d\x92\xf2\x4c\x43\xa9\x2e\x4c\x49\xdf\xea\xc3\x04\xf4\xcd\x4e\x9c\x66\x3c\xa5\xe9\x93\x28\x62\xce\xc6\x54\x0a\x9f\x14\xca\x33\xa8\x37\xac\x26\x53\xee\xc3\xc7\xa1\x17\xe0\x48\x65\xeb\x5c\x90\xad\x73\x1b\x27\xf7\x31\xf3\xe4\x2e\x0d\xc3\x28\xf1\x02\x26\xc3\x35\x2d\xf9\x49\xd8\xc5\xb2\x9d\x4a\x09\xb2\x63\x42\x1f\xae\x08\x29\x20\x64\xd0\x51\x65\xf7\xfc\x24\x96\xfc\x41\x6a\x6b\x81\x8b\x76\xe1\xa9\x59\xf1\x28\xe5\x99\x68\x33\x2e\x7d\x1a\xd3\xb6\x84\x0c\x7b\xb6\x0f\xf9\xe2\xc5\x23\x72\x74\xf9\x57\x77\x7f\x63\x30\xda\xed\x9d\x7a\x72\x05\xa9\x97\x32\xc6\xd4\x62\x86\x29\xbe\x17\x61\xc4\xdd\x27\x06\xd5\x33\x47\x95\x72\x8e\x1e\xa9\xcd\xba\x0d\x96\x40\x48\x7a\xd9\x92\x4b\xa8\x88\x1d\x1d\x93\x21\xbd\x10\x8a\x54\x9b\x56\x32\x29\xa4\x9e\xd8\xe5\xb4\x84\x34\xdf\x28\xca\x61\x90\xe9\x15\xb1\x55\x6c\xb3\x8c\x96\x6a\x35\xb1\xb9\xae\xcf\xab\xa5\xd9\x79\x34\x15\xb4\xf9\x04\x43\x15\xc9\x66\x5c\xde\x79\x51\xf9\x1d\x78\xd2\xa3\xa9\xd1\x0d\xe3\xa6\xd4\x24\x6f\x50\x24\x94\x1d\xc6\x5b\x32\x8a\x22\xc0\x94\xf1\x94\x7b\xd6\x77\x90\x67\x14\xaf\x85\x76\x65\x92\x0b\xf9\xb2\x0a\xef\xd3\x7e\xe8\x3e\x6c\xca\xfd\xa3\xba\x02\x07\x3d\x21\xbd\x4c\xba\x61\xa0\x75\x77\x39\x80\xed\x14\x4c\x5d\x61\x60\xeb\xf0\x57\xa7\x4a\xb5\x87\x96\xa8\x92\x94\xc7\xdf\xdb\x30\x9b\x5b\xb4\x76\xab\xd4\xbb\x7b\x18\x2f\x12\x37\xe2\x0d\x02\x45\x0e\xa8\xeb\x1f\xdf\x37\xb9\xd0\xd2\xa3\xa9\x77\x94\xbd\x7f\x5b\xe7\xff\x5b\xce\xb3\x47\xbb\x48\x63\x27\x26\xa8\x42\x54\x55\x48\x2b\xd5\xae\x98\x6e\x14\x0b\x9e\x52\xd4\xbc\xf5\xbb\xc2\x58\xa9\x6f\x31\xd7\x56\x35\x47\xd9\xc1\x7f\x67\x99\x77\xef\xd2\x2a\x80\x6c\xa3\x8b\xdd\xdf\x99\x0b\x55\xa6\xc2\x14\x5d\x77\x6f\x4f\x33\xb0\xf7\xa6\xd5\x6a\x95\x21\x0c\x4a\x27\x96\xb1\x38\x01\x17\x7e\x16\xa6\xe8\xa5\xe2\x5c\xfb\xcd\xee\x92\x30\x60\xfb\xc6\x1c\x8d\x92\xe4\x36\x4f\x5d\x2c\xe0\xf7\xfe\x9d\x56\x4a\xff\x7e\xcb\x1f\x5b\xc6\xd9\x38\x21\x9f\xd9\x39\xbb\xf6\x52\x6d\x62\x26\x19\x1b\xde\x0c\x06\x1a\x59\x18\xcb\xc2\xb2\x55\x4e\x81\x3a\xaa\x36\xfb\x77\x2a\xd8\x56\x06\xe2\x06\xe6\x63\x04\x15\x8a\x9c\xd4\x3b\x1c\xc8\x31\x5f\x92\xab\x85\xf1\x2c\x4b\xb2\x06\x32\x01\x8f\xb8\xe4\xdf\xe4\xf8\x45\x78\x69\x86\x75\x33\xee\x05\x7b\x4a\x3c\x01\x9c\xf1\xa8\x1c\xf4\x49\x5b\xcb\x4c\x64\xfe\xf7\x91\x41\x2f\x81\x94\x6e\x31\x0b\xba\xd0\xd2\xb1\x20\x42\x1b\xe8\xfc\x3c\x23\x9f\x00\x41\xd6\x98\xa4\xfe\xe0\xa6\x59\x18\xcb\x5b\xcc\x8b\x42\xaa\xc5\xd1\xfe\x62\xad\x99\x5d\xac\x25\xa9\xba\x36\x3b\x3c\x3c\xdc\xc0\x1d\xf1\x78\x29\x57\x98\x5f\xb5\x2d\x72\x9f\x85\x52\xf2\x78\x3b\xdb\x7a\x7c\xa7\x99\x17\x07\xc9\xda\xcd\x5f\x9d\x36\x73\xad\xf2\x55\x07\xa9\x95\x45\x47\x16\xeb\xd4\x4d\xb3\x04\x2d\x91\x64\x6e\x18\x34\x23\x99\x5e\x8f\x59\x01\xc5\xfa\xbd\x5a\xed\xc5\xed\xdc\xa5\x45\xbc\x4b\x2b\x9c\x3d\x71\x3b\x6f\xeb\x95\x5e\x9b\xdc\xb9\x6d\x16\xf1\xb8\xde\xcb\xf4\xb2\x1f\x25\x80\x29\x61\xa9\xe7\xdf\x72\x69\xb2\xdf\x8b\xdb\xf9\x79\xb1\xf4\xc7\x06\xde\xed\xbc\xc8\x53\xc8\xcf\x35\x11\x5a\x68\x87\xb1\x46\x40\x14\x01\x7d\xf0\x6e\xed\xf9\xee\x8a\x7b\x7a\x87\x87\x4a\x22\xb3\x44\x7b\xbf\xc2\x4e\x0f\x56\x07\x49\xfa\xa8\x79\x31\xfe\x67\x02\x8f\x78\x7c\x6e\xad\xc8\x14\x04\x98\x21\xe6\x9b\xd8\xa6\x4a\x9e\xb3\x79\x28\xd9\x31\x3b\x20\x57\x6f\x96\xf3\x36\xcb\xb8\x9f\xac\x53\x44\x32\x13\x6b\xbe\xc8\x0b\x2a\xe6\xaf\x76\x59\x86\x52\xb0\x03\x96\x71\xc1\x33\xed\x97\x7a\x71\xaf\x36\x2d\x13\xbd\x72\x41\xc8\xcd\x78\x1a\x79\x3e\x6f\x6a\x19\x99\xd4\x1b\xa6\xe4\xb4\x3f\x56\x86\xaf\xc5\xeb\xf7\x37\x8b\x92\xb5\x85\x12\x2e\xf6\x28\xf1\x3d\x59\x56\xf2\x3d\x78\x3a\xa7\xcd\x44\xad\xd2\x16\x4c\x35\xa0\x0a\xfa\x2d\xe0\x64\x72\x4e\x4e\xd2\xa7\xa1\xca\xb6\x10\xec\xf8\xe0\x15\x3b\x28\xd6\x0a\x4d\xe0\xff\xc2\x66\x38\xfb\xa7\x9a\x61\xd6\x1d\x1f\xdd\xf4\xfe\xf8\xb6\xa8\xe3\xfd\xdf\xda\x20\x18\x4b\x67\x18\x4b\x82\xa5\x82\xe7\x41\xa2\xa1\xff\xf5\x0d\x87\x58\x42\xda\x71\xd8\xf3\xe5\x43\x1b\x3a\x70\xe9\x7a\x59\xe6\x3d\xba\x34\x1f\xd3\x0a\xad\x68\x30\x8a\x73\x0e\x63\x6b\xf7\xc1\x32\xd6\x0d\xd0\x7b\x5f\x3e\x9c\x33\xb3\x74\x32\x6d\x97\x22\x24\x9f\xf6\x6a\x60\x1f\x6e\x94\xa9\xd2\xad\x34\x39\x5c\x31\xca\x37\x0e\x8b\x1f\x22\xda\x12\x20\x57\x20\x23\xa6\xcf\xd9\xab\x53\x8a\x93\xa5\x2f\xc4\x57\x60\xaf\x97\xaa\xa6\x76\x1d\x05\x8f\xb8\x2f\x85\xb5\x02\x2c\x17\x1d\x59\x1e\xff\x53\x52\xf5\xa3\x24\xe6\x2e\x4e\x77\x64\xdc\x97\x4a\x29\xe9\xe5\xee\xe6\x20\x50\x40\xcc\x92\xaa\xf2\xe9\x3c\x6b\x10\x68\xac\xe7\xf6\x6a\x1a\x9b\x03\x31\x97\x2c\xe0\x77\xa1\xcf\x1b\xba\xa6\xd1\xdb\x34\x3a\x6d\x06\xc2\x78\x99\xa1\xd7\x58\xae\x69\x4e\x29\xff\xba\x8e\x68\xcc\x13\x18\x26\xda\x00\x71\xd3\x30\x70\xe5\x32\x0c\x9e\x34\x53\x0e\xde\x01\x04\xee\xf0\x57\xa7\xec\xbf\xca\xd4\x34\x0c\x9e\x40\x9d\x87\x81\xfb\x2d\xcc\x6e\x03\x5e\x37\x0f\x83\x5a\x23\xdb\x68\xb1\x28\xd8\x53\x06\xd1\x3c\x5f\x94\xd6\x9b\x9b\x2c\xe0\x92\x29\x28\x91\x49\x20\x0c\xd2\x83\x77\x28\x07\xe0\x04\x2e\x9a\xef\x93\x9f\xb6\x75\x88\x1b\x0a\x1d\x70\xe9\xfc\x4b\x18\xa0\xdb\x15\x84\x33\x0e\x4f\x2b\x4e\x9b\xa0\x9f\xfb\x3f\x0a\x7d\x88\x26\x78\x4e\x2f\x2b\xe4\xa3\x8a\xa0\xeb\xfc\xdb\x5b\x76\x5c\x93\x07\x8c\xa4\xbb\xc8\x8b\xdd\x34\x17\x2b\x10\x6f\x33\xf5\x99\x25\x98\x82\xe9\xb7\xf4\xc3\x56\x1d\xeb\xb3\xaa\xb9\x49\x24\x49\x2b\x15\xfc\x6e\x6c\x10\x9c\xcc\xe3\x98\x47\x70\x48\x02\x67\x9b\xd1\xd2\x01\xed\x67\x8f\x56\xbb\x90\x78\x6e\xa1\x8a\xec\x93\x8c\xa5\x49\x9a\xc3\x75\xcd\x14\x49\xb6\xe6\xd2\x0b\x3c\xe9\x3d\xa7\x19\xde\xdf\xf2\xc7\x4a\xde\x8f\x7a\xa5\x0a\xae\x4a\x6e\x7e\x2c\x71\x85\x7f\xe7\xe7\xc5\x04\xf4\x2d\x68\xad\x1f\xb2\x24\x59\x93\x07\x6e\x91\xcb\x9c\x02\x1a\x24\x8f\x11\x76\x23\xbe\x4b\xd4\x66\x10\x62\x2b\xd3\xa5\xf0\x10\xb5\xd4\xa1\xe9\xa5\x2e\x27\x2f\xc0\xce\xe8\x82\x11\x1c\xf3\x93\x9c\x3a\xa3\x31\xed\x01\xc3\xde\xd7\xe7\x07\x0b\xb1\xd2\xee\x6b\x2f\xad\xd7\xa9\x50\x8d\x45\x7c\x0a\x74\x24\x66\x96\x84\xb4\xd8\xda\x13\xb7\xaa\xa5\xeb\x75\x2c\x66\xfb\x06\xbe\x88\x5f\x9c\x75\x42\x75\xe9\xd8\x44\xad\x7b\x19\xd5\xba\xa7\x95\xf3\x77\xe8\xfe\x17\xeb\xf7\x5d\xb6\xbf\xc3\x98\x1f\x09\x77\x9e\x2e\xce\x4d\x66\x61\x65\xfc\x73\x9a\x7f\x9b\xda\xdf\x61\xec\x21\x48\x0d\x45\x78\xbb\xe1\x29\xfd\xd6\xfc\x60\x98\x64\xb3\xae\xdb\xe9\xce\xdc\x89\xd3\xeb\x4f\x9c\xee\xac\xd6\xad\x74\xee\xf4\xe3\x88\x72\x4c\xe7\xda\xd1\x3c\x11\xe1\x45\x7a\xce\xbe\xf6\xc6\xdb\x50\x20\xab\x73\x39\x9a\x54\x10\x6c\x34\x13\x4c\x0f\xd5\x2f\x69\xfc\x6f\x6f\x2b\x1e\x07\x34\x0a\xb1\x6f\x65\xf7\xb6\x7a\xd7\x84\x4f\xdb\xce\xa7\xf1\x6b\xec\x11\xea\x7e\x38\xea\x46\x4e\x90\x7a\x8f\x3d\x38\xa8\x4b\xeb\xa5\xd5\xab\x4f\x0e\x59\x92\x4b\xd8\x24\x5e\xb4\xde\x36\x33\x04\x42\xfe\x28\x98\xfc\x8e\x99\x81\xd0\x6e\x99\x17\xac\x01\x9a\xe4\x32\xcd\xa5\x32\x35\x4b\x05\xd0\xa6\x93\x32\x4a\x77\x16\x6c\x29\x50\xa5\x0e\x32\xef\x9e\x09\x6f\x9d\x46\x65\x67\x27\x23\x53\x2b\xb4\x94\xce\x24\x89\xfd\xad\xed\xf0\xaf\x51\x11\xef\xc1\xf5\x39\xf1\x0e\xb9\xd3\xae\xb2\x0e\xe1\xd4\xcc\x7b\x02\xbe\x34\x56\xd5\xef\x15\x9d\x6c\x67\xbd\x48\xad\x1a\xe1\xa2\x65\x89\x72\x18\xd4\xc5\x5a\x88\xf2\xde\x8b\x6e\xe1\xcd\xcc\x80\x0d\x1b\x3f\x3c\xd2\xdc\x62\x97\x4c\x47\xa6\x85\xc1\xf7\xc9\x96\x10\x29\x77\x61\x93\x58\xf5\x42\xe8\x4f\xec\x00\x4e\x06\x6c\x08\x2c\x34\x6d\x3a\xab\xa6\x5c\x0d\xb7\x61\x51\xce\xfc\x85\xe5\xfe\x86\x1d\x30\x3f\x89\x60\xa6\x2b\xf6\x55\x41\x4b\x45\x95\xf1\x65\xf6\x1f\x94\xfd\x33\x95\x5d\x23\x06\x54\xd1\x13\x70\x29\xaf\x3c\xb1\xa2\x9d\xda\xa6\x22\x27\x5a\x37\xca\xfb\xc4\x0a\x90\xd1\x85\xa9\x24\x19\x6a\xe8\x16\xc2\x5b\x6b\xb4\x61\x50\x47\x55\xfc\x09\x42\xe1\x7b\x59\x80\xb5\xfe\x77\x99\xcf\xef\xde\xb2\x63\x43\xe4\x39\x5d\x42\xe8\x99\x96\x16\xe2\xa8\xc0\x1e\x96\xb6\x6a\xf9\xad\x3d\x73\xb0\xc3\x64\xa2\x3f\x04\xe7\xa5\x09\xec\x7b\x91\xaf\x0c\x13\x14\xa7\xfa\xd7\x56\xc8\xe8\xc9\x40\xa5\x1d\x78\x95\x5c\xc2\x68\x3b\xf9\x9a\x00\xb1\x80\x06\x12\x99\x6c\xe6\x6c\x20\xd8\x04\x02\xbb\xe7\x2c\x21\x7f\xb2\x17\xe1\x78\xdc\x86\xc4\x88\xf5\x8c\x8b\x3c\x92\x9b\x52\x6a\x9a\xa0\x6b\xf6\x5f\x92\xea\xb5\x5a\x92\xca\x9a\x3a\x2a\xb4\xa4\xb6\xdb\x14\x1f\xe2\x65\xf6\x5b\x92\xca\x4a\x1e\x49\xc3\xc6\xf7\x94\xaa\x78\x9f\xa4\xb2\x5e\x61\x5d\x0a\x20\x0d\x35\x13\xcf\xaa\x59\xdd\x22\xfd\xdf\x51\xb3\x17\x29\x41\x34\x23\x02\xbb\x97\x5c\x2d\x36\x54\x55\xf5\xba\xa3\xaa\x0b\xbb\x04\xc6\x08\xcc\x4f\x22\x63\x4e\x89\xdb\xf9\x21\xeb\xaa\x55\x59\xf4\x48\x1b\xe7\x49\x86\x1d\xe6\x50\xb0\xbb\x33\x76\xf0\x8e\xdd\xbd\x6e\x1b\x1c\x77\xaf\x29\xe1\x0c\xa1\x52\xb1\x08\x51\x3d\x41\x11\xf8\x66\x0b\x84\xe2\x64\xbd\x48\xe0\x68\x3e\x55\xb2\x20\x81\xdd\x4d\x83\xc6\xf8\x1b\x42\x6b\x43\x5b\x26\x6c\x81\xc2\xe6\x54\x81\x72\x03\x09\x76\x17\x7a\xac\xe6\x84\x36\x68\xa0\xc3\xa3\xaf\x15\xf7\xdb\xe1\xb3\xda\x8c\x64\xa0\x7c\x5a\xe4\xb0\x2d\x64\x02\x3f\x4b\x5d\x8f\x6f\x53\x52\xdf\xdb\x4e\xa0\xa1\x9a\x09\xbf\x36\x9a\x47\x39\xf1\x90\x85\x16\x82\xe8\x9e\x55\x25\x14\xb0\x6b\x74\x2b\x69\x87\xad\x52\xa3\xef\xe6\x9c\x2e\xe2\xd0\x6b\x6c\xc5\x7a\x83\x8b\xac\x0b\xd7\xaf\x0e\xfb\x3f\x65\x6b\x8e\x48\x27\xb1\x0a\x53\x5d\x8d\x67\xd5\xa2\x3e\xc5\xea\xcd\x27\x13\xa8\x5d\xb8\xbe\xf4\x29\x2a\x72\x7e\x55\xaa\xf8\xde\xac\x19\xec\x15\x83\x3e\x8a\xa0\x0d\x1b\x8d\xb3\x26\x14\xf3\xc9\x10\x9c\x7c\x0c\xa6\xd8\xc2\x0b\x23\x1e\x58\x18\x4e\x69\x63\x8f\xc7\x81\x17\x4b\xda\x79\xae\x14\x3a\xa1\x42\x34\x55\xf1\xe0\xb9\xe5\xd8\x5f\xd8\xf1\x56\xbb\x15\x33\xaf\x9b\x71\x4c\x50\xf5\x05\xbf\xb6\x5b\x11\x28\x9b\x08\x11\xce\xa3\x47\xf8\x94\x8b\x99\x8c\xfa\x00\x8a\x3f\xab\xf3\x98\x7e\x81\x02\xb5\xb5\xab\xed\xe9\x91\x9e\xb8\x6d\xf2\x1e\x15\x4e\x1d\x06\x08\x57\xef\xb7\xd6\x70\x6b\x90\x5a\xe7\x52\x1b\x80\xd8\x10\xe3\x2e\xec\x1c\x7b\x1b\xb0\xd8\xfc\x53\x3e\xa5\x88\xc7\x05\x5d\xe1\x2d\x78\xf4\x88\xfd\x61\xc4\x74\xa2\x36\x84\x02\x3f\x3c\xe5\xe5\x0e\x93\xc2\x8d\xf9\x3e\x10\xf2\x1c\x2d\x27\xc3\x98\x02\x07\x98\x17\x04\x7a\xc5\x47\xc6\x95\x48\x3d\x6b\x2d\x29\x32\xff\xdc\x9c\xda\x37\x80\xc6\xc6\xfd\xe6\xd6\x91\x9f\xa4\x8f\xf5\x9a\xbf\x68\xb4\x69\x31\xb9\x24\xc9\xca\xb0\x7b\xe1\x88\xd3\x88\xa8\x49\xb6\x5a\xb0\xff\x63\xc3\xcb\x70\xf7\xe2\x21\x66\x0a\xfe\xb3\xc3\xac\x49\x2f\x7b\x61\xa4\xf4\x72\xc3\x86\x66\x7d\x8a\xab\xce\x6e\x4c\x1b\xc7\xcb\xf0\x8e\xc7\x4a\x09\x6b\xa3\x13\x61\x59\x14\xd5\xb1\x38\xe4\xc5\x58\xc4\x7e\x0d\x6d\x13\x64\x49\xc4\xd6\x5c\x08\x6f\xc9\xc5\xb3\x46\xaa\xee\x78\x20\x70\x3b\xd7\x36\xe3\xbf\x6c\xbe\x4a\xf3\x28\x72\x61\x07\x15\x52\xd9\x2a\x0e\x80\xa2\x03\xc4\x49\x7c\x10\x85\x31\xf7\x32\xb2\x7a\x90\xe4\x7b\xa2\x94\x53\x28\x2c\x10\x83\x0c\xd3\x38\xa2\xf5\xe1\x37\x49\x16\xa8\x15\x1d\x27\x42\x58\x9a\xe9\x62\x1a\xa7\x40\xc4\x69\x12\x1f\xaa\x73\x94\x88\xeb\x86\x64\x93\xc2\x52\x86\x5b\xea\x48\xa9\x02\x92\xb1\xf6\x5b\xe8\x8d\x31\x15\xcb\xf6\x02\x39\x83\x13\xda\x86\xb9\xe5\x16\x6a\x6f\x1e\xf1\xef\x92\x6d\x65\x95\xa2\xa2\x36\x94\x64\x91\x50\x88\xb6\x13\x04\x82\x52\xd0\x16\x49\xb9\xa1\x5c\xc8\x32\x59\xb0\xee\x47\xa7\xfb\x69\x7a\x73\x8d\x33\x0a\x63\x5c\x59\xf1\xbc\x4a\x01\xab\x5e\x2a\x40\x53\x06\x1b\x5d\x84\xb2\x9e\x51\x13\x52\xcd\xa8\x8a\x30\xb3\x54\x18\x53\x2c\x7c\x65\x9a\xea\xab\x34\x5a\x59\x99\x61\xfb\xdc\x79\xc9\xee\x8b\x98\x83\x8a\x30\x64\xc4\x31\xb4\xea\x7c\xf7\x03\x5b\xe5\x21\xf2\x98\x82\x94\x0f\x6b\x88\xac\xc1\x0e\xeb\xb2\xc4\xf3\x21\xc3\xb9\x46\xa4\x91\x0b\x58\x19\x2c\x14\xce\xef\x05\xbf\xe5\x42\x0a\x76\xdd\xe9\x6a\x8b\xd4\x6c\xba\x7a\xbe\x9f\x64\xb8\x20\x29\x7a\x2c\x2a\xf3\x05\xaa\x41\x5d\x37\x25\x8e\xe0\x18\xc2\x5c\x44\xb7\x90\x65\xfc\x6f\x79\x98\x21\x6e\x33\x97\xc9\xda\x93\x21\x36\x1e\xcb\x82\xd7\xde\xa3\xb9\x7e\x0a\xc3\x84\xc6\x8e\x91\x09\xd8\xa0\x41\x26\x57\x39\xbc\x94\x85\x50\x71\x84\x92\xb6\x77\x0d\x16\x7d\x66\x46\x8d\x89\x3f\xa0\xf3\xeb\x05\xa9\xae\x37\xe6\x0b\xce\xb0\xc3\x81\xc5\x41\x8c\xc5\x75\x2c\xeb\xca\x07\x67\x40\x04\xdb\xcb\x63\xa5\xf4\xb0\x14\x4d\xee\x5b\xdf\x35\x5c\x4c\xab\xc1\xcb\xa9\x9a\x81\xe2\x3d\xf6\xf0\xbd\x0e\xda\x2c\xe0\x91\xf4\x0a\xd4\x1d\x82\x20\x85\xac\x00\x0e\x49\x88\xf3\x47\x05\x67\xc0\xde\xab\xcc\x4a\xd5\x55\x52\x01\x41\xf0\xe7\xac\x13\xc3\xb4\x0a\xd1\xf7\x8f\x0a\xfe\x50\x66\x59\xc8\xc2\x0b\x68\x02\x32\x18\x0e\xed\x85\xde\x37\xab\x9a\xc4\x8d\xb5\x2d\x43\xa2\x60\x48\x35\x87\x45\xa9\x20\x24\x95\x95\xc7\x30\x86\xdc\x54\x66\x85\x28\xba\x88\x77\xf1\x10\x2e\x46\xf7\xe6\xc1\xea\xe1\x01\x42\x0c\x11\xeb\x4f\xee\x09\x55\xc8\xd8\x41\x87\xac\x6f\xab\x69\x82\x33\xb8\x74\x2f\x08\x05\x13\x6b\x2f\x8a\x50\xf5\x15\xce\x69\xa9\xe9\x0d\x37\xf3\x51\x00\x20\xf6\xa6\xa1\xc2\x53\x25\x12\x52\xbe\xe6\xc2\x80\xe1\xcd\xc0\x60\x23\x3b\xa9\x89\x9c\x45\x27\x02\xc2\x0a\x19\x6a\x58\xda\x11\x39\x38\xa9\x60\xa2\x69\xc2\x4f\x52\xc4\x79\xeb\x43\xd6\x2c\xf2\x10\x0b\xfd\x88\x4b\x05\xe9\xd8\x01\x9a\x6a\x78\x33\x38\xfc\xa6\x35\x58\x00\x40\xc6\xe7\x6c\xed\x3d\x84\xeb\x7c\xbd\xcd\xc8\x6b\xb3\xf2\xe0\x19\xc8\xca\xcc\x0b\x23\x7c\x58\xb5\x7d\x5f\xb6\xcd\x79\x4d\xe4\xb5\x5e\x62\x3e\x19\x7b\xc7\x8e\x8d\x2c\x92\x85\x2d\xa0\xed\xe4\xac\x0e\x56\xe2\xd9\x58\x56\x58\xf6\x3c\xce\x8a\xc3\xac\x4f\x92\xdb\x90\x57\x34\xf6\x07\x2e\x89\xa6\xca\xa2\xe1\x8b\x4f\x55\x40\xc5\x45\x05\x26\x9e\x40\xe0\x1c\xd1\x62\xf1\x2c\x8d\x62\x06\xc3\x1b\x76\x49\x42\x84\x0d\x10\x70\x9c\xda\xa0\x83\x68\x46\xc6\x95\x81\x72\x6c\x4e\xd2\x2a\xea\x06\x15\xc5\x9c\xa0\x6d\xd7\xa1\x3a\xc5\x56\x65\xa7\x61\x15\xa5\xab\x9b\x87\x41\x63\x5d\x93\x7b\xc4\xab\xe6\x61\x50\x08\xfc\x8f\xaa\xed\x26\x4e\x45\xab\x5a\x4f\x1c\xc6\x5f\x44\xc9\x3d\xa0\xc3\x85\xb6\x88\x0f\x6b\xf5\x30\xf3\x2c\x6a\xd0\x66\xf8\x55\xd4\x63\xca\x25\x5b\xc0\xfc\x7a\xd9\x9a\xef\x3d\x90\xa8\x25\x1f\x35\x16\x97\x35\x45\x24\xb8\x84\xe4\xe0\x18\x24\x0e\xa8\x06\xb0\x02\xef\x78\x44\xce\xb4\xd8\x5b\x73\xfa\x71\xe7\xa9\x04\xdb\x40\xec\xaa\x1b\x35\x0a\x1c\x87\x6c\x68\x2c\xbc\x54\xea\xab\x1f\xee\xbc\x30\x82\x31\xd5\x26\xbf\x34\x53\x77\x34\x98\xdb\x1e\x80\xc4\x68\x5b\x45\x43\xb0\x34\xca\x05\x9b\x75\xc7\xb8\x6f\xe5\x83\x33\x9d\xf5\x47\xc3\xa2\xae\xd3\xc2\x6f\x45\x1c\x8a\x73\x36\x1d\x0d\xf4\xfd\x44\x34\x93\xf7\xc7\xe3\xc9\x68\x36\x72\x67\xdd\xb1\x29\xf3\xbe\xac\x58\x45\x4c\x65\x72\x01\x49\x48\x2b\x38\x93\xac\x11\xa5\x16\x4c\xe1\xa0\x84\x94\xec\xcc\x3b\x2f\xaa\xd0\xd2\x60\xd5\x8d\xde\x24\x95\xb5\x39\x58\x89\x00\xd3\x6e\xc5\x03\x66\xba\xda\xf1\x37\x67\xd1\xe5\x1f\xd0\x9c\xcb\x67\x35\xe7\x13\x4d\xf2\x87\xb4\xc1\xff\x97\xa4\x8e\x8a\xb9\x49\x2a\x5c\x7f\xee\x92\x85\xe4\x0a\x5e\x36\x00\x72\xea\x0b\x4d\x0c\x67\xdf\x9c\xff\x2c\xcf\x06\x1b\xf0\x0d\xb1\x21\xb1\x51\x70\xc8\x70\xb1\x4b\xa4\xf7\xb3\xea\x96\x9a\xc2\x5d\x91\x40\x59\x2f\x90\x8c\x93\xa2\x4a\x7a\x52\x51\xff\x3b\x70\xfa\xc3\xcf\x9d\x81\x56\xcf\x88\x94\xc5\xdc\xaf\x74\x90\xf4\x53\xad\xeb\xea\xa5\x68\xc7\x27\x8c\x35\x59\x0a\x43\x83\x12\x80\xd1\x50\xba\x9c\xe7\x8f\x85\x11\x5f\xec\x6f\x55\xc4\x79\x3b\x37\xa6\x20\x0c\xf5\x62\x5d\xea\x62\xd3\xa6\xcd\xd6\x49\xb0\x11\x81\x02\xd3\x1e\x83\x43\xac\xb2\x30\xbe\x65\x28\x86\x51\xf4\x12\x7d\xfe\xde\x50\x38\x67\x7b\xea\x74\x6c\x8b\x79\x6b\xd8\x23\x98\x32\x08\xa3\x4c\xd8\x32\x4b\xee\x8f\x14\x95\xa2\x20\x18\x82\x26\xe0\xea\xc4\x0a\x31\xc8\xf6\x8a\xc3\x77\x5e\xf0\x9b\x8b\xe2\x2e\xd2\x5b\xf5\x06\x32\xeb\x78\x3b\x22\x25\x17\x0d\x8d\x55\x9d\x4f\x9e\xb3\xf5\xf3\xbc\x7d\xff\x89\xd9\xf7\xd7\x1e\x0e\x8f\x9a\xd6\xec\xdf\xe7\x34\xfb\xe2\xc0\x2c\xdd\x2e\xa3\x0e\x34\x00\x8d\x31\x1b\x0c\x1a\xab\xd0\xe1\x56\x4f\x14\x80\x2c\xaf\x91\x8a\xf7\x01\xb2\x22\x4c\xc0\xc6\xf3\x9d\xa2\x9a\x7e\x72\xc7\x9d\xe9\xb4\x2e\x0e\x0c\x96\xf2\x20\xc5\x9e\xb8\xa5\x61\xd9\x20\x95\x9d\xf7\x94\xb7\x75\xb8\x11\xc8\x96\x9a\xe1\xa7\x22\x40\x50\x76\xfd\xc2\x58\xf0\x4c\x1e\xe9\xcb\x1d\xaa\xd5\xdc\x31\x55\xa4\x7d\x57\xfa\x8d\xe5\x24\x10\x9a\xcb\x20\x22\xbe\xae\xd5\xc8\x5a\x31\x61\x2b\xeb\x45\x2b\x26\x2a\xf1\xdf\xbf\x6c\x22\xb2\xf5\xf6\x7a\xf1\xda\xa9\x0c\x76\xa0\x05\x14\x29\x37\x2b\xdc\xaa\x8d\x8d\x55\xfa\x8f\x5b\xdb\xe1\x7c\x22\xfa\xaa\xb8\x8d\x42\x67\xf2\x18\x93\x5c\x70\x94\xe5\x71\x8c\x11\xa0\x4f\x8c\xfc\xb7\x86\x5f\xcc\xf3\xc5\x39\xaa\x61\x36\xcd\xec\x0c\xb7\xba\xc3\x08\x5c\x2f\x0e\xb0\x6c\xd2\x1a\x54\x15\x6c\xdc\xd9\xb2\xa5\xb8\x8b\x6f\x09\x15\x85\xf4\x59\x2e\xde\x28\x67\x48\xf8\x49\xb1\x52\xc8\x8b\x25\x56\x5f\x3e\xfc\x61\xb2\x60\xdf\x23\x0c\x73\x83\x96\x9b\x11\x8e\x3d\x1d\x87\xd3\x66\xea\xa8\xd2\xce\x7b\x9d\x50\xe1\xba\x1a\x57\x42\x6a\x00\x7b\x0d\xe0\x4a\x47\xa2\x90\x50\x9b\xcd\xf1\xb5\x58\xbe\x50\x6b\xaf\xc5\xf2\xff\xc7\x5a\x1b\x77\x9d\xa9\xb0\x1f\x78\x31\xd4\x7f\x5d\xdb\x64\xbd\x0c\x71\x37\x34\xd9\x22\x24\x06\xe3\xeb\x20\xd7\x31\x4a\xeb\x9b\x0f\xfa\x63\x18\x30\x85\x0d\xd2\x86\x2d\x03\xb3\x95\xee\xa3\x99\x97\xf2\xa0\x6b\x4b\x88\xcc\xe1\xf6\x7e\xa9\x43\xff\x31\xee\xb0\xf9\x5a\x4c\x0e\x28\x56\x94\xc2\x47\x55\x8c\xaa\x63\x00\x12\x79\xc0\x34\xaf\x70\x5f\x29\xea\xd6\x8c\xd4\xa2\x98\xea\x5f\x79\xc6\xbf\x6f\xb0\x97\x17\xb0\xb8\x74\xa1\xe4\xd5\xcd\xb0\x8b\x2d\xa1\xb1\x33\xd9\xbb\x1a\xb6\x8c\x21\xc7\xd8\x7f\xee\xee\x5c\x0d\xf7\xf2\x18\xc7\x07\x5a\x6d\x93\x5a\xff\xf3\x9f\xbb\x3b\x00\xab\x1d\x76\x6c\xb5\x9b\x41\xac\x43\x8c\xdb\x40\xac\x03\x88\x4d\x20\xa5\xe3\xac\x92\x6b\x83\xd8\xe7\x00\x5b\xed\x46\x10\xfb\xb0\xdf\x16\x10\x94\xb7\x8e\xe6\xb5\xda\xcd\x20\xf5\xd3\x77\xad\x76\x05\xa4\x16\xd2\xd0\x84\xa5\x76\xc0\xab\x11\xe4\xec\x9b\x20\xc5\x31\x97\x4a\x66\x05\xa4\x7a\x66\xa3\xd5\x6e\x00\x69\x3a\xa3\xd0\x6a\x6f\x05\xd1\x67\x0d\x5a\xed\xed\x58\xfc\x64\xbd\x2e\xf2\x6b\x20\x95\x60\xfe\x56\xbb\x06\x52\x89\xb6\xb7\x51\x34\x81\x24\x69\x0d\xc2\x06\xb1\x02\xa0\x70\xfa\xb6\xdd\x00\x22\x9e\x06\xb1\x26\xf8\x8d\xae\xa7\x41\x9a\xe5\x5a\x01\xa9\x05\xa9\x36\x61\xb1\x08\xa9\x10\xcb\x56\xbb\x0e\x02\x76\x71\xe9\x43\x43\x8f\x32\x20\x56\xcc\x64\x35\xbf\x04\x29\x62\xe8\x36\x00\xb6\x89\x2e\x49\x2d\x66\x9a\x45\xb7\x0d\x44\xef\xc6\x50\xfc\x4d\x09\xd0\x04\x82\x20\x0f\x1b\xc2\x06\xb1\xf7\xc9\x1b\xb0\xd4\x42\x29\x9a\xb0\xd8\x1d\x13\x3b\xe6\x0d\x58\xea\xa1\x0a\x0d\x20\x36\x86\x3a\x53\x0d\x35\xf2\xc2\xc8\x46\x62\x83\x14\x5b\xaf\x15\x80\x92\x50\xb9\x83\x58\x03\x28\xb1\xd4\x76\xe6\x5a\xed\x0d\x90\xfa\xbe\xda\xd3\x0d\xb0\xda\xd2\xbd\x6b\xdb\x33\x4f\xab\x66\xec\x69\x54\x20\x0c\xc8\x86\x5f\xba\xd5\x7e\x02\x24\xaf\x32\xbb\x51\xe9\x6a\x66\x1d\x44\xfb\xb0\x5a\xed\x6d\x20\x55\x2f\x43\xab\xbd\x09\x62\xc6\x34\xe2\xe6\x5b\xed\x2d\x58\xdc\xad\x50\x06\xa4\xba\xf2\xfc\x86\x74\xb1\x42\xfa\x86\x66\x28\x2d\xf3\x56\x7b\x03\xa4\x66\xbb\xb7\xda\x15\x2c\xa5\x6f\xcf\x64\x94\x7f\x34\x48\xcd\xe8\x6d\xb5\x37\x41\x1a\xfd\x5d\xad\xb6\x0d\x52\x37\x64\x5b\xed\x0d\x2c\x00\xf1\xd2\x34\x7a\xdc\x3e\x37\x02\xc4\x4f\xb2\xdb\x27\x74\x1d\x40\xbe\x31\x8e\x60\x65\xb5\xda\xfa\x15\x25\xb5\x44\x2d\x2e\xf4\xfa\x31\x5c\xaf\x7f\xd4\xdb\x0d\xc9\xa2\x7c\xa2\xa5\xfa\x42\x13\x22\xb4\x85\x3e\xa4\xa9\xe2\x43\x60\x7c\x15\x17\x41\x54\xae\x74\x81\xbd\x87\xcb\x63\xb0\x80\xf1\xa2\xa8\xc1\xe4\x72\x86\x37\xd7\xee\xd5\x70\xef\xa1\xc5\x0a\x0b\xec\x87\x1f\x1e\xca\x1b\xef\x80\xb8\xb8\xb8\xad\x6e\xa4\x55\x70\xb4\x76\x77\xaa\x20\x5f\xe9\x51\x88\xdf\x2f\x76\x7f\x80\x66\x5a\x54\x29\x92\x08\x70\xb3\xab\x75\x47\xf8\xfc\x91\xf1\x86\xcb\x2d\x44\x9b\x91\x39\x12\x30\x9c\xf9\x3e\x34\x77\x4f\x16\x94\x6a\x86\x8d\x5a\xb9\x1e\x36\xdc\x30\xe8\x20\x78\xe1\x66\xe6\xb8\xdd\xe9\xcd\x35\xee\xf7\x1b\x0c\xf4\x75\x86\x55\x40\xf2\x36\xf6\xe9\xa6\x4e\xba\x4b\xb2\x80\x3c\x69\x55\x29\xd7\xec\x25\x5a\xb8\x97\x99\x55\x4b\x49\xb3\x85\xc6\xba\xc2\x2d\xce\xec\x0c\x4b\x6d\xb5\x31\x81\xe5\x08\x0e\xed\x9a\xcd\x36\xbd\xff\xad\xba\x02\xd6\x92\x4d\x17\x46\x7e\xec\x4d\xdc\xab\xbe\x33\xe8\xb9\xd7\x9d\xe9\x27\x76\xfc\xb0\xc0\x6d\x1d\x55\xfe\x1a\x59\xd8\x44\xa5\xaf\x2e\xfd\xd8\x9b\x94\x52\x39\xab\x4b\xe5\xba\x33\xf9\xe4\x5e\x77\x86\x1f\x06\x4e\xcf\x3d\x2e\x01\x7f\x6a\x04\x74\x86\x57\xa3\x49\xd7\x29\xc1\x5e\xd7\x64\x57\x35\x01\xab\xa2\x2b\x52\xb7\x31\xac\x5f\x04\x28\xb1\x1f\xd7\xb0\x6f\x1a\x52\x55\x0a\x9b\xb6\xd8\x56\xe1\xcc\x6e\x86\x74\x23\x4e\x7f\xfc\xf9\xf5\x76\x82\x96\x9d\xb3\x15\xd3\xf4\x53\x7f\x5c\x6b\x32\x6a\xb3\x2a\xd4\xcd\xd4\x99\xa8\x9b\x17\x4b\x6a\x6f\xea\x32\xbe\xea\x4c\x67\xfa\x7a\xc6\xee\xf5\xb8\x04\xfc\x73\x1d\x70\xe2\xdc\x4c\xf5\x43\x23\xfd\x9e\xd5\x93\x9f\x21\xb0\x6d\xd5\xf8\x0f\x67\x32\xa2\x21\xe4\xce\xbe\x5a\x18\xeb\x94\x7b\xa3\xe1\xcc\xbd\x9a\x74\x3e\x98\xfb\x80\x15\xdc\x69\x1d\x6e\xea\xfc\x1f\x77\x78\x73\x7d\xe9\x58\x9d\xef\x55\x8d\xbb\x0d\x5b\xb4\xdd\x98\x87\xd9\x08\xed\xbc\xcb\x9a\xcb\x96\x73\x91\x6e\xa3\x86\x61\xd5\x1f\xf6\x9c\xaf\x65\xfb\xa8\xbf\x9b\xad\xd4\xbd\x99\x4c\x70\x8d\x72\x77\x7c\xb3\x51\xf0\x69\xd6\xc9\xef\xa1\x77\x03\x58\x18\xa7\xb9\x34\xab\xf8\x06\x59\x77\x67\x5f\x07\xce\x90\xb0\xb2\x3d\xcd\x8f\x91\x91\xbe\xce\xf5\x1a\x2e\x7e\x73\x9b\x6b\xd1\x96\x96\x39\xa1\xf5\x69\xf5\x11\xa3\xca\x4e\x80\x79\x74\xa3\xd3\xfb\xab\x3b\x19\x8d\xae\xdd\x21\xde\x12\xd2\x2f\x26\xc1\xf4\xd4\xc1\x3c\x74\x03\xf7\x3a\x24\x1f\x55\xb2\x60\x61\x7c\x60\x4e\x38\x59\x9b\x1b\x08\x46\xd4\x6f\xea\xc1\xa1\x41\x3b\xad\xb6\x0f\x16\x0a\x4e\x5f\x80\x4a\x77\xd6\xdb\x4e\x84\xe2\x99\x28\xd7\xd5\x28\xcb\xf7\xa1\xd4\x5d\x53\xfa\x06\x25\x1d\x6c\x5e\x24\xac\xbd\xec\xb6\xf8\xf8\x5b\xce\x73\xee\xae\xbd\x34\x0d\xe3\x65\x91\x6a\x42\xee\x8b\x04\x7d\x60\x9a\x0b\x1e\xcb\x6a\xa2\xf4\xc3\x3a\x54\x22\x93\x22\x29\xcd\xc2\x24\x0b\xe5\x63\x91\xa0\x0f\x74\xba\xfa\xdc\x68\x99\x5e\xfb\x96\xbe\x5b\x4d\xf0\xe7\xbf\xfc\xf4\x6b\xf1\x85\x25\x43\xf1\x21\x7d\xb3\x1c\x2d\x92\xe0\xb6\xae\x7c\xb8\x3c\x2e\x73\x63\x2f\x0d\xe9\xca\x30\xba\xe8\xb5\x43\x0d\x56\xbb\x54\x8b\x22\xd9\x11\x30\x8d\xed\x15\xba\xdd\x8f\x82\x73\xcc\xb5\x35\x87\x87\x87\xe6\x41\x2d\xdc\x67\xe7\xad\xc3\xa8\xac\x63\xc6\xd7\x89\xe4\x6e\x98\x9e\x5d\x00\xff\xd4\x04\x2b\xe0\x3e\xc1\xfb\x24\xbb\xa5\x0d\x4b\xf5\x00\x82\x85\x04\xe1\x68\x91\x2a\xc4\x9e\x5f\xaa\xa0\xf5\xfa\x97\xb3\x5f\x2f\xd8\xcb\xc9\xa9\x72\x2f\xa7\x08\x37\xdc\x05\x7b\x49\x41\x45\x91\xca\x41\x2c\x45\x0c\x07\x5b\x25\x42\xd6\x8b\x1c\xed\xe3\xbe\x26\xcb\x8c\xd1\x48\x8a\xfd\x08\x75\x75\xad\x1e\x03\xd5\xb3\xe3\xe5\x40\xd0\x69\x68\x6a\xeb\x2e\xb9\x9a\xe4\xee\xce\x2e\x1a\x12\x49\x2e\x74\x63\x1f\x28\xbf\x31\xa8\x64\x22\xea\x29\x52\x8f\x93\x93\xd7\x26\x89\x3f\x94\xa3\x44\x27\x45\xde\x9c\x47\x17\x46\x51\x7c\xc0\xdb\x79\xfa\xa5\x50\xed\x5e\x86\xa3\xcf\x18\xab\x5e\x14\x31\xdb\x38\xc5\xfa\x1a\xfb\x4a\xc5\x09\xa0\x43\xf3\x12\x06\x59\xc4\xca\x32\x9a\x87\x31\x1e\x71\xc3\x15\x42\x9e\x24\xf5\x83\x70\x0d\xb8\x8d\xc3\xe2\xb0\xf2\xbe\x71\xeb\x1f\x50\x00\xaf\x72\x5e\xa6\x59\x72\x87\x30\x1a\xec\x6a\xdf\x7b\x59\x20\x0a\x1c\x61\x84\x4b\x41\x09\x4d\x71\xad\x79\xf1\x40\x14\x66\x0f\x56\x3c\x0a\xa5\x11\x15\x0f\x06\xe0\xfb\x6b\x8f\xdc\xb7\x2b\x2f\x0e\x70\x56\x43\xf0\x34\xf3\x24\x8f\x70\x87\x01\xe7\x74\x3a\x78\xff\x70\xb7\xa2\x6a\x33\x5a\x62\x96\x5a\x76\xf4\xa9\xbc\xd4\xf9\xa4\xf4\x49\x9b\x4b\x97\x7b\x93\x91\xbe\x67\x19\x00\xaf\x0e\x5e\x6f\x82\x14\x47\x93\xdf\x32\x3c\x7d\x78\xb4\xcf\xde\x9d\x9c\xfe\x89\x04\x56\xc0\x62\x46\x00\xef\xb5\xab\x37\x2b\x0d\xb3\x7f\x54\xef\x6f\x58\x4e\x95\x3d\x6d\x9e\xe4\x71\xe0\x06\xfc\xce\x0d\x17\x17\x5b\xf4\x42\x45\x0d\x6f\xe8\xd8\x8a\x5e\xde\xd0\x9b\xb8\x9d\xdf\x28\x94\x0e\x6e\xfb\x14\xec\xa4\x7d\xda\x3e\x3b\xa0\x31\x8a\xd9\x1a\xd7\x7d\xd2\xfd\xa0\x4f\x0d\x47\x03\x54\x0c\x4a\x85\xb7\x50\x1e\x7f\x34\x6a\x4b\x4b\x68\xd4\x4f\xa3\xad\xa9\x82\x02\xa5\x7d\x8f\x36\x3a\xce\xb8\xd3\xfd\xe4\xcc\xdc\x8f\x4e\xa7\x87\x59\x98\x9d\xfe\xf4\x9a\x06\xd6\x0d\x66\xe0\x4a\xc3\xa1\x71\xd1\x11\x8b\x06\xd6\x4f\xa0\x90\xe3\xa5\xc8\xc1\x81\xb7\x35\x76\x5c\x75\x59\x7d\x15\xaf\x5c\x71\xc1\x8b\xfb\x9b\xd5\x60\x3b\xa4\xb5\x18\x1d\x95\x05\xa6\x0a\xb1\x8d\x6e\x55\xee\x9c\x1c\xb2\x9b\x58\xdd\x01\x5b\x29\x51\x3e\xe7\x43\xc7\x43\xcb\x9b\xa2\x82\x2c\x49\xf1\x9c\x4b\xc0\x3c\x76\xef\x65\xb4\x9d\x89\xe3\x76\xe8\x7a\xf8\x36\xbe\x23\x97\xbc\x10\xb4\xe4\xdd\x6b\x59\xc3\xa9\x4c\x46\x27\x2d\x8e\xe2\x3b\x3d\x3d\xa2\x90\x82\xf1\xa3\x7f\x62\xef\x5d\xff\x9c\x7d\xd5\x3f\xcc\xd8\xd9\x6e\xdf\xe8\xb3\x98\xa5\x90\x15\xef\xab\x24\xb9\xad\xd9\x37\xe6\x1a\xdc\x97\xd9\x36\x7a\x1b\xfb\x1f\xcf\x9b\xd6\xad\xb9\x01\xc3\xe4\x92\xd3\x5b\x36\x34\xbb\xb3\x25\xac\xa9\x2c\xc9\x97\x2b\x4d\x89\x36\xb8\xb3\x87\xbf\xb9\xb8\x7a\xd3\xea\xb5\x75\x03\x05\x33\x63\xf6\xf0\xb7\x83\x77\x01\xbf\x3b\x78\xa7\x53\x2d\xf8\xec\xc1\x55\x46\x94\x06\x2f\xe0\xad\x54\x66\xba\x30\xb5\x8c\xb8\xb5\x1a\x66\xfa\xc9\x68\x31\x3c\xba\xaa\xb7\xd3\x9e\x27\x70\xf5\x8a\x9a\x2d\xf3\x76\x5d\xe2\x68\x84\x17\x0b\x5d\xdc\xba\x70\xcf\x28\xb9\xeb\x18\x67\x25\xf7\xf2\x43\xc9\xbd\x7e\xbb\xfd\xac\xf3\x81\x2e\xa0\x67\x6f\x2a\x9a\x12\x43\x8f\xc4\xbc\xfb\x8f\x46\x55\x68\xec\xb6\x37\x4c\x7a\xcb\x5f\x6c\x44\xa5\xc1\xf7\x5b\x28\x79\x80\x63\xb4\x4b\x7d\x93\xaa\x4a\x7e\x88\xbc\x8d\x74\x7d\x0d\x28\xdd\x26\x6a\x15\x33\xd7\x63\x57\x01\x6c\x04\x25\x04\x72\xc8\x5b\x8f\x4d\x29\x52\x8c\xb1\x60\x22\x8c\x7d\xce\xe6\x49\x22\x91\x6a\x75\x01\xc4\xca\x82\xcc\xfc\x11\xd7\x31\x15\xbc\xc5\x19\xf9\x0f\xc3\x60\x93\x6a\x99\x4e\x97\x33\x6d\xbd\xa3\xba\xd9\x36\xc6\x1d\x62\x05\x9b\x31\x97\xb1\xc0\xc4\x53\x4b\x09\xe3\xe4\xdb\x57\xa4\x5a\x8d\x44\x2c\x7d\xbb\x8d\xec\xdb\xf9\x77\x37\x2f\xe0\xdf\x6d\xb8\x60\x7f\x77\xe3\xe6\xfc\xff\xe9\x6a\x9b\x89\xa2\xb2\x05\x6c\xf4\x02\xf6\xa3\x95\xce\xd0\x5b\xbc\x7a\x3c\x79\x71\x50\xdf\xce\xd5\xd7\xc7\xd1\x28\x7b\xd4\x83\x35\xc6\xf4\xa8\x1f\x8c\xb7\x8e\x98\xcd\x1f\x0d\xba\x3d\x7e\xb8\x3c\x2c\xb6\x91\x71\xf5\x41\xc0\x53\xf2\x3d\xaa\x63\x91\xfa\x66\x69\xfd\x0f\x06\x8a\x56\xeb\x35\xdb\x83\x98\x2e\x5b\x0b\xd4\x5d\x6d\x70\x34\x4f\xb7\x08\xae\x91\xd8\x41\xa7\x7d\x12\x7b\xfd\x42\x65\x9f\xb6\x2e\x68\x26\xd2\xdf\xaa\xfc\x77\xd9\x04\x9a\xd2\x37\xec\x0d\xe6\xc5\x7f\x14\xad\x27\x0d\x10\xaa\xd4\xf3\x29\xd4\x09\x68\x69\xef\xb0\xe7\xc8\xdb\x2a\xa7\x1f\xc3\x60\x2f\x2d\x57\x98\x8c\x3b\xcf\x2c\xf7\x7b\x53\x5f\x87\x59\xb1\xad\xab\x9b\xe5\x04\xba\xba\x7e\x49\x07\x87\xa3\xb8\x90\x4c\xc7\xb7\xea\xa3\x1c\x21\xac\x2c\x5c\xe2\x4f\xc6\x3e\x9b\xe2\x26\x7c\x33\xa9\x98\xf1\x92\x71\x5b\x8c\x7b\xf3\x70\xa9\x1e\xc1\x6f\x59\x22\x25\x52\x58\xd8\xc4\xfa\xde\x0c\x8c\x0b\x6e\x1e\xcf\xc7\xb8\xe1\x0b\x5c\xf8\x8a\xe7\x89\x28\x34\x37\x96\xc9\x2a\xda\x6b\x15\x36\x19\xc2\x45\xe9\x0a\x1e\x71\x34\x4f\x17\xf8\x3f\xa6\xa6\xd0\x8b\x0f\x57\x34\x6c\xd8\xf0\x29\x1f\x8b\x27\x9f\xe7\x63\xb1\x85\x57\x0e\xb9\x24\x6d\x58\x56\x7a\xd9\x52\xa0\x77\xef\xa0\x91\x46\xfa\x5a\x90\xe8\xd1\xba\x69\xd2\x7a\xc5\xd2\xbe\x7a\x1b\xf2\x7c\x54\x2f\x9e\xa8\xe8\x1a\xd4\xfe\xd1\x7e\xf3\x92\xae\x96\xaa\x97\x88\x92\x78\x69\x46\x93\x45\x2e\x6b\x40\xc1\xcc\x0b\x1c\xd5\xfe\xbb\x5b\x5f\xfb\xfe\x5f\xb7\xc5\x77\xbb\x2d\xf4\xcc\x25\x5c\x44\x5c\xa3\xc7\x18\x5a\x18\x20\x78\x11\xd2\x1a\x1c\xd4\x15\xd5\x32\x44\x3f\xd0\xa2\x5e\x2b\xc4\xb8\x41\xfc\x17\x50\xe8\x81\x79\x88\x87\x51\xe3\x44\xbd\x67\x69\x40\x35\x2a\x0c\x7d\x04\x8c\xfd\x9d\x67\xc9\x86\x0e\x14\x71\xe0\xfa\xf7\x96\x8d\x2c\x32\x29\xdd\x5c\x5c\x98\xeb\xe5\x3b\x77\x3c\xf3\x96\x3c\x60\x93\xd9\x0c\x3e\xe7\x57\xfa\xe0\xbd\x2f\x2c\x2c\x8d\xd1\xf2\xd4\x6a\xd5\x07\x6e\x50\xd4\x4b\xc3\xa3\x28\x8c\xf3\x87\x23\xe9\xa7\x87\x2b\x0b\x0b\xde\x31\x2a\x2d\x04\xf0\xb1\x0e\x4b\x4b\x0e\x9c\x0a\x21\x57\x19\xb7\x3c\x7b\x99\x7f\xe7\xc6\x96\x33\x05\x40\xf5\xef\x3c\x2e\x97\x04\x6b\x21\x5c\x1f\xa1\x8c\x45\x0a\xf7\xcb\x4b\xfe\x35\x4e\x5c\xc8\x1e\xf0\x28\xbc\xe3\x19\x2f\x05\x03\xd7\x84\x8b\x99\x3b\xbb\xf3\x22\x37\x2f\xe1\x95\x7d\x2d\xf4\x53\x09\x1a\x98\xd3\x1d\x2b\x95\x34\x99\x48\x2f\xc2\xf6\x2b\x72\x8a\x54\xc1\x97\xc2\xb5\xaa\x49\x6b\x94\x7a\x22\x7d\xdb\xb8\x4a\x28\x3b\x35\x4a\x84\xac\x24\x08\xb0\x16\x54\x93\x6e\x5d\xf9\x50\x7a\x47\x71\xb2\x0c\x9b\xb0\x08\xaf\xe0\xe1\x1d\x0f\x6a\xc9\x84\xa0\x70\x4b\xf5\xa0\x54\xd5\xc5\x31\xb4\xa4\x6b\x6c\xf7\xba\xe7\xdd\x3c\x13\xed\x4e\x66\x23\xb7\x7b\xe9\x5e\x0d\x3a\x1f\xd8\xde\x89\xde\xf9\x69\x86\x74\x66\x93\xce\x70\x5a\x83\x3e\xd9\x02\x3d\x9d\x61\x7b\xb1\x0a\x7b\xba\x05\xb6\x33\x18\x18\xc8\x29\x3b\x7e\xf8\x13\xfa\xe8\x35\x6e\x51\xc4\x1b\x62\x51\x64\x4e\x30\x44\x8f\xb4\x57\xad\xff\x42\xab\x96\x41\x86\xcc\x9f\xeb\x9d\x56\xc6\xea\x50\xea\x29\xbf\x01\x9e\xd7\xc3\x35\x5f\xb4\x9c\xbf\x1c\x5f\x15\x27\x40\xf4\x71\x82\x24\x13\xc5\xdc\x63\xde\x92\x7a\x6a\xf2\x29\x56\xed\xc6\xef\x55\xd4\xe7\xf3\xa8\xdf\x6b\xd7\xd2\x66\xfd\x6b\x67\x74\x33\x73\xfb\xc3\xfe\xac\xad\x87\xf1\x74\x85\xd7\xca\x8c\x33\x62\xfa\xf3\xf0\x60\x32\x1b\xe9\xfd\x72\x04\xb7\xe3\x68\x7a\x56\x3c\x02\x75\x70\x82\x03\x22\xfa\x05\x69\x0d\x25\x14\x06\x6d\xaf\x5a\xcf\x41\x55\x68\x4f\xbe\x0c\x7b\x05\xe1\x06\xca\xd4\x7d\xbc\x88\x79\xc1\x1d\xcf\x64\xf8\x77\xeb\x2d\xac\xfb\x30\x0e\x92\x7b\xb6\x57\xb8\x38\x44\x0b\xf1\xbc\x15\x5e\x0a\xe0\x97\xf0\xa4\x0f\x9d\x0d\x9d\xee\xcc\xed\x5e\xb6\x95\x40\xd4\xa1\x28\xdb\x89\x9a\x85\xcb\x95\x34\xf6\x84\x17\x17\xa4\xb0\x16\xbf\x23\x93\x23\x56\x37\x18\x40\xfb\xea\x7a\x84\x7f\xdf\x4e\xb6\x43\x0f\xfd\xb9\xce\x74\xd6\xb9\x1c\xf4\xa7\xf0\x83\x76\x2f\xdb\xac\x99\x38\x3d\x5f\x64\x88\x6e\xa3\x5a\xe6\x72\x21\xbd\x79\x14\xe2\x34\xf7\xee\xce\x16\xfa\xf0\xd8\x34\x31\xf0\x04\xfd\x92\x00\xac\x91\x7f\x92\xfe\xd0\x71\x7a\x53\xd7\xe9\x0e\x8b\xae\xd0\x5f\x58\xf8\x70\x95\x6f\x12\x2f\x71\x96\x37\x89\xcd\x85\x1a\x85\xd0\x61\xee\x09\xe6\x74\x87\xdb\xa4\x7b\xd9\x99\x3a\xee\x64\x56\xf6\x33\x1c\x7f\x9d\xe3\x60\xf2\x64\x36\x53\xb7\x5a\xf9\x49\x46\x27\x60\x74\x54\x88\x66\x1f\x6b\x31\x0f\x96\x16\xde\xd3\xc6\x5b\xd1\x9e\x5c\x15\x56\xe6\x9c\x17\x40\x6a\xd1\x85\x13\x05\x1a\xf0\x09\x6e\xbd\x68\x89\x5d\xac\xd5\x9a\x8e\x47\x2f\xe1\xbc\xc7\x79\x3f\x04\x1d\x07\x21\xae\x6d\x2f\x69\x7b\x76\xad\xd5\x6c\x96\x44\xc1\x21\xa6\x57\xa1\x1e\x80\x2b\x40\xc9\xed\x65\x30\x58\xc5\xb6\x89\x44\xe9\xd7\x42\x20\xe8\xe1\xe6\x61\x2c\x2f\x66\x18\xef\x78\xcd\x56\xbf\x6d\xcc\xb5\x77\x15\x3c\x75\xb2\xe5\x89\x75\x65\x6c\xe8\xd3\x21\x23\x9a\xa7\xd6\xa1\x2c\x79\xef\x64\xcb\xd3\x0d\x38\x99\xd8\xf9\xaf\xce\x41\x10\xbe\xcf\x82\x20\x7f\x48\x71\x79\xc2\x56\xae\x0b\x5d\xdf\x6e\x60\x5c\xdf\x3b\x52\x72\x23\x37\x19\x17\x58\x81\xc0\xf5\x52\x1e\xf7\x3e\x81\x33\xf1\x51\x96\xad\xa9\x58\xff\x81\x09\xbe\xc4\xdb\xad\x95\x3a\xbd\x3a\x37\xda\xc9\x54\xad\xc8\x85\x41\xcd\x98\xf4\x53\xd7\xd0\xa7\x0d\xbf\xbd\x63\xf6\xf6\x9d\x09\xca\x6e\x6d\xab\x99\x99\x97\x1a\x5b\x04\xb6\x9e\x0a\xa0\xc4\x92\xc2\x33\x2b\x4c\xcd\xd2\xc9\x39\x4b\x22\xf5\x28\x66\xbd\x0e\x31\xbf\xaf\xa6\x17\xcb\x38\x33\xed\x00\x35\x41\xa8\xdb\xdd\x8c\xb9\x48\xef\x41\xaa\x3b\x27\xb4\x4d\x7b\x14\xa6\x77\x67\x64\x86\xf9\x58\xea\x05\x5c\x72\x75\xf7\x93\x61\x6c\xce\xe5\x3d\x1e\x3f\xc6\x1a\x08\x58\x75\xdc\x07\xd3\x0f\xb0\x89\x43\xd6\x0f\x70\x51\xc6\xa3\x5e\x1a\x29\x55\x1f\xf3\x3b\x9e\xb1\x95\x97\xa6\x3c\xa6\x69\xae\xaf\x1e\xca\x4d\xf0\x12\xd9\xbd\x7e\xd2\x13\x5e\x94\x20\x20\x27\xb7\x0e\xbe\xc7\x84\x80\x81\xb6\x36\x3a\x18\x27\x29\xf5\x6d\x06\x60\xa0\x32\x8f\x9a\x80\xa6\xc3\x8d\xa9\x11\xda\xde\x52\x79\xfa\xbd\x4e\x93\x33\xfd\x79\xe8\x4e\x9d\xe1\xac\x96\x34\x71\xba\x9f\xad\xa4\xab\xfe\xd0\xfd\xd2\xe9\xcf\x4e\x1a\xd2\x4e\xad\x34\xcc\xb4\x04\x68\xa5\x75\x07\xa3\xa9\x53\xff\xae\x03\x0d\x10\x73\xd2\xe9\x7e\xb2\x93\xfa\xd3\x99\x33\xb4\x12\x80\xa8\x3f\xfc\x40\x0a\x7b\x08\x47\xb5\x7e\xb1\x94\x9a\xb6\xe8\x6d\xc0\x36\x74\xbe\x58\xb5\x28\xd3\xaf\x3b\x5f\x11\xae\x32\x73\xa6\xc0\x31\xe0\xde\x1d\xb7\x8c\x8a\x7f\x33\x5d\xc7\x18\x4a\x28\x82\xa2\xfd\x2f\xec\xe4\xf8\xf8\x64\xc7\x78\x20\x70\xaa\x15\xad\x6f\xe6\x6e\x4b\x81\xe9\x39\x7b\xff\x68\x03\xc9\x74\xd8\xeb\xc2\x16\xe8\x0e\x3a\xd7\x63\xe0\x3b\x35\xa8\x44\x1c\x60\xc5\x81\x2d\xf9\x35\xbd\x8f\x6a\x2f\xa2\xad\x10\x0f\x1a\x8c\x7a\x31\xfd\xfa\xcc\x6c\x4c\x16\xf6\xa9\x3e\x73\x54\x7c\xeb\xb3\x47\x9b\x4e\xe7\x9e\xf3\xb9\xfb\x01\x2f\x73\xbb\xd7\x9f\x86\x23\x2b\x70\xe7\xb8\xb5\x05\x6e\xe2\x74\xec\xf8\x9e\x6d\x60\x5f\x26\xfd\x99\x15\x95\x75\x5a\x7b\xca\x57\x01\xf6\x9c\xcf\xee\xe5\x60\xd4\xfd\xf4\x0d\xba\x80\xeb\x7e\xec\x58\x81\x3b\x27\xad\x8a\x64\x54\x84\x34\x3c\x9a\x2e\x4e\x50\xa9\x87\x72\x95\x77\x86\xe2\x37\x18\x8f\x31\x98\x68\xa9\xb7\x57\xe2\x05\xa3\xfb\xa0\x7a\xf2\xba\xc5\xfe\xab\x46\x70\xdf\x5a\x7d\x59\xa8\x8a\xc5\xc2\xda\xfb\x2d\xd1\x12\xc7\x57\x18\x27\xd9\xc6\xb6\x7a\xf5\xd5\x2d\x17\xee\x8d\xb2\xd1\xf0\xf5\xcb\xf1\xaf\xba\x51\xe0\x78\x59\xa0\x1b\xb8\x37\x9d\x71\xdf\x75\x07\xfd\xe1\xcd\x57\xea\x2d\x1f\x5d\x97\xed\x1f\xed\xfe\xbf\x03\x00\x27\x9c\xef\xb6\xfd\x94\x00\x00")
+
+func bindataBpfBpfHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataBpfBpfH,
+		"/bpf/bpf.h",
+	)
+}
+
+
+
+func bindataBpfBpfH() (*asset, error) {
+	bytes, err := bindataBpfBpfHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/bpf/bpf.h",
+		size: 38141,
+		md5checksum: "",
+		mode: os.FileMode(436),
+		modTime: time.Unix(1677602370, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataBpfBpfhelpersH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x5a\xfd\x6e\xdb\x38\xf6\xfd\x3b\x7e\x8a\x8b\x06\xf8\x41\xca\x78\x9c\xcf\xe6\xd7\xd9\xa0\xb3\x70\x53\xa7\x35\xa6\x4d\x02\x3b\x99\xc1\xa0\x13\x10\xb4\x74\x65\x73\x2d\x89\x5c\x92\x4a\x9c\x1d\xcc\x03\xed\x6b\xec\x93\x2d\x48\x7d\x58\xb6\x69\xcb\x6e\xd3\x0d\x8a\x22\x0e\x0f\xcf\xb9\xbc\x87\xbc\xa4\x68\x1d\x1e\xb4\x2e\xb9\x78\x96\x6c\x3c\xd1\xf0\x9f\x7f\xc3\xc9\xd1\xc9\x11\x7c\xb8\xef\x7f\xfa\xd4\xbd\xff\xdc\x83\xab\x9b\xfb\xc1\x75\xbf\x37\x68\xb5\x3e\xb1\x00\x53\x85\x21\x64\x69\x88\x12\xf4\x04\xa1\x2b\x68\x30\x41\x28\x5a\xda\xf0\x2b\x4a\xc5\x78\x0a\x27\x9d\x23\xf0\x0c\xe0\x55\xd1\xf4\xca\xbf\x68\x3d\xf3\x0c\x12\xfa\x0c\x29\xd7\x90\x29\x04\x3d\x61\x0a\x22\x16\x23\xe0\x2c\x40\xa1\x81\xa5\x10\xf0\x44\xc4\x8c\xa6\x01\xc2\x13\xd3\x13\xd0\x73\xf6\x4e\xeb\xf7\x82\x80\x8f\x34\x65\x29\x50\x08\xb8\x78\x06\x1e\xd5\x51\x40\x75\xab\x05\x00\x30\xd1\x5a\xfc\xed\xf0\xf0\xe9\xe9\xa9\x43\x6d\x94\x1d\x2e\xc7\x87\x71\x8e\x52\x87\x9f\xfa\x97\xbd\xeb\x61\xef\xc7\x93\xce\x51\xab\x75\x9f\xc6\xa8\x14\x48\xfc\x67\xc6\x24\x86\x30\x7a\x06\x2a\x44\xcc\x02\x3a\x8a\x11\x62\xfa\x04\x5c\x02\x1d\x4b\xc4\x10\x34\x37\x71\x3e\x49\xa6\x59\x3a\x6e\x83\xe2\x91\x7e\xa2\x12\x5b\x21\x53\x5a\xb2\x51\xa6\x17\x12\x54\x46\xc5\x14\xd4\x01\x3c\x05\x9a\xc2\xab\xee\x10\xfa\xc3\x57\xf0\xae\x3b\xec\x0f\xdb\xad\xdf\xfa\x77\x1f\x6f\xee\xef\xe0\xb7\xee\x60\xd0\xbd\xbe\xeb\xf7\x86\x70\x33\x80\xcb\x9b\xeb\xf7\xfd\xbb\xfe\xcd\xf5\x10\x6e\xae\xa0\x7b\xfd\x3b\xfc\xd2\xbf\x7e\xdf\x06\x64\x7a\x82\x12\x70\x26\xa4\x89\x9d\x4b\x60\x26\x75\x18\x76\x5a\x43\xc4\x05\xf1\x88\xe7\x6e\x29\x81\x01\x8b\x58\x00\x31\x4d\xc7\x19\x1d\x23\x8c\xf9\x23\xca\x94\xa5\x63\x10\x28\x13\xa6\x8c\x79\x0a\x68\x1a\xb6\x62\x96\x30\x4d\xb5\xfd\xbc\x32\x9c\x4e\xeb\xe0\xb0\xb5\xcf\xa2\x34\xc4\x08\x08\x79\x77\x7b\x45\x3e\xf6\x3e\xdd\xf6\x06\x43\xf2\xb1\xb5\x1f\x62\xc4\x52\x5c\xf9\x7b\xd5\x90\x60\x32\x42\x49\x68\x18\x9a\xc8\x3d\xc5\x33\x19\x20\x51\x5a\x66\x81\x36\x09\xb5\x1f\x73\x90\x0f\xbb\xfe\xfc\xd1\xda\xf3\xfe\x2c\x3f\x7c\xa7\x9f\x3f\x5a\x7b\x7b\x8f\x9c\x85\x70\x40\x88\x44\x7d\x51\xfe\xfd\xc5\x7e\x8c\x80\xa5\x86\xb7\xe0\xe5\x4a\xbe\xe7\x79\xc1\x84\x4a\x38\xf0\x17\x12\xe6\xc3\x0f\xc0\xa3\x48\xa1\xe6\x91\xa7\x9f\x05\xf2\xc8\x3b\x58\x44\x2c\xe7\xd4\xbf\x98\x0b\x5c\x7c\xbf\x14\xfd\xe5\xcf\x2d\x1f\x89\x88\x08\xc9\x52\x3d\xf5\xa2\x44\xb7\xa1\xd3\xe9\xf8\x2f\x66\xa0\x19\x8c\x4d\x0d\x21\x84\x44\x89\xfe\xf2\x00\x6f\x21\x4a\xd6\xf9\x62\xe0\x26\x1e\x2d\x69\x80\x65\x54\x45\xd7\x36\x28\xf6\x2f\x93\xc2\xe2\xb3\xdf\xb6\xa9\xda\xdb\xdb\xdb\x83\xfd\x7d\x42\x7e\xed\x92\xee\xe0\xc3\x90\x10\xff\x62\x75\xb0\x87\x07\x30\xc1\x58\xa0\x84\x84\x06\x92\x9b\x4a\x21\x62\x1a\x20\x08\xc9\xc7\x92\x26\xaa\x0d\x09\x15\xaa\x0d\x45\x11\x02\x96\xb6\xe0\x00\x42\x16\x45\x28\x31\xd5\xa0\x30\xc8\x17\x1c\x4b\x01\xe3\x88\x8c\x44\x64\xeb\x63\x07\x86\x79\x0b\xa4\x34\x41\x65\x3a\x51\x89\xc0\x52\x8d\x52\x48\x34\x05\x65\xf4\x5c\xf5\x88\x39\x0d\x51\xb6\xe0\xe0\xb0\x4a\xff\xb0\x77\xe9\x5d\x77\x3f\xf7\x7c\x20\x84\xea\xa2\x0a\x11\xe2\x79\x85\x62\xde\xd8\x36\x55\x39\xf4\x17\x46\x12\x65\x69\x11\x53\x40\xe3\x18\x43\x88\x24\x4f\x00\xdf\xdd\x5e\x55\xa3\xb2\xb5\x50\x63\x6a\xca\xe2\xa5\x51\x55\xa6\x6e\x04\x90\xcf\x5b\xef\xc0\xe4\x3a\xa1\x82\xc4\x9c\x4f\x33\x41\x30\xc6\xc4\x2f\x26\x75\x42\x45\xbb\xc0\x4d\xf1\xd9\x87\xb7\xad\xbd\x72\xba\x9b\x9a\x72\x75\x7f\x7d\xb9\xdc\xf5\xa2\xa4\x67\xa9\x86\x39\x79\x26\x42\xaa\x71\x03\x79\xf9\xeb\x23\x8d\x33\x6c\x17\x9e\xee\xed\x01\x64\xa9\x62\xe3\x14\x43\x88\x79\x3a\xce\xff\x8b\x62\x3a\x56\xeb\xa3\xa9\x69\xad\x89\x26\xc4\x18\x35\x7e\xd5\x50\x6b\x5d\x1d\xe4\x42\xf2\x11\x12\x89\x34\x2c\x79\x43\xa5\xdb\x16\x60\x26\x6e\xa9\x90\xa5\x8a\x46\x48\x84\x96\x6e\xa1\x39\xcd\x46\x0d\x53\x40\x5e\x48\xc7\x50\x55\x5a\x8e\x94\xe7\x4e\x4e\x35\x4b\x90\x8c\x51\x93\x54\xe5\xc2\x6e\xde\x3a\xce\x31\x82\xfa\xca\xf6\xbd\x80\xa7\x4a\x83\x2d\x10\x07\x76\x89\x9b\x6c\x45\x89\x26\x79\xc6\x6c\x29\x72\x89\xd4\x59\x2a\x11\x0b\x2a\x54\x28\x8b\x89\x59\x15\x65\x8a\x02\x3d\x2b\x13\x63\x27\xb6\xd1\x61\x69\x88\xb3\x35\xfc\x65\xff\xe6\xbc\x98\x91\xaa\x44\x98\x7c\x06\xa8\x14\x97\x84\x15\x13\xc0\x4d\xed\xc2\x6f\xa7\x12\x64\xd2\x94\x22\x22\x58\x48\xf4\xb8\x59\x65\x19\xbf\x9b\x4a\xc6\x42\xb2\x83\x48\x01\x77\x38\x5e\x47\x05\x3c\xa9\x56\xdd\x28\x8b\xf2\xd5\x31\xca\x22\xeb\x77\xb3\x88\xe9\xbe\xdb\x28\x34\x55\xd3\x6d\x87\x60\xb0\xbb\xb1\x07\x63\xc9\x33\xb1\x85\xe1\x2b\x1d\x9a\x75\x04\xca\x88\xe0\xa3\xe9\x55\xaf\x29\x66\xf6\x56\x15\xd2\xfc\xdb\xb1\x48\x2e\xd1\x3a\xfc\x0a\x62\x9e\x9a\x02\x14\x32\x89\x81\x2e\x75\xed\x02\x32\x6e\xb1\xc8\xae\x9b\x62\xa9\xae\x17\x5a\xa4\x71\xe8\xcc\x15\x76\xa1\xdd\x82\xd0\xd4\xea\x7a\xba\x2c\xc4\x6e\x33\x5b\x72\x1b\x02\x07\x7f\x2d\x75\x3c\xd3\x22\x5b\xcc\x8d\xcb\x9e\x75\xd6\x94\xe8\x90\x6a\x5a\x83\x97\x25\xbc\xd1\xb9\x5c\xde\x11\xa2\x99\x9c\x8a\x07\x53\x33\xe5\x38\x9f\x32\xac\x85\xe8\x66\x5d\xe9\x51\xb1\x56\xc1\x3b\xe9\x33\x16\xee\xc2\x9d\xad\x2d\x0d\x4a\xd3\x60\xca\xc2\x35\xb9\x6c\x9a\x0e\x35\x06\x07\x7f\xbe\x97\x9a\x33\x10\x92\x4c\xe1\xe2\x86\x99\x53\x29\x19\xcc\xf7\x4e\xb7\xc6\x32\x8b\x43\xa8\x5c\xdf\xa6\x84\x10\xfb\x48\x56\x2c\x75\xdf\x5b\x1a\xcb\x86\x4d\x67\x2d\x49\x25\x98\x9d\x9f\x15\x82\x63\xac\x8a\x49\x10\x53\xa5\xb6\x74\x63\xb1\x8b\x63\x20\x6a\x3a\xb2\x40\x9d\xa5\x29\xc6\xc4\x1c\x87\x56\x8d\xa9\xd6\x92\xc9\x59\xe3\xaa\x5a\xa5\x5c\xa3\xab\x5e\x5e\x57\x6d\xa3\x5b\x0b\x8e\x0b\xe7\xa2\x0e\xe7\xb2\x5b\x8d\x91\x0b\xdd\x3c\xc6\x6f\xd1\x52\x6e\x2d\x47\xbd\x99\x4f\x17\x21\x69\x1a\xf2\x84\x64\xa7\x27\x4d\xdb\x55\x0d\xea\x18\xc7\x2c\x14\x84\x86\xff\xc8\x94\x26\x93\xda\xce\x54\xed\x10\xf9\x23\xaf\x9b\x7d\xa9\xef\x66\xf6\x04\x35\xfd\x5a\x76\xd3\xd7\xc1\xae\x50\x9b\x02\xb6\x9c\x7b\x93\xf1\x18\x1f\x31\x2e\x34\x84\x36\x4f\x73\xa5\x27\x5c\xe8\x47\x1a\x57\x85\xba\x84\xc4\x98\xba\xc3\x98\x8b\x38\x22\x18\xff\x2f\x22\x18\x6f\x8a\x40\x4d\x9d\x5b\xa5\xab\xf2\x56\x2b\x6e\xe3\x3a\x6b\xda\x38\xcd\x78\x6b\x4f\x67\xee\xc7\xae\x17\x7a\x08\x5c\xd2\xda\xbc\x8d\x9b\x83\x15\xb1\x82\x0b\x31\xad\x53\x9c\x47\x55\xde\x31\xd9\x33\x6c\x05\x6f\x3e\xcc\x3a\xb5\xd7\xc5\x68\x1e\xe2\x1d\x21\xd6\x7c\x32\xf2\xf3\x98\x00\x76\x0e\x65\x49\xc2\x11\x89\xb9\x84\x94\x2c\x34\x27\x39\x9d\xc9\x74\x21\x88\x4a\xcd\xa6\x48\x06\x6e\xa1\x25\x86\x0b\x7b\x85\x11\xc7\x8f\x09\x8c\x32\x16\x6b\x96\xd6\x2e\x32\xf4\x84\xea\xfc\x06\xe3\xb2\xbc\xc3\xb0\xb7\xca\xf6\x4a\x9a\x9b\xcb\x15\x4c\x98\x06\x33\x88\x4f\xef\x49\xf7\xdd\xd0\xdc\x87\x96\x1f\xfb\xd7\xef\x81\xa5\xf9\x5d\xa5\xb9\x16\xb1\xd7\x2c\xf9\x47\x50\x53\x32\xca\xa2\xe8\xa2\xb5\x18\x73\xf1\x1f\x0d\xc9\xe8\x59\x63\x11\xba\x9a\x8e\xaa\xac\xba\x66\x1f\x8f\x22\x1f\xa8\x4a\xbc\x57\x66\x14\x9d\x91\x88\x3a\xe6\x5e\xa7\x63\x28\x5e\xf9\xeb\x25\x26\x34\x8e\xbe\x51\xc2\x50\x6c\x92\x78\xe2\x32\xfc\x46\x09\x43\x61\x24\x56\xa7\x82\xd9\x78\xaa\x5c\xa9\x85\x99\x50\x14\xe6\x72\x5e\x6a\x5e\x16\xb4\x75\x15\x72\x81\xca\x31\xed\x8c\x96\xd2\x5c\x62\xb3\x98\xb9\xf5\xaa\xe4\xb6\x3b\x15\xcc\x89\x1d\xd2\xf1\x29\x09\x54\x96\x10\x89\xf6\x86\xd0\x2d\x6d\x55\x2a\x61\xcd\x1b\x75\x97\x58\x5d\xba\x67\xdf\x45\xf7\xac\x49\xd7\xa4\xda\x75\x64\xdd\xf1\xca\x64\x99\x66\x8d\x52\x30\xa1\xe9\x18\x17\x0e\x0e\xbb\x99\x57\x23\xc8\x4b\xc9\x30\xa0\xa9\xfd\x0a\xa5\x3b\xb8\xfc\x08\x82\x2a\xf3\x35\x98\xa9\x2a\xe6\x36\xd4\xfe\x0d\xd3\x47\x78\xa4\x92\xd9\x6f\x8c\x3c\x85\x08\x9f\xe9\x14\xcd\xed\xad\x6f\x4a\xc4\x3e\x8b\x20\xbf\x8c\x0d\x3d\x42\xee\xba\x83\x0f\xbd\x3b\x62\x3a\x92\xd9\x9b\x73\x7f\xe1\x9e\x5c\x53\x69\xce\x47\xb3\x37\xe7\xae\x3f\x17\x24\xad\x7d\x8c\xd7\x52\xaa\x9f\x4e\x8f\x66\x4e\x52\xdb\xf2\xb5\xb4\x54\x26\xe7\x67\x4e\x5a\xdb\xf2\xb5\xb4\x09\x13\xca\xc9\x6a\x1a\xbe\x96\x54\xf0\x27\x94\x22\x70\xf2\x16\x6d\x5f\x4b\xad\x04\x95\x6e\x62\xdb\xd2\x40\xab\xb0\xb5\x6f\xa6\x70\xe4\x6e\x4f\x43\x16\xd9\x19\x77\x45\xe3\x18\x46\x34\x98\x9a\xaf\x11\x9e\xcc\x96\x65\xa6\x9f\xf9\x7a\x94\xc5\x28\x41\xd1\x67\x55\xcc\xab\xb5\x64\x0b\x23\x98\xbd\x39\x27\xe7\x67\x84\x38\x03\xb7\x73\x6d\x69\xc8\xea\xf4\xa7\xa3\x19\x21\x9b\xa6\xd1\x52\x0f\x4a\x65\x30\x59\xab\x51\xcc\x91\xa5\x3e\xc6\xe3\x35\x1d\x4c\xd3\x0a\xbe\xf0\x8e\x90\xcd\xce\x2e\xf5\xb2\xc6\x10\xb2\xc9\xb4\x3c\xf3\xa5\x01\xf5\xe5\x5a\xc3\xda\xb5\x5a\x91\xdc\xde\x91\x41\xef\xc3\x90\xdc\x76\x07\x9f\x8f\xbd\x99\x0f\x9e\x37\xf3\x7f\xfc\x39\x64\xbe\x13\x72\x32\x87\xa8\x35\x90\xd3\x1a\xcb\xcc\x0d\x39\x9b\x43\x82\x35\x90\xd7\x73\x88\x7c\xb3\x0a\x19\xf4\xee\xe6\x00\x25\x56\x01\x57\xb7\xf3\xf6\x91\xa3\x7d\x70\x39\x6f\xa7\x8e\x18\x86\xb7\x9b\xf9\xfb\xb5\x76\x26\xfc\xd6\x92\x5d\x75\x6f\xcc\x1c\x6c\xce\xf8\x58\x48\xf5\xe5\xe4\xa1\x31\xed\x16\x77\xfa\xd0\x98\x7b\x8b\x3b\x7b\x68\x34\xc0\xe2\x5e\x3f\x34\xba\x60\x71\xe7\x0f\x0d\x56\x58\xd4\xf1\xd9\xc3\x66\x43\x72\xd4\xf1\x83\x0f\x87\x07\xf0\x1b\x97\x53\x05\x3c\x8d\x9f\xf3\xb7\x25\x2e\x6f\xae\xaf\xfa\x1f\xc8\xd5\xa0\xfb\xb9\x47\x6e\x6f\xfa\xd7\x77\xbd\x41\xfd\x7b\x40\x97\x83\x6b\x93\x57\xb7\xd1\x82\x8e\x5f\x3f\x6c\x36\x53\xa8\xa7\x8e\xf9\x56\x7f\x93\xa5\xc5\x26\xd2\x64\xa9\xc4\xb1\xfa\x72\xd4\x6c\xa9\xc5\x1d\x37\x5b\x6a\x71\x27\xcd\x96\x5a\xdc\x69\xb3\xa5\x16\x77\xd6\x64\xa9\x45\x9d\x1e\x35\x58\x6a\x51\x27\x3f\xbd\x9c\xa5\x6b\x93\xb7\xcb\xca\x14\xc1\x26\x1b\x4d\x71\xde\xd2\xc5\xb3\x2d\x5d\x7c\xbd\xa5\x8b\xe7\x5b\xba\xf8\xff\x5b\xba\xf8\x66\x3b\x17\x8f\xb7\x71\xf1\xf4\xe8\x85\x5d\x3c\x6e\x70\xb1\x9a\x3b\x1b\xbd\x0c\xc4\x11\xc1\xcd\x86\x16\x5b\x67\xb3\xa7\x63\x21\xbf\x9c\x36\x5b\x6a\x60\x67\xcd\x8e\x1a\xd8\xeb\x66\x43\x0d\xec\xbc\xd9\x4f\x03\x73\xd9\x3e\xb8\x6c\x8e\x7f\x97\xb5\x91\x36\x6c\x5b\xe6\x48\xd1\x9c\xc9\x8c\x58\xf7\xee\x07\xbd\x0f\xa4\x7f\xd4\x9c\xd2\x05\xfc\x71\x73\x6e\x17\xf0\x5b\xd4\xbe\x05\xfc\x69\x73\xb6\x17\xf0\x67\x4d\xab\x68\x01\xdd\x64\x52\x63\x6a\x86\xb7\x6e\xf0\xd5\xed\x43\xfe\xfe\xca\x70\xc2\xb3\x38\xcc\xdf\x31\xa4\xb1\xe2\x30\x42\xa0\xb5\x73\x1f\x04\x13\x0c\xa6\xb5\x57\xe3\xa8\x0c\x20\xa0\x0a\xff\xbe\xfa\xb8\xb6\x7a\xae\x75\x4d\x0a\x6d\x16\x58\x71\xc2\xdf\x00\xcb\x51\xc5\x31\x73\x7e\xdc\x5c\x3a\xc4\x57\x27\xd9\x82\xc9\x3c\x02\xff\x72\x3b\xb8\x79\xd7\x23\x83\x5e\xf7\xbd\x29\x50\x86\x95\x89\x36\xd8\x2f\x63\xbc\x3f\xc1\x63\xc2\x87\xb7\xe0\x99\xcf\x3f\xfe\x1c\xb3\x74\x7a\x01\x7f\xcd\x43\xb6\x0c\x83\xde\xdd\x0a\xc9\x1a\xee\x62\x7a\xaf\x9e\x94\x77\x8e\xa8\xcc\x82\x99\x0e\xa6\xe9\x9b\xc3\x52\xb8\x4b\x14\x8b\xef\xb4\x78\xff\x67\xa2\xaa\xde\xf3\xb2\xbf\x97\x77\x00\xcb\x81\x6e\x19\xe9\x8e\x6a\xe5\x0b\x65\xd5\xcf\xfc\x15\xbf\x32\x80\xab\x5b\xeb\x22\xfc\x50\xeb\x58\x46\xb3\x38\x75\xfe\x3b\x00\x1a\x64\x99\x83\xbd\x2b\x00\x00")
+
+func bindataBpfBpfhelpersHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataBpfBpfhelpersH,
+		"/bpf/bpf_helpers.h",
+	)
+}
+
+
+
+func bindataBpfBpfhelpersH() (*asset, error) {
+	bytes, err := bindataBpfBpfhelpersHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/bpf/bpf_helpers.h",
+		size: 11197,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792221301, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataBpfBpfmapH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\xcd\x6e\xe3\x36\x14\x85\xd7\xe1\x53\x1c\xb8\x9b\x36\x70\x6d\xc3\x40\xbb\xa8\xd1\x85\xec\x28\xa9\x5a\x47\x0a\x2c\xbb\x41\x5a\x14\x02\x2d\x5d\x49\x17\x95\x48\x0e\x49\xc5\xf6\x0c\xf2\x40\xf3\x1a\xf3\x64\x03\x39\x1e\xe4\xc7\xb3\x3c\xf7\x3b\x20\xcf\xb9\x77\x7c\x29\x16\xda\x1c\x2c\x57\xb5\xc7\x97\xcf\x98\x4e\xa6\x13\xdc\x6c\xa2\xe5\x32\xd8\xdc\x86\xb8\x4e\x36\xab\x38\x0a\x57\x42\x2c\x39\x27\xe5\xa8\x40\xa7\x0a\xb2\xf0\x35\x21\x30\x32\xaf\x09\x27\x32\xc4\xdf\x64\x1d\x6b\x85\xe9\x68\x82\x1f\x7b\xc3\xe0\x84\x06\x3f\xcd\xc4\x41\x77\x68\xe5\x01\x4a\x7b\x74\x8e\xe0\x6b\x76\x28\xb9\x21\xd0\x3e\x27\xe3\xc1\x0a\xb9\x6e\x4d\xc3\x52\xe5\x84\x1d\xfb\x1a\xfe\xe5\xf5\x91\x78\x38\x3d\xa0\xb7\x5e\xb2\x82\x44\xae\xcd\x01\xba\x7c\xed\x82\xf4\x42\x00\x40\xed\xbd\xf9\x6d\x3c\xde\xed\x76\x23\x79\x4c\x39\xd2\xb6\x1a\x37\xcf\x2e\x37\x5e\x46\x8b\x30\x4e\xc3\x9f\xa7\xa3\x89\x10\x1b\xd5\x90\x73\xb0\xf4\xa1\x63\x4b\x05\xb6\x07\x48\x63\x1a\xce\xe5\xb6\x21\x34\x72\x07\x6d\x21\x2b\x4b\x54\xc0\xeb\x3e\xe7\xce\xb2\x67\x55\x0d\xe1\x74\xe9\x77\xd2\x92\x28\xd8\x79\xcb\xdb\xce\xbf\x59\xd0\xb7\x54\xec\xf0\xda\xa0\x15\xa4\xc2\x20\x48\x11\xa5\x03\xcc\x83\x34\x4a\x87\xe2\x3e\x5a\xff\x91\x6c\xd6\xb8\x0f\x56\xab\x20\x5e\x47\x61\x8a\x64\x85\x45\x12\x5f\x45\xeb\x28\x89\x53\x24\xd7\x08\xe2\x07\xfc\x15\xc5\x57\x43\x10\xfb\x9a\x2c\x68\x6f\x6c\x9f\x5d\x5b\x70\xbf\x3a\x2a\x46\x22\x25\x7a\xf3\x79\xa9\x9f\xaf\xe5\x0c\xe5\x5c\x72\x8e\x46\xaa\xaa\x93\x15\xa1\xd2\x8f\x64\x15\xab\x0a\x86\x6c\xcb\xae\x3f\x9e\x83\x54\x85\x68\xb8\x65\x2f\xfd\x51\x9f\xd5\x19\x89\xcb\xb1\xf8\xa1\xa0\x92\x15\x61\xbe\xb9\xce\xd2\xe8\x9f\x30\xbb\x0d\xee\xb2\x38\xc5\xf4\x97\x5f\x85\xf0\x07\x43\x05\x95\x70\xde\x76\xb9\xc7\xd6\x94\x59\x2b\x4d\x56\x50\x29\x3e\x89\x8b\x4e\x39\xae\x14\x15\x60\xe5\xd1\x5b\x67\xef\x66\xff\xd3\x21\x73\xfc\xf1\x6c\xfe\x28\x9b\x8e\xbe\x4b\x5a\xb9\xcf\x48\x79\xcb\xe4\xce\x91\xc9\xca\x46\x56\x67\x80\x95\x22\x7b\xcc\xc5\xc5\xfe\x3d\x34\xac\xfa\xc5\xcc\xc4\x45\x5e\x4b\x0b\x25\x5b\x72\x46\xe6\xf4\xef\xbb\xbe\xff\xcd\xc4\xd3\xeb\x7e\x33\x21\x48\x75\xed\x71\x64\x58\x65\x7d\xbd\xbe\xf3\x5d\x14\x67\x71\x12\x87\xf8\x1d\x93\xe1\xb3\x4c\xe6\x7f\x86\x8b\x75\x16\xa7\x27\x7d\xb3\x4c\xe6\xc1\xf2\x45\x2f\x36\xe9\x3a\xb9\xcd\xe2\x74\x28\x9e\x66\xe2\xeb\x00\x4e\x8c\x49\xf2\xa8\x03\x00\x00")
+
+func bindataBpfBpfmapHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataBpfBpfmapH,
+		"/bpf/bpf_map.h",
+	)
+}
+
+
+
+func bindataBpfBpfmapH() (*asset, error) {
+	bytes, err := bindataBpfBpfmapHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/bpf/bpf_map.h",
+		size: 936,
+		md5checksum: "",
+		mode: os.FileMode(436),
+		modTime: time.Unix(1677602370, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataConstH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x95\xdf\x6e\xdb\x36\x14\xc6\xef\xf9\x14\x1f\x54\x0c\xb0\x0b\xc5\x36\x82\xa2\x17\x0d\x12\xc0\x4b\xd2\x55\x98\x67\x03\xfe\xb3\xa2\x57\x02\x2d\x1d\xd9\xc4\x28\x52\x23\xa9\xb8\xc6\xb0\x07\xda\x6b\xec\xc9\x06\xc9\x5e\x6c\x42\x92\x1b\x64\xbd\xd8\x5d\xc2\xf3\xd3\xe1\xf9\x7e\x47\xb6\x87\x6f\xd9\xbd\x2e\xf6\x46\x6c\xb6\x0e\x7f\xff\x85\xeb\xd1\xf5\x08\x3f\xad\xa2\xc9\x64\xbc\xfa\xe5\x11\x1f\x67\xab\xf9\x34\x7a\x9c\x33\x36\x11\x09\x29\x4b\x29\x4a\x95\x92\x81\xdb\x12\xc6\x05\x4f\xb6\x84\x63\x25\xc4\xaf\x64\xac\xd0\x0a\xd7\x83\x11\x7a\x15\x10\x1c\x4b\x41\xff\x86\xed\x75\x89\x9c\xef\xa1\xb4\x43\x69\x09\x6e\x2b\x2c\x32\x21\x09\xf4\x35\xa1\xc2\x41\x28\x24\x3a\x2f\xa4\xe0\x2a\x21\xec\x84\xdb\xc2\x9d\xba\x0f\xd8\x97\x63\x03\xbd\x76\x5c\x28\x70\x24\xba\xd8\x43\x67\xe7\x14\xb8\x63\x0c\x00\xb6\xce\x15\x1f\x86\xc3\xdd\x6e\x37\xe0\xf5\x94\x03\x6d\x36\x43\x79\xa0\xec\x70\x12\xdd\x3f\x4e\x17\x8f\x57\xd7\x83\x11\x63\x2b\x25\xc9\x5a\x18\xfa\xbd\x14\x86\x52\xac\xf7\xe0\x45\x21\x45\xc2\xd7\x92\x20\xf9\x0e\xda\x80\x6f\x0c\x51\x0a\xa7\xab\x39\x77\x46\x38\xa1\x36\x21\xac\xce\xdc\x8e\x1b\x62\xa9\xb0\xce\x88\x75\xe9\x3c\x41\xff\x4e\x25\x2c\xce\x01\xad\xc0\x15\x82\xf1\x02\xd1\x22\xc0\x8f\xe3\x45\xb4\x08\xd9\xe7\x68\xf9\x69\xb6\x5a\xe2\xf3\x78\x3e\x1f\x4f\x97\xd1\xe3\x02\xb3\x39\xee\x67\xd3\x87\x68\x19\xcd\xa6\x0b\xcc\x3e\x62\x3c\xfd\x82\x9f\xa3\xe9\x43\x08\x12\x6e\x4b\x06\xf4\xb5\x30\xd5\xec\xda\x40\x54\xea\x28\x1d\xb0\x05\x91\x77\x79\xa6\x0f\xdb\xb2\x05\x25\x22\x13\x09\x24\x57\x9b\x92\x6f\x08\x1b\xfd\x44\x46\x09\xb5\x41\x41\x26\x17\xb6\x5a\x9e\x05\x57\x29\x93\x22\x17\x8e\xbb\xfa\xff\x46\x9c\x01\x7b\x3b\x64\x6f\x44\xa6\x52\xca\x10\xdf\xcf\xa6\x8b\x65\xfc\x29\x66\x6f\x52\xca\x84\xa2\xb3\x93\xe7\xa3\xc9\x6c\xfc\x70\x38\x1e\x4f\x97\xbd\x82\x1b\x9e\x87\x78\xe2\xa6\x0f\x6e\xf3\x5e\xf0\xc3\x08\xb7\x08\x50\x9f\x23\x80\x94\x01\x3e\x20\xb8\x35\x41\xaf\x62\xfa\x8c\x0d\x87\x90\x9a\xa7\x71\x4a\xca\x99\x7d\x6c\xc8\x6a\x59\x56\xd3\xc5\xb9\x4e\x09\x57\x98\x68\x9e\xda\x3a\xe5\x01\xc1\x09\x41\x85\xb0\x38\xe6\xee\xe8\x3f\x8e\x7b\x3d\x2e\x77\x7c\x6f\x63\xa1\xa4\x50\xd4\xef\xc3\x56\x61\x13\x94\xef\xdf\x5d\xba\xa8\xd7\xc7\x1f\xf5\xdb\x55\x71\x1d\xb3\xdc\x62\x74\x53\x33\x7e\xe6\xa0\x1d\x0f\xc2\x8e\x3e\xfd\x43\x13\x43\xae\x34\xaa\x83\xb9\x61\x7f\x9e\xd4\x08\xa5\x53\x8a\x33\x21\x1d\x19\xa1\x36\x4d\x31\x35\x80\x67\xe0\x55\x5a\xda\x2e\xf1\xa4\xb4\x4e\xd1\xa5\xa4\x0d\x0e\x42\xb4\x1d\xfb\x3a\xda\x08\x4f\x46\xa6\xa5\xd4\xbb\xa6\x83\xc3\xf9\xab\xa2\x9f\xb5\xf4\x12\x9f\x5f\xd5\x15\xf4\x8c\x09\xc2\xe3\x10\x2d\xb1\xce\x0a\x5e\x1a\x43\x49\x69\xac\x78\xa2\x66\xa0\xe7\xd2\xab\x32\xf9\x8d\xbd\x58\x7e\xa9\x33\x99\x8f\x05\xe1\x69\xa0\x96\x7c\x7e\xcd\x8b\xf8\x94\xd9\x5c\x97\xca\xc5\xb9\x72\xb1\x48\x63\x9d\x65\x96\x9c\x17\xf5\x78\xa4\x33\xd4\xe4\xd5\xdd\x01\x85\x21\xc9\x5d\x65\xc0\xe9\xb3\x4a\x58\xff\x96\xd4\x5f\xc7\xdc\xd5\x9f\x10\x38\x91\xbf\xd4\x90\x7d\xff\xee\xd2\x5c\xcf\xa6\x2a\xae\x63\xf4\x2e\x63\xed\x78\x10\x76\xf4\xf1\x0d\xb6\x33\x9e\x49\xbb\x8e\xeb\x36\xf6\x3f\xaa\xac\xde\xae\xba\x11\x0a\x2d\x54\xe5\x71\xbd\xaf\x2e\xb1\x65\x41\x26\x5e\x4b\x9d\xfc\x76\x75\x67\x8f\x77\x7d\x27\xdb\x1d\xb3\x7b\xba\xbb\xf2\x75\xf9\xee\xe0\x83\x10\x1d\x15\xdf\x78\x07\x74\x41\x79\xfd\x67\xed\xec\xc5\xea\x4f\x8f\xfc\xcf\x56\xd0\xc8\x72\x61\x15\x0d\xf6\x85\x2b\x69\x3c\x17\x84\xdf\xea\x7c\x69\x45\x0d\xd8\x5b\x55\x45\xa8\x2a\x7e\x99\x7f\x63\x3b\x15\xc8\x73\xb2\x05\x4f\xe8\xea\x4e\xd9\x41\xf5\xcc\x77\x92\xdc\x9c\xc2\xf3\xda\x32\x64\x97\xca\x26\x1a\x84\x68\x1e\xfa\xc2\x9a\xf5\xda\xd1\x1b\x52\xa9\xc8\xd8\x3f\x03\x00\x40\x44\x49\xac\x11\x0c\x00\x00")
+
+func bindataConstHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataConstH,
+		"/const.h",
+	)
+}
+
+
+
+func bindataConstH() (*asset, error) {
+	bytes, err := bindataConstHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/const.h",
+		size: 3089,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792221307, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataDentryH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\xfd\x72\x1b\x37\x92\xff\x9f\x4f\xd1\x71\xaa\x14\x52\xa6\x48\xc5\x7b\x75\x77\x65\x85\xae\x95\x63\x2a\x61\xad\x2c\xbb\xf4\x91\x5c\x6e\x6b\x6b\x0a\xe4\xf4\x90\x28\x0d\x07\x13\x00\x43\x9a\xbb\xeb\x07\xba\xd7\xb8\x27\xbb\x6a\x7c\x0c\x66\x86\x43\x52\xb2\xe5\xbb\xe4\x2a\xb6\x4b\x32\xf1\xd1\xe8\x2f\x74\xff\xd0\x80\x34\x3c\xee\x7c\x2f\xf2\x8d\xe4\xf3\x85\x86\xff\xfe\x2f\x78\x71\xfa\xe2\x14\x7e\xb8\x9b\x5c\x5e\x9e\xdf\xbd\x1d\xc3\xc5\xbb\xbb\xeb\xab\xc9\xf8\xba\xd3\xb9\xe4\x33\xcc\x14\xc6\x50\x64\x31\x4a\xd0\x0b\x84\xf3\x9c\xcd\x16\x08\xae\xa7\x0f\x3f\xa1\x54\x5c\x64\xf0\x62\x70\x0a\x5d\x1a\xf0\xcc\x75\x3d\xeb\x9d\x75\x36\xa2\x80\x25\xdb\x40\x26\x34\x14\x0a\x41\x2f\xb8\x82\x84\xa7\x08\xf8\x61\x86\xb9\x06\x9e\xc1\x4c\x2c\xf3\x94\xb3\x6c\x86\xb0\xe6\x7a\x01\x3a\x50\x1f\x74\x7e\x71\x04\xc4\x54\x33\x9e\x01\x83\x99\xc8\x37\x20\x92\xea\x28\x60\xba\xd3\x01\x00\x58\x68\x9d\xbf\x1c\x0e\xd7\xeb\xf5\x80\x19\x2e\x07\x42\xce\x87\xa9\x1d\xa5\x86\x97\x93\xef\xc7\x57\x37\xe3\x93\x17\x83\xd3\x4e\xe7\x2e\x4b\x51\x29\x90\xf8\x6b\xc1\x25\xc6\x30\xdd\x00\xcb\xf3\x94\xcf\xd8\x34\x45\x48\xd9\x1a\x84\x04\x36\x97\x88\x31\x68\x41\x7c\xae\x25\xd7\x3c\x9b\xf7\x41\x89\x44\xaf\x99\xc4\x4e\xcc\x95\x96\x7c\x5a\xe8\x9a\x82\x3c\x57\x5c\x41\x75\x80\xc8\x80\x65\xf0\xec\xfc\x06\x26\x37\xcf\xe0\xf5\xf9\xcd\xe4\xa6\xdf\xf9\x79\x72\xfb\xe3\xbb\xbb\x5b\xf8\xf9\xfc\xfa\xfa\xfc\xea\x76\x32\xbe\x81\x77\xd7\xf0\xfd\xbb\xab\x37\x93\xdb\xc9\xbb\xab\x1b\x78\x77\x01\xe7\x57\xbf\xc0\x5f\x26\x57\x6f\xfa\x80\x5c\x2f\x50\x02\x7e\xc8\x25\xf1\x2e\x24\x70\x52\x1d\xc6\x83\xce\x0d\x62\x6d\xf1\x44\x58\x6b\xa9\x1c\x67\x3c\xe1\x33\x48\x59\x36\x2f\xd8\x1c\x61\x2e\x56\x28\x33\x9e\xcd\x21\x47\xb9\xe4\x8a\x8c\xa7\x80\x65\x71\x27\xe5\x4b\xae\x99\x36\x9f\xb7\xc4\x19\x74\x8e\x87\x9d\xaf\x79\x92\xc5\x98\x40\xf4\x66\x7c\x75\x7b\xfd\x4b\xf4\x63\xd4\xf9\x3a\xc6\x84\x67\x58\x6d\x2a\xdb\x5c\xd3\xf5\xf8\xe6\xdd\xe5\x1d\xc9\x13\x5d\x5c\x9f\xff\xf0\x76\x7c\x75\x7b\x03\xfe\xcf\xe9\x9e\xd1\x37\x93\xab\x1f\x2e\xc7\xe5\x24\x00\xf8\x76\xcf\xe8\xf7\xe3\xeb\x8b\xe8\xf5\xdd\xc5\xc5\xf8\xda\xd1\x7e\x11\x58\x31\xe3\x7e\x1a\x47\x37\xd7\xdf\x53\xd7\xb7\xf0\xdd\x77\x70\xba\xd5\x7b\x7b\x7e\xfd\xc3\xf8\xd6\xf6\x86\xa5\xc6\x6f\x27\xb7\xd1\xf8\x27\xcb\x81\x9b\xfb\xa2\xd3\x19\x0e\x61\x8e\x3a\xe2\x99\x88\x91\xbe\xc2\x09\x5c\xa3\x2e\x64\xa6\x8c\xe6\x4d\x3b\x64\xc5\x72\x8a\x92\x1c\x96\x65\xae\x49\x69\x59\xcc\x74\x21\xb1\x13\x45\x4c\x3b\xff\x88\xa2\x6e\x97\xa5\x6b\xb6\x51\x11\xcf\x52\x9e\x61\xaf\x07\x45\xa6\xf8\x3c\xc3\x18\x52\x91\xcd\xeb\x6b\x75\x2d\x11\x47\xf1\xd8\x7c\xeb\x75\xfe\x61\x76\x41\x7d\x1a\xcf\xc4\x99\x69\x9e\xe6\x49\x94\x4b\x31\xc5\x48\x22\x8b\xbb\x47\x3c\x13\x7d\x50\xfc\xef\x28\x92\xae\x9d\xdf\x07\x6a\x8c\xf1\xe4\x15\xa7\x35\x7a\x76\x9e\x34\x32\xd1\x4a\x67\x9d\x8f\x46\x6a\xda\x08\x58\x93\xfb\x67\x6a\x79\x2a\xb1\x57\x82\xc7\xcd\x35\xda\xe4\xed\x5b\x01\xe9\x83\x17\xbd\x21\xe3\x43\x44\xdc\x16\x49\x2d\x84\xd4\xff\x2b\x82\x95\x2b\xb5\x8b\x57\xfc\xe9\xc5\x43\xa5\x2b\xfe\xf4\x62\xa7\x6c\xc1\x71\x96\xa2\xc8\x74\xc4\xe3\x86\xa7\x9a\x66\xe0\x71\xbb\x54\x03\x38\xf7\x6d\xb1\x40\x95\x7d\xa3\xe1\x3e\x13\x6b\xd0\x0b\x29\x8a\xf9\x02\xd6\x0b\x3e\x5b\x38\x1a\xb9\xe0\x99\x06\xae\x61\xcd\x14\xa9\x55\x22\x45\xe1\xf8\xa5\x59\x66\xf2\xc6\xc7\xed\x84\x4b\xa5\xa1\x2b\xd2\x18\x95\xee\xb9\xb9\x22\x01\xae\x15\xa8\x22\x47\x39\x4d\xc5\xec\x1e\xb8\x72\xce\x87\x71\x9f\xb2\x87\x04\x95\x33\xca\x12\x4c\xcf\x16\xa8\x2c\x53\xca\x04\xd6\x34\x75\xb4\x97\x83\x43\x56\x20\x0e\xb7\x55\xd2\xb0\x40\xcc\xa5\xd7\xfa\x70\x08\x6f\x0d\x83\x93\x37\xc6\x0a\x34\xdf\xcf\xb2\x3b\xc4\x4d\x35\x9c\x47\x96\xf5\x63\x95\x4f\xdb\xb7\x9d\xca\xa7\xa5\xd5\x54\x3e\x25\xab\xc5\x5c\x92\x3f\xaa\x69\xef\xac\x53\x25\x98\x72\xa5\xa3\x05\xb2\x18\x54\x64\x56\x54\x3b\x48\xba\xde\x40\xd7\x35\x10\x71\x95\x4f\x4f\x5e\x95\x0d\x6e\x81\xe1\x10\x6e\x17\x08\x22\x49\x14\x6a\xd2\xdc\xd2\xfa\x05\x57\x26\x1b\x9b\x84\xc6\x34\xa4\x82\xc5\xa0\xf9\x12\x21\x91\x62\x69\x8c\x78\x8f\x32\xc3\x14\x5e\xdf\x5e\x80\xde\xe4\xb4\xdd\x13\x21\x97\x26\x69\xb4\xf2\xe6\x35\x55\xf2\xc6\x33\xdd\xeb\x43\xd7\x6c\xf2\xe3\x9e\x67\x6c\x90\xe1\x07\x0d\xcf\xcd\x8a\x91\x9a\xba\xd6\xc8\xb2\x15\x59\x3e\xbb\x3d\xaf\x1f\x17\x92\x82\x15\x82\xa3\xc7\x98\x69\xb9\x21\xff\x8f\xb1\x35\x20\x97\x7e\x0d\x31\x52\x9c\x64\x24\xeb\x74\x63\x46\xe4\x52\xac\x78\x8c\x31\x58\x2a\x87\x3c\xa9\xee\x32\xcd\xd5\xbd\x47\xd9\x36\x38\xb6\xdf\xbd\x57\xd5\xe7\xc6\x76\x4a\xbb\x79\x5d\x67\xa9\x41\xf7\x99\x8c\x6b\x69\x9e\xbc\xf2\x4d\xb5\x88\xed\x1a\xbd\x76\x6c\xe0\x69\xe8\x67\x2b\xbe\x3d\xa1\x7a\x2a\xe1\xee\xb0\x5e\xfa\x0d\x8d\x78\x95\x78\x7d\x35\x94\xf2\x28\x9d\x34\xc3\x60\x8c\xab\x86\x6b\xc4\xb8\xe2\xb3\x32\xb8\x6b\xe1\x82\x5a\x4d\x68\x33\x17\xa6\x48\x49\x47\x1d\x92\x3d\xc6\x55\x54\x0d\x33\x31\xae\xba\x75\x01\xcd\xb7\x1e\x58\xe9\xec\xf0\x18\x57\x7b\x02\xca\xae\x78\x52\x09\x27\xd3\x5a\x0e\x30\xf1\xa4\x6d\x4a\x8c\xab\xa0\x38\x5c\xd1\x24\x65\x82\x44\x8c\xab\x86\x0b\xe1\xaa\xaa\x3e\x67\xc6\x4f\xd3\x9f\xb3\xf7\xa3\x15\x18\x16\x6d\xf7\x9c\x2f\xa1\xc3\xe0\x43\x4f\xaf\xc4\x4a\xde\xb1\xe9\x32\xa8\x92\x81\x69\xb1\x2e\x68\xf5\x4a\x7c\xf8\xb4\x59\x99\xf3\x04\x2e\xda\x50\x66\x1b\x6b\x0d\x97\xa5\xa4\x08\xed\x49\xb1\x41\xac\xc2\xe9\x08\xae\xee\x2e\x2f\xff\x5f\x25\xca\x8a\x74\x5f\x28\x61\x1a\x37\x28\xb9\x0c\x4d\x95\xcc\x09\x0f\x48\x9d\x61\xe2\xde\x14\x6a\x56\x6b\xd9\xe7\x87\xcf\x34\xa1\xed\xb3\xb3\xc5\xf6\x61\x27\x70\xf1\x7f\x9e\x4a\xc3\xe6\x20\xb0\x1e\x86\x04\x95\x51\x4d\x63\x0f\xea\x10\x49\x5d\x27\x34\xfc\x90\x46\xea\x72\xd5\x17\xf1\x1a\xa1\x16\x38\xa6\xaf\xed\xda\x48\xf6\x69\x23\x69\x68\xc3\x7d\x26\x6d\x10\xc5\x93\x57\x49\x9b\x2e\x5c\xe3\x96\xf0\xce\x38\x75\xe9\x5d\xe3\x67\x88\xef\x28\x04\xf9\x6d\xc3\x41\x05\xf8\x79\x89\x9b\xb0\x4b\x05\x25\xfc\xf0\x3a\x70\xce\x55\x51\x42\xce\xf4\x62\xe0\x9a\x1b\xaa\xf0\xb4\x3f\x86\x4a\xc3\xfb\xf3\xdb\x1f\xa3\xd7\x77\x93\xcb\x37\xe3\xeb\xe8\xed\xf9\x7f\x44\x6f\xc6\xef\x6f\x7f\x84\x7f\x3b\x35\xbe\x32\x2d\x78\x1a\x1b\x8a\x70\x02\xaf\xe9\x83\x55\x14\x66\x9a\x4b\x04\xd3\xd1\xd4\x96\x13\x85\x69\xd3\xfc\x6c\x56\x48\x25\xe4\x33\x1f\x94\x78\x66\x9a\x69\x66\x64\xa8\xa3\x84\x69\x91\x24\x28\x07\xb4\xe0\x9f\xab\x1d\x2f\x9b\x09\x26\x51\x80\x2b\xcc\x34\xac\x25\xcb\x73\x6a\x5f\x30\x0d\x33\x91\x51\xb5\x8d\xaa\x42\xc0\x33\xae\x39\x4b\xf9\xdf\x31\xb6\xdc\xd5\xd7\x30\x4b\x58\x8e\xb6\x88\xe7\x42\x71\x3a\x18\x78\x16\xed\x0c\x58\x2f\x50\x62\xc9\x33\xa8\x85\x28\xd2\x18\xa6\x68\xb0\xa2\xc6\xcc\x90\xb4\x32\x6f\x91\x74\xaa\xd0\x02\x24\x2a\x91\xae\xd0\x0c\xf6\x07\x81\x97\xee\x28\x19\x4e\x9a\xed\xe3\x53\xa6\xdc\x7e\xde\x5a\xc0\xb4\x02\xd3\x95\xf4\x6a\x66\x16\x46\x10\xa5\x45\x9e\x63\x7c\xd8\x75\x99\xe6\x33\x73\x7e\x0f\xf6\x2e\x3d\x56\x45\x46\xe5\x91\x53\x79\xa4\xe1\xb8\x6a\x23\x77\xee\xb7\x3a\x2d\x61\x71\x3d\xf4\xd9\x31\x5e\xec\x3e\x14\xff\xfa\x2f\x70\x1c\xa4\x6a\x6c\x85\x5f\x95\x96\xe6\x4b\x2d\x0b\x97\x14\xa3\x9c\x49\xcc\xb4\xed\x24\xba\x33\x91\x73\x8c\x61\x04\xa7\xa1\xcd\x70\x98\x62\x56\x6f\x75\x2e\x58\xb6\xd5\x83\x8f\xd1\x65\xa4\x97\x79\xad\xd3\x50\xba\xc7\x4d\xa4\xe1\x1e\x37\x30\x82\x7f\x7c\xb4\xfd\xf7\xb8\x19\x78\x91\x60\x54\x39\x63\x9b\xde\xaf\x73\xc9\xe6\x4b\x06\x45\x26\x45\x9a\x9a\x26\xaa\x71\x76\x09\x41\x71\xc3\x15\x70\xf8\x6e\xc7\xee\x3b\x03\xfe\xfc\x79\xcf\x4c\xb2\x9a\x69\x8b\x05\xa4\xa1\x32\x0e\xd0\x87\x7a\x5a\xc8\xd8\xd2\x47\xc2\xb6\xd9\x5e\x8d\x25\x05\xdf\x50\xa7\xe2\xda\x9c\x50\x0e\x62\x8c\x3f\x70\x0d\xdc\xed\x7a\xbb\xc7\xcc\x4e\xe1\x0a\x58\x4a\xe4\x37\x90\x14\x4e\x68\xfa\xc7\x13\xe8\x3a\x07\x81\x57\x23\xe8\x3a\xa1\xa9\xf2\x19\xdd\x4c\xfe\x73\x0c\x27\xf0\x6d\xaf\x57\x0e\xaf\x44\x2c\x6f\xc6\xfa\xfa\xdf\x2f\x90\xca\x2d\x09\xac\x11\x66\xcc\xba\x79\xd5\xed\x91\xc9\x74\x53\x4e\x68\x39\xd2\x79\xaf\x3c\x2a\x4d\x5e\xd1\x54\xb5\xe2\x45\xe9\xb3\x1c\xd3\x87\x23\xb2\x79\x28\x35\xd2\xdf\x8a\x1b\xc3\x08\x5c\x7f\xe8\x26\xd1\x49\xf5\x4b\x96\x47\xa9\x10\xf7\x45\x1e\x61\x8a\xcb\xee\xd1\xcc\x54\x9a\x2c\x3f\xca\x52\xee\xc1\x57\x16\x7d\x7a\xd4\xba\x53\x19\xbe\xe3\x63\x4d\x2d\xaf\x71\xc6\xe8\xd2\x42\x24\xa0\xc4\x12\x61\x46\x3c\x89\x5c\xf3\x25\x57\x06\xc0\x59\x94\x97\xa6\xab\x65\xdf\xd8\x6e\x2a\x8a\x2c\x56\xb0\x42\x49\x25\x77\x37\x84\xf1\x54\x91\x6e\xbd\xc1\xba\x42\x86\x7d\xe3\x1a\x7b\xc0\x55\x75\xe5\x82\xae\x5c\x7c\xd4\xac\x39\x5a\x64\x9c\xd4\xdd\x00\x10\x7d\x42\xfb\xeb\x01\xdc\x0a\x98\x6e\x72\xa6\x28\x9b\x70\xd5\xb7\xd7\x24\xc4\xd3\x8a\xa5\x85\x11\xc1\x2d\xbf\x64\x59\xc1\xd2\x74\x33\xd8\xe9\xc9\x96\xb9\xd2\x8f\x6d\x69\xd1\xf1\x19\x54\x35\x1c\x02\x1c\xb5\xba\xde\xd5\xf9\xdb\x31\x25\x3e\xe3\x85\xc0\xeb\x77\x2c\x86\x25\xa3\x1f\x94\x03\x98\x68\xc0\x4c\x15\xd2\x94\x20\x28\xe8\xd2\x15\x50\x9a\x42\x86\x2b\x94\xa0\x34\x93\x94\x8c\xf2\x0d\xdd\x56\x30\x63\xaf\xea\xfa\x2e\x57\xb9\xec\xc1\x14\x4c\xf9\x1c\x98\x0a\x0c\x30\x6d\x33\x58\x8c\x1f\x2c\x7d\xae\xbc\x56\xc9\xcb\xc2\x40\x9f\xa9\xca\x6e\x9f\x40\xfd\x6a\x65\x48\xdc\xb6\x46\xf7\xa8\x1a\xbd\x4f\x5e\xd1\xd4\xbf\x3a\x03\x3f\x40\x41\x7f\xeb\x97\x9f\x2b\xe0\x9e\xc2\xcf\xa0\x11\x71\x86\x43\x38\x0a\x73\x3f\x49\xaf\x36\x51\x01\x8b\x63\x9f\xef\x9c\x57\x30\xa7\x82\x95\x73\x98\x7e\x75\x55\xaf\x3a\xe3\x6a\x81\x03\x73\xd9\xb7\x64\x1f\xf8\xb2\x30\x47\x1d\x21\x51\xf3\x19\x4b\x1d\x05\x50\xee\x4a\x2a\x97\xb8\xe2\xa2\x50\x60\x90\x7e\xd0\xa9\xdf\x10\xcf\x47\xd0\x75\xfa\x0d\xf2\x55\xe4\xf6\xdb\x14\x9e\x8f\x9c\x1d\x42\x1f\x85\x83\xc6\x59\xc1\x01\x35\x18\x8d\x9a\xa7\x88\x32\xfa\x86\xd0\x58\x8f\x0b\xf5\xd8\x73\x7a\x56\xeb\xdc\x13\x34\xfc\xff\x5c\x4a\x1d\x81\x5f\xc9\x52\xf8\xd8\x69\x9d\xff\xb1\xd3\xbc\xcc\xda\x42\x8b\x0e\xb4\x78\x21\x12\x4a\x81\x98\x69\x65\xce\x18\xa6\x8b\x8a\x06\x6e\x59\x9e\x69\x01\xcb\x22\xd5\x3c\x4f\x11\xca\xb1\x7d\x10\x19\xd2\x5d\x1f\x58\x9e\xc2\xb9\xcd\x00\x3b\x37\xdb\x60\xc5\x31\x9b\x2d\xca\x89\x64\x71\xc5\x56\x36\x10\x31\x32\xde\x3d\x9d\xce\xb8\x22\xc8\xa9\x78\x1c\x20\x5c\x85\xaf\x05\x53\x8b\x25\xcb\x07\xfb\x30\x5c\x7d\xe1\x06\x36\x23\x7a\xe4\xf7\xd1\x3d\x6e\x5e\xba\x8b\x02\x82\x09\x8e\xe9\xb0\x52\x9d\x9f\x6d\x5e\x3c\x2b\x0f\xc4\x6a\x04\x23\x76\x69\xdb\x23\xb7\x26\x06\xdb\x06\x34\xc7\xf7\xd8\x3c\x96\xd6\x98\x8a\x34\x50\xe2\x32\x1b\xa4\x82\x7c\xb6\x09\xd1\x99\x3e\xaa\xc3\xa3\x4a\x13\x2d\x73\xf6\xa9\x08\x6f\x27\x46\x7b\x0c\xcc\x6a\x3a\xec\x17\x07\x58\x36\xd2\x96\xca\x33\xb1\xb1\xa4\x55\x6f\xf6\x45\x92\x1d\x61\xb4\xc9\x95\xd7\x4e\x49\xed\x00\x70\xf3\x74\xc8\x08\x30\x2a\x2d\x15\x3a\x28\x26\x39\xd5\x8f\x42\x28\x68\x62\x10\x3f\x8f\xe0\x4d\x3d\xd8\x7c\x04\x4c\x15\x36\x86\xb7\x81\x2e\x47\x79\x07\xec\x3a\x04\xbd\xaa\x0c\xf4\xda\xa2\x19\xc9\x51\xd7\xec\x5f\x4f\xff\x46\x61\xf5\x9b\xe1\x37\xf0\xcf\x7f\x42\x6b\xdf\xe9\x23\xe4\x0c\x60\x2b\x90\x72\x31\xaa\xaa\xd7\xc3\xd8\xaf\xb6\xc7\x54\x9f\x50\x63\x0f\x46\xed\xd8\xcf\x13\x28\xf2\x98\x69\xdc\x4d\xa0\x0f\xc1\xdb\xfa\xf0\xfa\xfd\x45\x74\x7e\xf5\x4b\xef\x80\x95\x5c\x8c\xe7\xf0\x1c\xbe\xad\x0a\x3a\x1c\x3e\xd5\xf2\x25\xd1\x1d\xb9\xc6\xeb\xa9\xae\x76\xb2\xcb\x01\x46\x1d\xf4\xdd\x9a\xfb\x55\xc3\xa6\x2d\x56\xdf\xf6\x84\xb3\x96\xe1\xd6\xb2\xd5\x13\xde\xe9\x9e\x61\x5b\xde\xf2\x20\xc5\x95\x4e\xbd\xcf\x7a\x4e\x52\xa7\x82\xad\x48\xe6\xea\x5a\x3e\x19\xe4\x28\x93\x88\x30\x1d\xca\x6a\xd6\xf5\xf9\x46\xb9\x4b\x73\x73\xa4\x87\x42\x11\x52\x35\x7d\x28\x13\x7f\x8c\x5b\xa2\x5e\x88\x78\x00\xb7\xf4\xf8\xc9\x7e\x00\xb9\x4d\x28\xe6\x12\x67\x3a\xdd\xd4\x31\x28\x65\x52\x97\xfc\xec\x1a\x06\x8d\x19\x34\x37\x45\x50\xa6\x45\x54\x6e\xc7\x69\x19\x94\x98\x08\x89\xfd\xca\x2c\x33\x70\xca\x66\xf7\xf5\xd1\x94\x25\x81\xc1\x8a\x49\x6e\xde\x3d\x51\x08\x84\x18\x73\xcc\x62\x92\x44\x84\xc4\xaa\x6c\x4e\x9f\xe9\x0f\x5b\x09\x5d\xe2\x9c\x2b\x8d\x92\x6a\xe1\x99\xa6\x8b\xdc\x70\x8b\x68\x4e\x32\x5a\x10\xa3\x71\x50\x8c\xe1\xc9\x11\xa4\x73\xdb\x16\x49\x17\xe8\xcc\xa1\x2e\xaa\xd2\xab\xd7\xa7\x88\xa0\x12\x85\x9c\x21\x3d\x60\x02\xcd\xe4\x1c\x75\x3b\xb0\xf8\xb3\xaf\xbb\xd4\xd6\x62\x59\x59\x8f\xa9\x2c\xe2\xc4\x56\x9a\xf4\xe5\x95\xff\xfa\xfd\x05\x95\xe4\xe6\x92\x2d\xeb\x36\xd8\x23\x22\x49\x98\xa4\x6c\xfe\x12\x2c\xd0\x53\xb0\x5e\xb0\x92\xc1\x50\xf9\x72\x7c\xc6\x83\x07\xa2\x15\xaa\xbd\xb4\x38\xa8\x07\x2a\xb9\x8e\x24\xce\x15\x1c\xcf\xf4\x87\x46\x05\xa9\x54\xe9\xb1\xf9\x4f\x1f\x8a\x7f\x37\x1c\xfa\x2d\x3e\x1c\xc2\x7b\x89\xb4\x57\x2b\x9e\xe9\x3c\xb1\x82\x1e\x0e\x15\xb1\xdc\x49\xa9\x35\x52\x2b\x3f\xa8\x0f\xf6\xd4\x7e\xf2\xca\x93\x1b\xe4\x52\xcc\x50\xa9\x28\x66\x9a\x0d\x34\x8f\xdd\x96\xa5\xa8\xf4\x55\x95\x7e\xaf\xd3\x88\x64\x7b\x4b\x55\xc3\xa1\xdf\xb8\xa6\x53\x95\x34\xbb\x24\x3a\x1c\x55\x5f\x87\x99\xc4\x51\xfb\x1c\x62\x5f\x85\x72\xa5\xae\x57\xe5\x2b\x88\xe4\xeb\x77\xee\xa3\x92\x33\x97\xbd\xfb\xd0\x14\x9a\xfa\x7c\x58\x6c\xd1\x09\x75\x7b\x68\xe8\xf4\x41\xff\x76\x0e\x4b\x31\x9b\xeb\x05\x8c\x4a\x76\x7d\xd8\xdb\x25\xb5\x7d\xf5\x56\x13\xdc\x37\x05\xd9\x69\x62\x73\x49\xf3\x95\xa6\x99\xc7\x71\xd1\xf5\x98\x4e\x6f\x04\x0d\x0e\x0c\xbc\x9c\x5c\xfd\xa5\x4a\xdb\xd9\xe8\x2d\xbb\x47\xa0\x53\x6b\x65\xeb\x1a\x2f\xcc\x70\xed\xf0\xaa\xc4\x39\x93\xb1\x79\xb9\xe9\xf6\xa5\x59\xaa\x46\x69\x1b\x4d\xdb\xb8\xd0\xc0\xd3\xfe\x6f\xe8\x74\x49\xa7\xc9\xbc\x1b\x50\xb9\x48\x69\x99\x5a\x49\x6b\x3b\xe6\xfb\x11\x75\x12\x7e\x97\xc4\x98\xa2\xc6\x1d\xb5\xac\xb0\x4e\x2b\x50\xfb\x0c\xb7\x74\x94\x77\x79\x66\x83\xf5\x16\xe7\x74\x23\x1e\xe0\x9f\xd5\x91\x0f\x76\xd1\xf0\xf4\xd2\xb8\x67\xf5\x63\x70\x9f\xe1\xf0\x21\x85\x96\x4f\xab\x98\x98\x3a\xda\x52\x90\x4f\x2e\x58\x66\x8a\x77\xf8\x6b\xc1\x52\xd0\x22\xc4\xa0\xe1\x10\x4e\x61\xba\xd1\x64\x2c\xca\x43\x8c\x5e\x88\x29\x0d\x07\x58\x82\xef\xb6\x46\x0c\x76\x1e\x53\xaa\xe6\x3c\x79\x85\xab\x70\x5e\x69\x28\xba\xb7\x6d\xa5\x8a\x55\x28\x73\xcc\xf2\xc2\xc5\x67\x2a\x90\xa8\x65\x1e\xb9\xb0\x2b\x64\xc4\xe3\x6e\x65\xb4\x61\x82\x00\x90\x21\x13\x89\x42\xe7\x85\xee\x9a\xac\x72\xe4\x89\x53\xb1\x31\x2f\xfa\x50\xf7\x37\xc7\x5b\x33\x5d\xe8\x1e\x3c\x2f\xe3\x88\xab\xff\x3c\xc0\x76\xbd\x5e\x4b\x25\xa5\x46\xc5\x5f\x47\xfa\xac\x58\x82\xc2\xc7\x80\xb6\xed\xe2\x49\x2b\x76\x9b\x49\x64\xf4\xdc\x95\x60\x9f\xab\xbc\x00\xf3\xf5\x06\x73\x5c\xa6\x67\x8f\xc4\x4d\xbd\xe2\x62\x97\x36\xd8\x21\x73\xcf\xca\x6b\xb9\xff\x0f\x7c\xf5\x5b\xc4\x57\xa5\x2f\x3c\x01\xba\xda\x4e\x4d\xf5\x9c\xf4\x09\x98\xe4\x4b\xe7\xe5\xdd\x79\xb1\x8a\x4b\x0e\x9c\x8b\xf7\x13\x69\x64\xd6\x90\xd8\x0e\x24\xd6\x2a\x6e\x0a\xd3\xbd\xdd\x9c\x45\x82\xf9\x5a\x90\xd8\x51\x48\x59\x9f\x09\x8f\x76\x4b\xd8\x0e\x1f\x3e\x15\x33\xfc\x7e\x60\x58\x2b\xb2\xd9\x3a\xb0\xef\xc2\x34\x87\xac\xd8\x00\x2e\x7b\x0d\xf9\x20\x10\xf1\xe5\x12\x63\x33\x1b\xef\x4c\xdc\x6d\x49\xee\xb4\x99\xd8\xa8\xc2\x90\x86\xa8\xf4\x98\xf4\x66\xa7\x86\x02\xff\xc1\xba\x04\xa5\x31\x13\xac\x80\x9b\x57\x19\x74\x91\x50\x7b\xd0\xd3\x60\x26\xd4\xff\xed\x55\x82\xcb\x8e\x8a\x0a\x5a\x01\x74\x35\xdf\x68\x98\x05\x0c\x6c\xf2\x2f\xcb\x76\x14\x2b\x88\x05\xff\x53\x07\x34\xd1\x2b\x4e\x55\x32\x82\xaf\xb9\x74\xb7\xdf\x5e\x31\xbd\xe8\x83\xb9\xeb\x92\x2c\x8b\xc5\xd2\xaf\x56\xfe\xa8\x94\x2d\x24\x90\xee\x40\x22\x55\xb7\xec\x68\xba\x69\xe8\xfd\x91\x9d\x7f\x9b\xd9\xb9\xe1\x81\x7f\x54\x40\xda\x2b\x20\xf4\x40\x28\x2e\x96\x4b\x77\x77\x10\x3a\x0e\x21\x92\xa7\x2a\x9d\xec\xce\x5b\x5f\x35\xf2\xd6\xd1\x11\x1c\x18\xd8\x96\xb7\xf6\x81\x93\x4f\x81\x1d\xbb\xd1\x8a\xa7\xfa\xe0\x5c\xde\x0e\x53\x86\x43\x78\x97\xa5\x1b\xef\xc8\xa4\xd3\x0c\xe9\x10\xc6\xe4\xe6\xf0\x8d\x47\x33\xf0\xba\x0c\xb8\xeb\xd2\xe3\x33\x2a\x04\x8f\x28\x5c\x55\x1c\xac\x77\x56\x5b\xbf\x6d\xe2\xbe\x42\x80\xff\x33\x1c\xc2\x0d\x5b\x85\x94\x55\xeb\x6c\xbd\x11\x68\x57\x4c\xfd\x74\x6a\xdf\x68\x34\xaf\x05\x82\x0f\x7c\x26\x14\xdc\x81\xe5\xf6\x78\xe3\x6e\x88\xb8\x17\x52\x3e\xda\x13\xdd\x3c\x3f\xe2\xec\x01\xbb\xf3\x77\x81\x2a\x77\xec\x86\xb6\xbd\xfd\x54\x3b\xca\xc6\x45\xd4\xee\x61\x54\xad\xaf\x5e\xde\x28\x43\xad\xff\xfb\x19\x9b\xb1\x81\x7a\x9b\x96\x68\xd8\xf7\x51\x5b\xd2\xcd\xfd\x3d\xed\xca\xdf\x33\xae\xdf\x5b\xbc\x22\xb5\xd4\xde\xf8\xd0\x5e\xa0\xda\x25\x2a\xed\x9e\x61\x2b\x48\x44\x9a\x8a\xb5\xc1\xf6\xa2\xf9\x4e\x79\x29\x62\xfc\x03\xb1\xfe\x76\x10\x6b\xf5\x75\x91\xb1\xde\x13\xe0\x54\x02\x7a\xb2\xfa\xf8\x9a\x30\x5e\xf0\x81\x88\x7c\x00\x46\xf6\xc7\x82\x1c\xc5\x46\xaf\x77\x7c\xda\x53\x5b\x13\x47\x2d\xbf\x61\xa1\xfc\xed\x0d\xcd\x97\x3e\x96\x0f\x2f\x61\xb9\xcd\xed\xc6\x71\xec\x93\x5e\x6b\x3b\xe1\xc1\xcb\x36\x7e\x0d\xc4\xfe\xc5\x1b\xa1\xe6\x89\x58\xa8\xfc\x6e\x89\xfd\xcb\x57\x6f\x63\xf7\x2e\xed\xe2\x80\x44\x6d\x4e\xf8\x5f\x63\x16\xf3\xa4\xf3\x3f\x03\x00\xca\x45\xf1\xd2\x99\x45\x00\x00")
+
+func bindataDentryHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataDentryH,
+		"/dentry.h",
+	)
+}
+
+
+
+func bindataDentryH() (*asset, error) {
+	bytes, err := bindataDentryHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/dentry.h",
+		size: 17817,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792221307, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataEventsEventsH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x91\xcf\x6e\x9b\x4c\x14\xc5\xf7\xf3\x14\x47\x78\xf3\x7d\x91\x0b\x96\x97\xed\x8a\x26\xa4\x41\x75\x41\x32\x76\xa2\xac\xa2\x31\x73\x81\xab\xc0\xcc\x74\x66\x28\xe1\x91\xfa\x1a\x7d\xb2\xca\xf9\xa3\xda\xc9\x92\xdf\x3d\x3a\xfc\xe6\xde\xe4\x42\x5c\x1a\x3b\x3b\x6e\xbb\x80\x3f\xbf\xb1\x5e\xad\x57\xf8\xb6\xcf\x37\x9b\x74\xff\x23\xc3\x75\xb9\xdf\x16\x79\xb6\x15\x62\xc3\x35\x69\x4f\x0a\xa3\x56\xe4\x10\x3a\x42\x6a\x65\xdd\x11\x5e\x27\x4b\xdc\x92\xf3\x6c\x34\xd6\xf1\x0a\xff\x1d\x03\xd1\xeb\x28\xfa\xff\x8b\x98\xcd\x88\x41\xce\xd0\x26\x60\xf4\x84\xd0\xb1\x47\xc3\x3d\x81\x9e\x6a\xb2\x01\xac\x51\x9b\xc1\xf6\x2c\x75\x4d\x98\x38\x74\x08\xff\xda\x63\x71\xff\x5a\x60\x0e\x41\xb2\x86\x44\x6d\xec\x0c\xd3\x9c\xa6\x20\x83\x10\x00\xd0\x85\x60\x3f\x27\xc9\x34\x4d\xb1\x7c\xb6\x8c\x8d\x6b\x93\xfe\x25\xe5\x93\x4d\x7e\x99\x15\x55\xf6\x69\x1d\xaf\x84\xd8\xeb\x9e\xbc\x87\xa3\x9f\x23\x3b\x52\x38\xcc\x90\xd6\xf6\x5c\xcb\x43\x4f\xe8\xe5\x04\xe3\x20\x5b\x47\xa4\x10\xcc\xd1\x73\x72\x1c\x58\xb7\x4b\x78\xd3\x84\x49\x3a\x12\x8a\x7d\x70\x7c\x18\xc3\xd9\x82\xde\xac\xd8\xe3\x34\x60\x34\xa4\x46\x94\x56\xc8\xab\x08\x5f\xd3\x2a\xaf\x96\xe2\x2e\xdf\xdd\x94\xfb\x1d\xee\xd2\xed\x36\x2d\x76\x79\x56\xa1\xdc\xe2\xb2\x2c\xae\xf2\x5d\x5e\x16\x15\xca\x6b\xa4\xc5\x3d\xbe\xe7\xc5\xd5\x12\xc4\xa1\x23\x07\x7a\xb2\xee\xe8\x6e\x1c\xf8\xb8\x3a\x52\xb1\xa8\x88\xce\x7e\xde\x98\x97\x6b\x79\x4b\x35\x37\x5c\xa3\x97\xba\x1d\x65\x4b\x68\xcd\x2f\x72\x9a\x75\x0b\x4b\x6e\x60\x7f\x3c\x9e\x87\xd4\x4a\xf4\x3c\x70\x90\xe1\xf9\xfb\xc3\x73\x62\x71\x91\x88\x05\x37\x5a\x51\x83\x87\xec\x36\x2b\x76\xd5\xc3\xcd\x83\x58\x28\x6a\x58\xd3\x29\x12\x0b\xd6\x75\x3f\x2a\x42\x44\x4f\x54\xc7\x5d\x74\x42\x7a\xd6\x8f\xe7\x64\x78\x54\xec\xde\x21\xa3\xb8\x99\xcf\x99\xb1\xa4\xcf\x89\x23\x2d\x07\x7a\xc7\x86\x0f\x65\x9e\x82\x0c\xe1\x3d\x9c\x87\x8f\x26\xa3\x7e\x63\x62\x41\x5a\x71\x23\xfe\x0e\x00\xc8\x5d\x54\x0f\x29\x03\x00\x00")
+
+func bindataEventsEventsHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataEventsEventsH,
+		"/events/events.h",
+	)
+}
+
+
+
+func bindataEventsEventsH() (*asset, error) {
+	bytes, err := bindataEventsEventsHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/events/events.h",
+		size: 809,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792221307, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataEventsExecH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x56\xed\x6e\xdb\x36\x14\xfd\xaf\xa7\x38\x4b\x01\xcf\xce\x64\xcb\x75\x81\xfd\x68\x90\x6c\x5e\xea\xb4\xc6\x32\x07\x88\x93\x75\xfd\x45\xd0\xd2\x95\x4c\x44\x22\x39\x92\xb2\xe3\x15\x79\xa0\xbd\xc6\x9e\x6c\xa0\x2c\xd9\x52\x93\x14\x08\x62\x91\x3c\xf7\x83\xe7\x9e\x7b\xa5\xe8\x34\xb8\x54\x7a\x67\x44\xb6\x76\xf8\xef\x5f\x4c\xc6\x93\x31\x3e\xde\xcf\xaf\xaf\xa7\xf7\x7f\xcc\x70\x75\x73\x7f\xbb\x98\xcf\x6e\x83\xe0\x5a\xc4\x24\x2d\x25\x28\x65\x42\x06\x6e\x4d\x98\x6a\x1e\xaf\x09\xf5\x49\x88\x3f\xc9\x58\xa1\x24\x26\xa3\x31\xfa\x1e\x70\x52\x1f\x9d\x0c\xce\x82\x9d\x2a\x51\xf0\x1d\xa4\x72\x28\x2d\xc1\xad\x85\x45\x2a\x72\x02\x3d\xc6\xa4\x1d\x84\x44\xac\x0a\x9d\x0b\x2e\x63\xc2\x56\xb8\x35\xdc\xd1\xfb\x28\xf8\x52\x3b\x50\x2b\xc7\x85\x04\x47\xac\xf4\x0e\x2a\x6d\xa3\xc0\x5d\x10\x00\xc0\xda\x39\xfd\x3e\x8a\xb6\xdb\xed\x88\x57\x59\x8e\x94\xc9\xa2\x7c\x8f\xb2\xd1\xf5\xfc\x72\xb6\x58\xce\x86\x93\xd1\x38\x08\xee\x65\x4e\xd6\xc2\xd0\xdf\xa5\x30\x94\x60\xb5\x03\xd7\x3a\x17\x31\x5f\xe5\x84\x9c\x6f\xa1\x0c\x78\x66\x88\x12\x38\xe5\xf3\xdc\x1a\xe1\x84\xcc\x42\x58\x95\xba\x2d\x37\x14\x24\xc2\x3a\x23\x56\xa5\xeb\x10\xd4\x64\x25\x2c\xda\x00\x25\xc1\x25\x4e\xa6\x4b\xcc\x97\x27\xf8\x6d\xba\x9c\x2f\xc3\xe0\xf3\xfc\xee\xd3\xcd\xfd\x1d\x3e\x4f\x6f\x6f\xa7\x8b\xbb\xf9\x6c\x89\x9b\x5b\x5c\xde\x2c\x3e\xcc\xef\xe6\x37\x8b\x25\x6e\xae\x30\x5d\x7c\xc1\xef\xf3\xc5\x87\x10\x24\xdc\x9a\x0c\xe8\x51\x1b\x9f\xbb\x32\x10\x9e\x3a\x4a\x46\xc1\x92\xa8\x13\x3c\x55\xfb\x6a\x59\x4d\xb1\x48\x45\x8c\x9c\xcb\xac\xe4\x19\x21\x53\x1b\x32\x52\xc8\x0c\x9a\x4c\x21\xac\x2f\x9e\x05\x97\x49\x90\x8b\x42\x38\xee\xaa\xf5\xb3\xeb\x8c\x82\xd3\x28\x78\x23\x52\x99\x50\x0a\x36\xfb\x6b\x76\xc9\x3e\xb1\xe0\x4d\x42\xa9\x90\x74\xdc\x08\xa2\x08\x36\x5e\x53\xc2\xb4\x51\x31\x59\xcb\xe8\x91\x62\xc6\x4d\x66\x31\xc4\xd4\x64\x65\x41\xd2\xd9\xa6\x82\x15\x34\x7a\x6e\x00\x67\x78\x4c\x5a\x09\xe9\x42\x58\x22\xef\x36\xb2\x3b\x1b\x3d\x90\x91\x94\x47\x09\xad\xca\x2c\xf2\x20\x21\xb3\x88\x36\xde\x67\xf4\x9a\xb3\x28\x55\xa6\xe0\x2e\xb0\xce\x94\xb1\x7b\x2d\xbd\xe0\x6b\xa5\xa1\x52\x5a\x91\x49\x4a\x60\xd7\xca\x38\x2f\xce\x42\x49\xe6\x76\x9a\xce\xba\xe7\xf1\x9a\x9b\xe6\x38\xcd\x79\x66\xbf\x73\xae\x0d\x51\xa1\x1d\x8b\x55\x29\xdd\x1e\x27\xe4\xc1\xb9\x16\xc9\x59\x70\xd8\x4c\xb8\xe3\x2c\x57\x31\xf3\x6d\x22\x79\x51\xc7\xd5\x22\x61\x0e\x15\xf4\xb8\x54\x79\xc2\xaa\xad\xa7\xb3\xc0\x53\xe4\x09\xa1\xce\xd5\x30\xc4\x92\x64\x62\x2b\x31\xd0\x23\xc5\xa5\xab\x04\xae\xb9\x5b\xfb\xa2\x57\xfb\xbc\x5d\x16\x0e\x49\x5b\x68\xa3\x32\xc3\x0b\x2f\xfd\xd2\x92\x81\xd5\x3c\xa6\x11\xee\xbc\x97\x4d\x0d\xad\x22\xae\x09\x75\x3c\xb2\x48\x8c\xd2\x7a\xdf\x4c\xad\x03\xdf\xf0\x8e\x8c\x05\x37\x24\x7f\x74\xb0\x24\xdd\xc8\x1b\xff\xea\x79\x7f\xdf\x0d\xef\xed\x8e\xc5\x0f\x18\xe3\xae\x6e\x21\xc6\xfa\x7d\x9e\x6f\xf9\xce\x32\x21\x73\x21\x69\x30\x80\xf5\x7a\x8d\x2b\xde\x9e\xdf\xbd\xff\xfd\x82\xe3\xd4\xff\x1f\x34\x75\x7f\x37\xc1\x03\xed\x70\x8e\xf1\x9e\xf0\xda\xd8\x3b\x62\x95\xc0\x98\xc3\x29\x6d\x1c\xce\xb1\xd2\x29\x2b\xb8\x66\xb9\x52\x0f\xa5\x66\x94\x53\xd1\xef\xb5\x80\xab\x52\xe4\x09\x99\x10\xbd\x07\xda\x0d\xea\x7a\xa7\xe8\xff\x40\x1b\x37\xa8\x56\xfe\xcf\x90\x2b\x8d\x6c\xc2\xa5\x22\xcf\x0f\x39\x7a\x0d\xf4\x7b\xb4\x71\xc3\x8b\xf6\x56\xdb\xd5\x9e\xd3\xc6\xe2\x25\xf0\x4b\x91\xaa\xad\x28\xc2\xac\xab\x84\xb0\x92\x41\xae\xb6\x64\xf0\xf6\x67\xac\xc4\xb1\x16\x8c\x35\x82\x44\x2a\x28\x4f\x7c\x11\xb1\x3f\x4f\x2d\x55\x03\xfc\x99\x84\xbe\xa9\x61\xa3\xec\x9c\x64\x4d\x9e\x36\x6a\x45\xcc\x10\x4f\x98\x75\xa6\x4e\xbe\xd1\x7b\x08\x2b\xfe\x21\x95\xf6\x3b\xbb\x83\x10\xfd\x8d\x12\x09\x4e\x07\xbe\x6c\xf8\x09\x7d\xff\x3b\xbc\x78\xd6\x2f\xe8\x61\xfc\x78\x75\x75\x75\x35\xa8\xf9\xea\xf8\x61\x39\xc9\xcc\xad\x71\x5e\xa5\x73\x81\x31\x7e\xa9\x9e\xde\xb7\xe9\x39\xcc\xa9\x3d\x31\x05\x15\xca\x1c\xde\x3a\xed\xf6\x10\x16\x3c\xf7\xf7\xd8\xc1\x93\x51\xea\xb6\x74\x1c\xb7\x0f\xac\x7e\x3e\xf5\x0b\x9c\xa3\xff\xd2\xd9\xc0\x0b\x2a\x23\xc7\xe2\xd2\x98\x4a\x69\xdc\x3e\xf4\xeb\xec\x6b\x50\x51\x1c\xe0\x45\xf1\xcd\xa8\xc9\x95\xcc\x7c\x17\x31\xeb\xb8\x71\x61\xf5\x48\xb2\x9e\x14\x5d\xbe\xfb\xbd\xa2\x38\x10\x5c\x14\x83\x10\x3d\x9f\xca\xf0\xa2\x28\x06\x2f\xe3\x5b\x7e\x6b\xb3\xc3\x8e\xb7\x2e\x8a\xe1\xc5\x71\xe3\x75\x17\x24\x93\x8e\x03\x92\x49\xdb\xdc\x2f\xeb\x5b\xbd\x9b\xf8\x0b\x58\x5f\x28\x9c\x37\x77\xc1\xf0\x78\xc1\x63\x17\x1c\x70\x17\xe7\xa8\xde\x40\xd3\xdb\x8f\x4b\x76\x3d\x5b\x0c\xf0\xf5\xa0\xfe\x28\xc2\x5d\x47\xa1\x5e\xc1\xce\x94\x32\xe6\x8e\x92\xb0\x35\xdf\xe0\xd3\xad\x46\x65\x81\xd4\xa8\x02\x91\x6f\x2f\x6c\xd7\x24\x3b\xf3\x4c\x58\x58\x27\xf2\x1c\x3c\x17\x1b\x3a\x04\x6a\x65\xdd\x49\x06\x43\xbc\xdd\xe7\xfc\xd4\x08\xac\x87\xfe\x33\xc8\x00\xa2\xfb\x3d\xe2\x43\x6e\xc8\x88\x54\xf8\x2f\x0b\x85\x95\x2a\xeb\x81\xed\x79\x6c\xe4\xe8\x3f\x88\x82\x4e\xf8\xde\xab\xf1\xbb\x9c\x61\xdc\xa6\xe9\xdb\xa2\x55\x5d\xe3\xc1\xe1\xc1\x73\xa7\x05\x3b\x15\x7f\x3a\x36\x5a\x03\xde\x37\x59\xb3\xaa\x7b\xab\x7c\x37\x41\xac\xcb\x7a\x0c\x78\xc9\xdb\x42\x37\x43\x4c\x19\x26\x92\x46\xf6\x55\x3a\x64\xd2\x7a\xa4\xaa\xd2\xe9\xd2\x79\xe5\xd8\x10\xbd\xda\x60\x7f\x66\x43\xef\x32\x04\x6d\x8e\x12\xf5\x83\xba\x69\xff\xe3\xf4\x7b\x0a\x82\x37\x24\x13\x91\x06\xff\x0f\x00\xdf\x62\x8d\xf1\x01\x0b\x00\x00")
+
+func bindataEventsExecHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataEventsExecH,
+		"/events/exec.h",
+	)
+}
+
+
+
+func bindataEventsExecH() (*asset, error) {
+	bytes, err := bindataEventsExecHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/events/exec.h",
+		size: 2817,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792221307, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataEventsLinkH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x51\x6e\xdb\x38\x10\xfd\xd7\x29\xde\xa6\x40\x60\x07\xae\x15\xa4\x8b\xfd\x68\x90\xc5\xba\x89\x93\x0a\x4d\x9d\xc0\x76\x5a\xf4\x8b\xa0\xc5\x91\x4d\x44\x26\xb5\x24\x15\xc7\x68\x7b\xa0\xbd\xc6\x9e\x6c\x41\x4a\xb6\xe4\xc6\x29\xda\x62\x91\xc0\x80\xc8\xe1\xcc\x9b\x37\x33\x6f\xe2\xa3\xe8\x5c\x17\x6b\x23\xe7\x0b\x87\x7f\xff\xc1\xc9\xf1\xc9\x31\xae\xee\x92\xeb\xeb\xc1\xdd\xfb\x21\x2e\x6f\xee\xc6\xa3\x64\x38\x8e\xa2\x6b\x99\x92\xb2\x24\x50\x2a\x41\x06\x6e\x41\x18\x14\x3c\x5d\x10\xea\x9b\x1e\x3e\x90\xb1\x52\x2b\x9c\xf4\x8f\xd1\xf1\x06\x07\xf5\xd5\x41\xf7\x34\x5a\xeb\x12\x4b\xbe\x86\xd2\x0e\xa5\x25\xb8\x85\xb4\xc8\x64\x4e\xa0\xc7\x94\x0a\x07\xa9\x90\xea\x65\x91\x4b\xae\x52\xc2\x4a\xba\x05\x5c\xe3\xbd\x1f\x7d\xaa\x1d\xe8\x99\xe3\x52\x81\x23\xd5\xc5\x1a\x3a\x6b\x5b\x81\xbb\x28\x02\x80\x85\x73\xc5\xeb\x38\x5e\xad\x56\x7d\x1e\x50\xf6\xb5\x99\xc7\x79\x65\x65\xe3\xeb\xe4\x7c\x38\x9a\x0c\x5f\x9e\xf4\x8f\xa3\xe8\x4e\xe5\x64\x2d\x0c\xfd\x5d\x4a\x43\x02\xb3\x35\x78\x51\xe4\x32\xe5\xb3\x9c\x90\xf3\x15\xb4\x01\x9f\x1b\x22\x01\xa7\x3d\xce\x95\x91\x4e\xaa\x79\x0f\x56\x67\x6e\xc5\x0d\x45\x42\x5a\x67\xe4\xac\x74\x3b\x04\x6d\x50\x49\x8b\xb6\x81\x56\xe0\x0a\x07\x83\x09\x92\xc9\x01\xde\x0c\x26\xc9\xa4\x17\x7d\x4c\xa6\x6f\x6f\xee\xa6\xf8\x38\x18\x8f\x07\xa3\x69\x32\x9c\xe0\x66\x8c\xf3\x9b\xd1\x45\x32\x4d\x6e\x46\x13\xdc\x5c\x62\x30\xfa\x84\x77\xc9\xe8\xa2\x07\x92\x6e\x41\x06\xf4\x58\x18\x8f\x5d\x1b\x48\x4f\x1d\x89\x7e\x34\x21\xda\x09\x9e\xe9\xaa\x5a\xb6\xa0\x54\x66\x32\x45\xce\xd5\xbc\xe4\x73\xc2\x5c\x3f\x90\x51\x52\xcd\x51\x90\x59\x4a\xeb\x8b\x67\xc1\x95\x88\x72\xb9\x94\x8e\xbb\xf0\xfd\x24\x9d\x7e\x74\x14\x47\x2f\x64\xa6\x04\x65\x60\xd7\xc9\xe8\x1d\x7b\xcb\xa2\x17\x82\x32\xa9\xa8\x39\x88\xe2\x18\xce\xf0\x94\x58\x2e\xd5\x3d\x5e\x62\xea\x3f\x2c\x78\x55\x75\xbb\xb6\x8e\x96\x08\x77\xf4\x40\xca\xf5\xfd\x83\xbf\x52\xf7\xf8\x1a\x86\xe6\xd2\x3a\x32\x16\xa9\x56\x8e\x1e\x5d\xb8\xd2\xb9\x60\x82\x94\x33\xeb\xd7\x28\xb4\x54\xce\xb3\xac\x43\x6e\xd5\x31\xac\x33\x65\xea\x4a\x43\x9b\xb6\xb0\xba\x34\x29\x85\x80\xc1\x85\xa2\x15\x13\xd2\x3c\x79\x2f\x95\x16\xf4\xf4\xb9\x20\xeb\xa4\x0a\x3c\x40\x48\x43\xa9\xd3\x66\xdd\x38\xfa\x39\x2c\x6d\x67\x01\x10\x63\xdc\xd5\x3d\xc1\x58\xa7\xc3\xf3\x15\x5f\x5b\x26\x55\x2e\x15\x75\xbb\xb0\xbe\x00\x29\xa4\x72\x2d\x1a\x3b\x15\x46\x14\x8e\x19\x9a\x5b\x1c\xa5\xee\xb1\x57\xc7\xda\x44\x3e\x6a\x88\xda\x5e\x55\x09\x1e\xd5\xf9\x3f\x79\xd1\xa4\xd3\x8d\x3e\x87\x01\x2a\x5f\x9d\x20\x2d\x4a\x9c\x61\x56\x64\x6c\x4e\x8e\xd9\x65\xc1\x0a\xa3\x53\xb2\x56\x1b\x26\x45\xa7\x7b\x1a\x2c\x77\x5c\xb1\xd4\x0f\x1b\x73\x38\x12\xdc\xf1\xea\xab\xf6\xb1\xe4\x05\xcb\xb5\xbe\x2f\x0b\x46\x39\x2d\x3b\x87\x3b\x2f\x66\xa5\xcc\x05\x99\x1e\x0e\xd3\xa2\xac\x3d\xcb\x0c\x9d\xdf\x1a\x3f\xdd\x70\xe8\xff\x0d\xb9\xd2\x28\x1c\x57\x66\x71\x8c\x31\x59\x72\x28\xb8\x5b\x28\xbe\x24\xdc\xd3\xda\xa2\x93\xea\x32\x17\x58\xfa\xf9\x28\x0b\x18\xb2\x3a\x2f\x43\x29\x65\x28\x88\x21\xac\xb8\x85\xd5\x4b\x42\x4e\x99\xf3\xc3\x00\x1f\xad\x8a\xd3\xc4\x7d\xf9\x67\x66\x59\xd5\xa2\xd6\xa4\xcc\x47\x61\xf7\xb4\xc6\xd9\x06\xc0\x5e\x53\xc7\x8d\x67\xed\xfb\xd6\x69\x69\xac\x36\xcd\x5d\x1c\x63\x20\x04\x6a\x9a\x03\x9a\x00\xa6\xfc\xe3\x77\x9f\x14\xce\x7c\x2b\xe7\x9b\x32\x30\x7f\xdf\x39\xdc\x1b\xbe\x6d\x52\xd3\x19\xc7\xb8\x35\x7a\x46\x70\xeb\x82\x9e\x07\x1e\x7e\x71\x86\xe1\x87\xe1\x68\x1a\xa6\xf9\x34\x6a\x83\xd3\xb9\xa8\xdb\x69\x0b\x6f\xaf\x1f\xcf\x55\x65\x77\x06\xcf\x44\x5d\x6e\xa9\x74\xa7\x69\xd0\x06\xda\x15\x29\x32\xdc\x91\xd7\x07\x7e\x1f\x6a\xb8\x95\xae\x26\x24\xb7\xad\x79\x5d\xc9\x3c\xc7\x8c\x60\xa8\xb4\x24\x7e\xb8\x6a\x9b\x86\x2e\x0c\x57\x42\x2f\x59\xf9\xea\xa4\xd3\xdd\xe1\xdf\xc7\x5b\xea\x52\x39\x24\x17\xed\x1e\xaf\xa7\xc8\xc3\xdf\x9f\x99\xa0\xa7\xb9\x3d\x0b\x29\x44\x60\x52\xd4\x5e\x82\xc7\xed\x61\x67\x1b\xa4\xdb\xd0\x7f\x11\xfc\xee\xe7\xdd\x27\x59\x8f\xf3\x19\x1a\x10\x4f\x31\xd4\x8d\x29\xa4\xef\xbb\x5a\x10\x9e\xb7\xda\x78\x6c\x14\x62\x87\x29\x45\xab\x2d\x53\xbd\x50\x9a\xea\xa1\xef\x53\xaf\xaf\x8a\x48\x58\x48\xf7\x3c\x13\x75\xa0\xef\x93\x51\xc3\x6c\x51\x71\x19\xfc\x6f\x65\xa2\x0a\xd7\x69\x22\xf4\x70\x99\x5c\x4f\x87\x63\x36\x19\x9f\x77\xf1\xe5\x0b\x9e\xb7\x98\x0e\xc6\x57\xc3\x69\xb7\x8b\xcf\x5b\x81\xa9\x24\x45\xe7\x0f\x9b\x15\xd2\x92\x9e\x70\x1c\x54\xc0\x76\x82\xfa\xb6\x5d\x8e\x87\x93\x9b\xeb\x0f\xc3\x10\xf5\xb4\xed\x2e\xa4\xdd\x54\xce\xff\x6d\x44\xb1\x2c\x04\x77\xb4\x47\x14\x7b\x38\xbc\xa7\xf5\x6e\x80\x37\xb7\x97\x6c\x30\xfa\x54\xf7\xd6\xd7\x28\xda\xd5\xc3\xaf\xdf\xac\x5d\x66\xc8\x35\xab\xd7\xd7\xa7\xb6\xd5\xd9\xaf\x2d\xe2\x5f\x59\x5a\x1e\xc4\xbe\xc5\xb5\xdd\x35\x5b\x75\xdb\x8c\x66\x5a\x1a\x43\xca\xb1\x42\x0a\xe6\xe6\xff\xf3\xae\xa9\x68\xfd\xb9\x1d\xb3\xb7\x75\x0d\xb9\x07\x9e\xe3\x0c\xb7\x53\x36\x1e\x5e\x4d\xd8\xf8\xdc\x77\x44\xab\x4b\xbd\x98\xd7\x03\xf1\x23\x92\x59\xcf\xc2\x33\xaa\xd9\x7e\x52\x5b\x7e\x2b\xa2\xad\x78\x3b\xfa\xf5\xeb\x93\xb7\x2f\xe8\xce\x20\x6e\xe6\xe4\xd6\x0f\x44\xf4\x13\x23\x52\x8d\x1d\xbe\x60\xf8\x3e\x99\xb2\xb0\x6d\xea\x44\x36\x15\x14\x94\xd3\x77\x06\xa3\xb6\x6e\x0a\xf5\x35\x8a\x5e\x90\x12\x32\x8b\xfe\x1b\x00\xa3\xef\xbd\x35\xd6\x0c\x00\x00")
+
+func bindataEventsLinkHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataEventsLinkH,
+		"/events/link.h",
+	)
+}
+
+
+
+func bindataEventsLinkH() (*asset, error) {
+	bytes, err := bindataEventsLinkHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/events/link.h",
+		size: 3286,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792220584, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataEventsMkdirH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x61\x6f\x1a\x39\x10\xfd\xbe\xbf\xe2\x35\x95\x10\x44\x14\xa2\xdc\xe9\x74\x4a\xc5\xe9\x68\x42\x5a\xd4\x84\x54\x40\x5a\xf5\x93\xe5\xd8\xb3\x60\x65\xd7\xde\xb3\xbd\x21\xa8\x97\x1f\x74\x7f\xe3\x7e\xd9\xc9\xde\x85\x85\x96\xf4\x74\xd5\x49\x51\x60\xed\x99\x37\xcf\x33\xcf\x6f\xe9\x1f\x27\xe7\xa6\x58\x5b\xb5\x58\x7a\xfc\xfd\x17\x4e\x4f\x4e\x4f\xf0\xf6\x76\x7c\x75\x35\xbc\xbd\x1e\xe1\xf2\xe6\x76\x3a\x19\x8f\xa6\x49\x72\xa5\x04\x69\x47\x12\xa5\x96\x64\xe1\x97\x84\x61\xc1\xc5\x92\x50\xef\x74\xf1\x91\xac\x53\x46\xe3\xb4\x77\x82\x76\x08\x38\xaa\xb7\x8e\x3a\xaf\x93\xb5\x29\x91\xf3\x35\xb4\xf1\x28\x1d\xc1\x2f\x95\x43\xaa\x32\x02\x3d\x0a\x2a\x3c\x94\x86\x30\x79\x91\x29\xae\x05\x61\xa5\xfc\x12\xbe\x41\xef\x25\x9f\x6b\x00\x73\xe7\xb9\xd2\xe0\x10\xa6\x58\xc3\xa4\xbb\x51\xe0\x3e\x49\x00\x60\xe9\x7d\x71\xd6\xef\xaf\x56\xab\x1e\x8f\x2c\x7b\xc6\x2e\xfa\x59\x15\xe5\xfa\x57\xe3\xf3\xd1\x64\x36\x7a\x75\xda\x3b\x49\x92\x5b\x9d\x91\x73\xb0\xf4\x47\xa9\x2c\x49\xdc\xad\xc1\x8b\x22\x53\x82\xdf\x65\x84\x8c\xaf\x60\x2c\xf8\xc2\x12\x49\x78\x13\x78\xae\xac\xf2\x4a\x2f\xba\x70\x26\xf5\x2b\x6e\x29\x91\xca\x79\xab\xee\x4a\xbf\xd7\xa0\x0d\x2b\xe5\xb0\x1b\x60\x34\xb8\xc6\xd1\x70\x86\xf1\xec\x08\x6f\x86\xb3\xf1\xac\x9b\x7c\x1a\xcf\xdf\xdd\xdc\xce\xf1\x69\x38\x9d\x0e\x27\xf3\xf1\x68\x86\x9b\x29\xce\x6f\x26\x17\xe3\xf9\xf8\x66\x32\xc3\xcd\x25\x86\x93\xcf\x78\x3f\x9e\x5c\x74\x41\xca\x2f\xc9\x82\x1e\x0b\x1b\xb8\x1b\x0b\x15\x5a\x47\xb2\x97\xcc\x88\xf6\x8a\xa7\xa6\x9a\x96\x2b\x48\xa8\x54\x09\x64\x5c\x2f\x4a\xbe\x20\x2c\xcc\x03\x59\xad\xf4\x02\x05\xd9\x5c\xb9\x30\x3c\x07\xae\x65\x92\xa9\x5c\x79\xee\xe3\xf3\x37\xc7\xe9\x25\xc7\xfd\xe4\xa5\x4a\xb5\xa4\x14\xec\xfa\xfd\xc5\x78\xca\xde\xb1\xe4\xa5\xa4\x54\x69\xda\x59\x49\xfa\x7d\x78\xcb\x05\xb1\xfc\x5e\x2a\x8b\x57\x98\x87\x27\x07\x5e\x0d\xde\xad\x9d\xa7\x1c\xd5\x26\x3d\x90\xf6\xbd\x90\xf2\xbb\xf0\x8f\x67\xb0\xb4\x50\xce\x93\x75\x10\x46\x7b\x7a\xf4\x71\x4b\x2a\x7b\x86\xc2\x28\xed\x43\x8b\x4d\x3c\x98\xd2\x46\xd2\x46\x08\x21\x98\xab\x78\x28\xa9\x2c\x09\x6f\xec\xba\xca\x24\xed\xed\xfa\x9b\xe4\x6a\x19\xce\xdb\x52\xf8\xd2\x6e\x71\x34\xad\xbe\x02\xc8\x8d\xa4\x33\xe4\x3b\xb5\x2a\xe2\x82\x67\x59\xc2\x18\xf7\xf5\x7c\x19\x6b\xb7\x79\xb6\xe2\x6b\xc7\x94\xce\x94\xa6\x4e\x07\x2e\x34\x53\x40\x69\xbf\xdb\x90\x76\x55\x15\x85\x67\x96\x16\x0e\xc7\xc2\x3f\x76\x6b\x2a\xf5\xa9\x8e\xa5\xb2\xdb\xa5\x9a\xeb\x71\xf5\xd9\x45\x19\xc8\x30\x1f\x39\x75\x92\x2f\x51\xf8\xe5\x4f\xa7\x10\x45\x89\x01\xee\x8a\x94\x2d\xc8\x33\x97\x17\xac\xb0\x46\x90\x73\xc6\x32\x25\xdb\x9d\xd7\x31\x72\x0f\x94\x89\x70\x49\x98\xc7\xb1\xe4\x9e\x57\x4f\x35\x46\xce\x0b\x96\x19\x73\x5f\x16\x8c\x32\xca\xdb\xad\xbd\x8c\xbb\x52\x65\x92\x6c\x17\x2d\x51\x94\x35\xb2\x4a\xd1\x7e\xd1\xe0\x74\xe2\x62\xf8\xb3\xe4\x4b\xab\x71\x52\x85\xf5\xfb\x98\x92\x23\x8f\x82\xfb\xa5\xe6\x39\xe1\x9e\xd6\x0e\x6d\x61\xca\x4c\x22\x0f\xba\x2e\x0b\x58\x72\x26\x2b\x83\x14\xa1\x62\xdb\x2d\x61\xc5\x1d\x9c\xc9\x09\x19\xa5\x3e\x88\x18\xa1\x5a\x55\xa7\xa9\xfb\xea\xb7\xd4\xb1\x4a\x56\xce\x0a\x16\xaa\xb0\x7b\x5a\x63\xb0\x21\x70\x30\xd4\x73\x1b\xba\xf6\xfd\x68\x51\x5a\x67\x6c\xb3\xd7\xef\x63\x28\x25\xea\x36\x47\x36\x91\x4c\xf9\xcb\xcf\xe1\x50\x18\x04\xc1\x67\x9b\x31\xb0\xb0\xdf\x6e\x1d\x2c\xbf\x1b\x52\xb7\xb3\xdf\xc7\x07\x6b\xee\x08\x7e\x5d\xd0\xf3\xc4\xe3\x7f\x0c\x30\xfa\x38\x9a\xcc\xab\x4b\xf8\x3a\xd9\x65\x17\x64\xf2\x7c\x7a\xd8\xc5\x00\x6d\xa5\x7d\x27\x7c\x6f\x72\xaf\x4d\xa9\x3d\xc6\x17\xcf\xe7\x86\xf6\xe6\x21\x8a\x29\x89\x01\x42\xff\xa2\x7c\xb7\x8b\x6d\xa9\x6c\xa7\x41\xbc\x88\x0a\x6a\xda\xb4\x0b\x1a\xb0\x6a\xa1\x0f\x6a\x71\x36\x89\x97\x2a\xf3\x64\x1b\x91\xa5\xf1\xb9\xdd\xe4\x77\x71\x39\xbe\x9a\x8f\xa6\x6c\x36\x3d\xef\x1c\x12\xde\x06\x69\x46\x3a\xba\x79\x3c\x4a\x5c\xdc\x68\xbd\x2c\x24\xf7\x74\x40\xeb\x5d\xb4\xee\x69\xdd\xdd\x61\xdb\xc5\x9b\x0f\x97\x6c\x38\xf9\x5c\x4f\xaa\x29\xf3\xf4\xb5\xf9\x31\x4b\xbe\x31\xc0\xe0\x1e\x75\xb0\x49\x7f\xd0\x0e\x7f\xc8\x72\x02\x8d\x43\xb6\xb3\xb5\x8f\xad\x60\x37\xf6\x21\x4a\x6b\x49\x7b\x56\x28\xc9\xfc\xe2\x7f\xb6\x8f\xaa\xa5\xff\xcd\x36\x0e\x2a\xd0\x92\x7f\xe0\x19\x06\xf8\x30\x67\xd3\xd1\xdb\x19\x9b\x9e\xb7\x85\x7f\xdc\xd1\x5c\xb8\x9f\x51\x94\x87\x65\xb7\xa7\xe5\x2a\xae\x12\x72\x4d\x57\x69\xd3\x3e\x2c\xd3\x9d\x1a\xd3\xe0\x55\x0f\x14\x0d\xcd\xd5\x8a\x88\x2b\xd1\x7c\x5c\x60\xb4\x2f\x9f\xe9\x68\x76\x73\xf5\x71\x14\xd4\x8a\x3f\x31\xba\x1e\xcf\x59\xbc\xbe\x3b\x98\xe7\x4b\x12\xf7\xb0\x14\x2c\x47\x3d\x50\x34\xfb\xf0\x8e\x86\xd2\x8e\xac\xaf\xcf\xa4\x52\x68\x0a\xde\xc2\xe3\x8b\xa1\xfa\x45\x13\x54\x16\x7d\xb5\x7e\x5f\x6d\xdf\x67\xd1\x40\xe9\x51\x64\xa5\x24\xb9\x9d\x7b\x53\x63\x80\xcc\x70\xc9\xb6\x0b\x2c\x14\xdd\x0c\x3e\xb8\x7b\x13\xda\x6a\xe1\x85\x72\x6c\x03\x56\xf7\xe4\xb9\x56\x75\xf0\x65\x3b\xd5\xf2\x57\x3c\xf0\xac\xa4\xc6\x45\x77\x64\xb5\xb1\x5f\xe6\xc3\xdd\xf0\x64\x6b\x2f\xfe\xf2\xd4\x84\x36\x1b\x3d\xa5\x0d\x06\xff\x32\xcf\x83\x89\x3b\xae\xf5\x6c\xf6\x26\xa6\x01\x38\x68\x15\xb1\x88\x63\x15\x78\x17\xad\xa6\x4a\x17\xad\x78\xd2\xaf\xed\xe2\x29\xd9\x33\x1e\x49\x19\x7d\xc7\x78\xea\xa4\xe6\x32\x3c\x25\xc9\x4b\xd2\x52\xa5\xc9\x3f\x03\x00\xc7\x6a\x10\xef\xc5\x0b\x00\x00")
+
+func bindataEventsMkdirHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataEventsMkdirH,
+		"/events/mkdir.h",
+	)
+}
+
+
+
+func bindataEventsMkdirH() (*asset, error) {
+	bytes, err := bindataEventsMkdirHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/events/mkdir.h",
+		size: 3013,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792221307, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataEventsModifyH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\xd1\x6e\xdb\xb8\x12\x7d\xd7\x57\x9c\xa6\x40\x20\x07\xae\x15\xe4\x5e\xdc\x87\x06\xbe\xb8\x6e\xe2\xb4\xc2\x4d\xed\xc2\x76\x5a\xe4\x89\xa0\xa5\x91\x4d\x44\x26\xb5\xe4\x28\x8e\xb1\xdb\x0f\xda\xdf\xd8\x2f\x5b\x90\x92\x57\x36\xea\xee\x62\x8b\x45\x00\x25\x1c\x0e\xcf\x9c\x99\x39\x33\x49\x2e\xa2\x1b\x53\xed\xac\x5a\xad\x19\xbf\xfd\x8a\xab\xcb\xab\x4b\xbc\x7f\x48\xef\xef\x47\x0f\x1f\xc7\xb8\x9b\x3e\xcc\x26\xe9\x78\x16\x45\xf7\x2a\x23\xed\x28\x47\xad\x73\xb2\xe0\x35\x61\x54\xc9\x6c\x4d\x68\x6f\xfa\xf8\x4c\xd6\x29\xa3\x71\x35\xb8\x44\xec\x1d\xce\xda\xab\xb3\xde\x75\xb4\x33\x35\x36\x72\x07\x6d\x18\xb5\x23\xf0\x5a\x39\x14\xaa\x24\xd0\x4b\x46\x15\x43\x69\x64\x66\x53\x95\x4a\xea\x8c\xb0\x55\xbc\x06\x77\xe8\x83\xe8\xb1\x05\x30\x4b\x96\x4a\x43\x22\x33\xd5\x0e\xa6\x38\xf4\x82\xe4\x28\x02\x80\x35\x73\xf5\x36\x49\xb6\xdb\xed\x40\x06\x96\x03\x63\x57\x49\xd9\x78\xb9\xe4\x3e\xbd\x19\x4f\xe6\xe3\x37\x57\x83\xcb\x28\x7a\xd0\x25\x39\x07\x4b\x3f\xd5\xca\x52\x8e\xe5\x0e\xb2\xaa\x4a\x95\xc9\x65\x49\x28\xe5\x16\xc6\x42\xae\x2c\x51\x0e\x36\x9e\xe7\xd6\x2a\x56\x7a\xd5\x87\x33\x05\x6f\xa5\xa5\x28\x57\x8e\xad\x5a\xd6\x7c\x54\xa0\x3d\x2b\xe5\x70\xe8\x60\x34\xa4\xc6\xd9\x68\x8e\x74\x7e\x86\x77\xa3\x79\x3a\xef\x47\x5f\xd2\xc5\x87\xe9\xc3\x02\x5f\x46\xb3\xd9\x68\xb2\x48\xc7\x73\x4c\x67\xb8\x99\x4e\x6e\xd3\x45\x3a\x9d\xcc\x31\xbd\xc3\x68\xf2\x88\xff\xa7\x93\xdb\x3e\x48\xf1\x9a\x2c\xe8\xa5\xb2\x9e\xbb\xb1\x50\xbe\x74\x94\x0f\xa2\x39\xd1\x51\xf0\xc2\x34\xdd\x72\x15\x65\xaa\x50\x19\x4a\xa9\x57\xb5\x5c\x11\x56\xe6\x99\xac\x56\x7a\x85\x8a\xec\x46\x39\xdf\x3c\x07\xa9\xf3\xa8\x54\x1b\xc5\x92\xc3\xf9\x9b\x74\x06\xd1\x45\x12\xbd\x56\x85\xce\xa9\x80\xf8\x38\xbd\x4d\xef\x1e\xc5\x07\x11\xbd\xce\xa9\x50\x9a\x0e\x4d\x51\x92\x80\xad\xcc\x48\x6c\x4c\xae\x8a\x1d\xde\x60\xe1\x8f\x0e\xb2\xe9\x7d\x30\xab\x2c\x84\x02\x3d\x93\xe6\x81\x7f\xf3\xbf\x8c\x5f\xde\xc2\xd2\x4a\x39\x26\xeb\x90\x19\xcd\xf4\xc2\xe1\x2a\x27\xcd\x76\xf7\x16\x95\x51\x9a\x7d\xa1\x4d\x48\xaf\x31\xef\xf5\xe0\xc1\x23\x21\x24\xb7\x45\x17\x22\x8e\x65\xb9\x95\x3b\x27\x94\x2e\x95\xa6\x5e\x0f\xce\x67\x98\x41\x69\x3e\xe2\x18\x3b\xb6\x75\xc6\xa8\x58\x58\x5a\x39\x5c\x64\xfc\xd2\x47\x6b\x6c\xa3\x5c\x34\xbf\xfb\x10\xa2\xfe\xd7\x15\x36\xd2\x3d\xf5\xa2\x9f\x83\xf2\x92\x04\x5f\x08\x46\x97\x3b\x64\xd2\x12\xe4\xd2\xd4\x7c\x22\xd9\x58\xe5\x20\xc7\xb8\x9b\xb7\xf5\xea\x85\xe7\xaa\x40\xec\xe1\xf0\x6a\x88\xab\xc6\xd4\xe0\xfa\x1f\x4b\x5c\x5b\x8d\xcb\xeb\x60\xf9\x1a\xbe\x3e\x7e\x56\xd5\x18\x62\x59\x15\x62\x45\x2c\xdc\xa6\x12\x95\x35\x19\x39\x67\xac\x50\x79\xdc\x6b\xfc\x8f\x52\x10\x99\x9f\x0a\xc1\xb8\xc8\x25\xcb\xe6\xd4\x62\x6c\x64\x25\x4a\x63\x9e\xea\x4a\x50\x49\x9b\xf8\xfc\xe8\xc5\xb2\x56\x65\x4e\xb6\x8f\xf3\xac\xaa\x5b\x64\x4f\xfa\x55\x87\xd3\xfb\x0e\xe1\x24\xc1\x8c\x1c\x31\x2a\xc9\x6b\x2d\x37\x84\x27\xda\x39\xc4\x99\xa9\xcb\x1c\x1b\x2f\xe4\xba\x82\x25\x67\xca\x3a\xd4\x48\x85\x66\x5a\xc2\x56\x3a\x38\xb3\x21\x94\x54\xb0\x57\x2d\x7c\xb4\x26\x4e\x17\xf7\xcd\x7f\x0b\x27\x1a\x11\x39\x9b\x09\x1f\x45\x3c\xd1\x0e\xc3\x3d\x81\x93\xae\x2c\xad\xaf\xda\x9f\x7b\x67\xb5\x75\xc6\x76\x77\x49\x82\x51\x9e\xa3\x2d\x73\x60\x13\xc8\xd4\xff\xf9\xb7\x4f\x0a\x43\xdf\xf1\x72\xdf\x06\xe1\xef\xe3\xf3\x93\xe1\x0f\x5d\xda\x72\x26\x09\x3e\x59\xb3\x24\xf0\xae\xa2\xef\x13\x0f\x5f\x0c\x31\xfe\x3c\x9e\x2c\x5a\x11\x5d\x47\x87\xf4\x94\x36\x39\x75\xe4\x4e\xa2\xf8\x4a\x35\x7e\x43\xf8\x3a\xb4\xcd\x56\xda\xc4\xcd\x9f\x1d\x29\x9f\xf1\xc6\xd4\x9a\x91\xde\x1e\x2a\xaa\x79\x7e\x71\x1a\x25\xa7\x63\x9c\xef\x92\x08\xc8\x42\xe5\x2d\x42\x40\xfb\xc3\x18\x87\x63\xaf\x4b\xef\x36\x60\x9e\xce\xcd\xa7\xd4\x0e\xea\xb0\x95\x7b\xf7\xf0\x4e\x95\x4c\xb6\x93\x6d\x11\xce\x71\xf7\xbe\x8f\xbb\xf4\x7e\x31\x9e\x89\xf9\xec\xa6\x77\x4a\xca\x7b\xa4\x39\xe9\xf0\x0f\x21\xf4\x25\x18\xf7\xd3\x53\x57\xb9\x64\x3a\x31\x3d\x7d\x9c\x3f\xd1\xae\x7f\xc0\xb6\x8f\x77\x9f\xee\xc4\x68\xf2\xd8\x96\xa7\x0b\xf3\xf5\x9b\xed\x29\x2c\x71\xb7\x41\xfd\x9a\x6b\xbd\x4d\xf1\x83\xfb\xf4\xc7\x36\xa4\xe7\x71\x6a\x4b\xee\x57\x60\x37\x04\xfb\x95\x94\xd5\xd6\x92\x66\x51\xa9\x5c\xf0\xea\x1f\x5e\x49\x4d\x51\xff\xde\x2a\x3a\xa9\x42\x4b\xfc\x2c\x4b\x0c\xf1\x69\x21\x66\xe3\xf7\x73\x31\xbb\x89\x33\x7e\x39\x50\xdd\xcc\xef\xa6\x67\x0a\x0b\xcc\xb5\xfd\x0a\x96\xb0\x6c\x9c\xf7\x3e\x6e\xee\x6c\x3c\x9f\xde\x7f\x1e\x7b\x2d\xe1\x17\x8c\x3f\xa6\x0b\x11\xc6\xb5\x77\x7d\x24\x98\x9c\x4a\x62\xfa\xab\xdc\xba\x14\xbe\x46\xd1\x6b\xd2\xb9\x2a\xa2\xdf\x07\x00\xa3\xad\x20\x54\xc0\x09\x00\x00")
+
+func bindataEventsModifyHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataEventsModifyH,
+		"/events/modify.h",
+	)
+}
+
+
+
+func bindataEventsModifyH() (*asset, error) {
+	bytes, err := bindataEventsModifyHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/events/modify.h",
+		size: 2496,
+		md5checksum: "",
+		mode: os.FileMode(436),
+		modTime: time.Unix(1677602370, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataEventsOpenH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\xed\x6e\xdb\x46\x16\xfd\xcf\xa7\x38\xeb\x00\x06\xa9\x95\x45\xc3\xbb\xd8\x1f\x31\x14\xac\x62\xcb\x89\x50\x47\x32\x24\x39\x41\x7e\x0d\x46\xe4\xa5\x34\x30\x39\xc3\xce\x5c\x5a\x56\xdb\x3c\x50\x5f\xa3\x4f\x56\x0c\x49\x99\x72\xaa\x04\x68\x50\xc0\x10\xc0\x99\xfb\x71\xee\x39\x77\x8e\xe3\x5e\x70\x65\xca\x9d\x55\xeb\x0d\xe3\x8f\xdf\x71\x71\x7e\x71\x8e\x77\xf7\x93\xdb\xdb\xd1\xfd\x87\x31\x6e\x66\xf7\xf3\xe9\x64\x3c\x0f\x82\x5b\x95\x90\x76\x94\xa2\xd2\x29\x59\xf0\x86\x30\x2a\x65\xb2\x21\xb4\x37\x7d\x7c\x24\xeb\x94\xd1\xb8\x18\x9c\x23\xf4\x01\x27\xed\xd5\x49\x74\x19\xec\x4c\x85\x42\xee\xa0\x0d\xa3\x72\x04\xde\x28\x87\x4c\xe5\x04\x7a\x4a\xa8\x64\x28\x8d\xc4\x14\x65\xae\xa4\x4e\x08\x5b\xc5\x1b\x70\x57\x7d\x10\x7c\x6e\x0b\x98\x15\x4b\xa5\x21\x91\x98\x72\x07\x93\x1d\x46\x41\x72\x10\x00\xc0\x86\xb9\x7c\x1d\xc7\xdb\xed\x76\x20\x6b\x94\x03\x63\xd7\x71\xde\x44\xb9\xf8\x76\x72\x35\x9e\x2e\xc6\x67\x17\x83\xf3\x20\xb8\xd7\x39\x39\x07\x4b\x3f\x57\xca\x52\x8a\xd5\x0e\xb2\x2c\x73\x95\xc8\x55\x4e\xc8\xe5\x16\xc6\x42\xae\x2d\x51\x0a\x36\x1e\xe7\xd6\x2a\x56\x7a\xdd\x87\x33\x19\x6f\xa5\xa5\x20\x55\x8e\xad\x5a\x55\xfc\x82\xa0\x3d\x2a\xe5\x70\x18\x60\x34\xa4\xc6\xc9\x68\x81\xc9\xe2\x04\x6f\x47\x8b\xc9\xa2\x1f\x7c\x9a\x2c\xdf\xcf\xee\x97\xf8\x34\x9a\xcf\x47\xd3\xe5\x64\xbc\xc0\x6c\x8e\xab\xd9\xf4\x7a\xb2\x9c\xcc\xa6\x0b\xcc\x6e\x30\x9a\x7e\xc6\x4f\x93\xe9\x75\x1f\xa4\x78\x43\x16\xf4\x54\x5a\x8f\xdd\x58\x28\x4f\x1d\xa5\x83\x60\x41\xf4\xa2\x79\x66\x1a\xb5\x5c\x49\x89\xca\x54\x82\x5c\xea\x75\x25\xd7\x84\xb5\x79\x24\xab\x95\x5e\xa3\x24\x5b\x28\xe7\xc5\x73\x90\x3a\x0d\x72\x55\x28\x96\x5c\x7f\xff\x65\x9c\x41\xd0\x8b\x83\x57\x2a\xd3\x29\x65\x10\xb3\xbb\xf1\x54\xbc\x17\xc1\xab\x94\x32\xa5\xa9\x3b\x08\xe2\x18\x6c\x65\x42\xc2\x94\xa4\x71\x86\xa5\xff\x70\x90\x8d\xea\x6e\xe7\x98\x0a\xd4\x77\xf4\x48\x9a\x07\x3e\xe1\xff\x09\x3f\xbd\x86\xa5\xb5\x72\x4c\xd6\x21\x31\x9a\xe9\x89\xeb\xab\x52\xf2\xe6\x35\x4a\xa3\x34\x7b\x7e\x4d\x3d\x55\x5d\xcb\xdf\xc0\xb1\xad\x12\xae\x2c\x05\x42\x48\x6e\xc9\x16\x22\x0c\x65\xbe\x95\x3b\x27\x94\xce\x95\xa6\x28\x82\xf3\x93\x25\x50\x9a\x0f\xf0\x85\x4d\x3a\x4a\x16\x96\xd6\x0e\xbd\x84\x9f\xfa\x6d\xcd\xa6\x7e\xcf\xff\x46\xc1\xaf\xf5\x7e\x55\xff\xb9\x40\x52\x56\x18\x62\x55\x66\x62\x4d\x2c\x5c\x51\x8a\xd2\x9a\x84\x9c\x33\x56\xa8\x34\x8c\x2e\xeb\xc8\xb6\x44\x4a\x9a\xed\x4e\x24\x7e\x17\x05\xa3\x97\x4a\x96\xcd\x57\x5b\xa3\x90\xa5\xc8\x8d\x79\xa8\x4a\x41\x39\x15\xe1\xe9\x8b\x8c\x55\xa5\xf2\x94\x6c\x1f\xa7\x49\x59\xb5\x95\x55\x86\xf0\x5f\x5d\x9d\xa8\x3e\xf4\x7f\x96\xb8\xb2\x1a\xe7\x4d\x58\x1c\x63\x4e\x8e\x9a\x29\xb4\x2c\x08\x0f\xb4\x73\x08\x13\x53\xe5\x29\x0a\xbf\x3e\x55\x09\x4b\xce\xe4\x95\x57\x1c\xaa\x7e\x52\x96\xb0\x95\x0e\xce\x14\x84\x9c\x32\xf6\xbb\x02\xdf\xad\xe9\xd3\xf5\x3d\x7b\x93\x39\xd1\x28\xe8\x6c\x22\x7c\x17\xf1\x40\x3b\x0c\xf7\x00\x8e\x86\xb2\xb4\x9e\xb5\xef\x47\x27\x95\x75\xc6\x76\x77\x71\x8c\x51\x9a\xa2\xa5\xb9\x46\x53\x83\xa9\xfe\xf7\x5f\x3f\x14\x86\x7e\xb5\xf2\xbd\x0c\xc2\xdf\x87\xa7\x47\xdb\x1f\x86\xb4\x74\xc6\x31\xee\xac\x59\x11\x78\x57\xd2\xb7\x81\xd7\xbf\x18\x62\xfc\x71\x3c\x5d\xd6\xcb\x7e\x19\x1c\x82\x53\xda\xa4\xd4\x41\x7b\x21\x3f\x7a\x8d\xa8\x4d\x43\xbf\x39\xa5\xef\x28\x2c\xc9\x74\x2f\x78\x1f\x4e\xfd\x42\x26\x0b\xbf\xca\x8c\xfa\x38\xf5\x6c\x9d\xbd\x69\x4e\xa2\xcb\x6f\x63\xf4\x3a\x34\x38\x86\xf0\x2c\xb7\xab\xa4\xb4\x09\x5f\x24\xc7\x31\x3e\x98\x4a\x33\x26\xd7\x87\x60\x1f\x33\x57\xd4\xc7\xbd\x42\xf3\x71\xac\x85\xe6\xaf\x81\x76\x59\x1d\xd4\x42\x73\x74\xbc\xc0\x37\x71\xd7\x9d\x85\x4a\x9f\xcb\x2b\xcd\x51\x1f\xe1\xa3\x51\x29\x7a\x51\xa1\x19\xff\x46\x6e\x64\x2a\xf6\x0d\x45\xa1\x59\xa8\x54\x98\x2c\x73\xc4\x61\x14\x75\x82\x5c\xd7\xd3\x76\x6a\x1c\x76\xf5\x24\xb5\xe4\x0e\x5b\x96\xbb\xc4\x1b\x95\x33\xd9\xee\x99\x65\xf5\x77\xd8\xe5\xf7\x71\x33\xb9\x5d\x8e\xe7\x62\x31\xbf\x8a\x8e\x3d\xbd\x7d\xa5\x05\xe9\xfa\xdf\x46\xad\xd1\x33\x15\xfe\xb5\x57\x65\x2a\x99\x8e\xbc\xf6\x3e\x4e\x1f\x68\xd7\x3f\x40\xdb\xc7\xdb\xbb\x1b\x31\x9a\x7e\x6e\xd9\xec\xda\x7c\xf9\xca\x67\x85\x25\xee\xbc\xd6\x3b\x64\x1b\x6b\xb2\x1f\x73\xde\x1f\x31\x53\x0f\xe2\x98\xa1\x3e\xbb\xe7\xf3\x7b\xdd\xbb\x67\x52\x59\x4b\x9a\x45\xa9\x52\xc1\xeb\x7f\xd8\x3d\x1b\x3e\xff\x9e\x6b\x1e\xdd\x4f\x4b\xfc\x28\x73\x0c\x71\xb7\x14\xf3\xf1\xbb\x85\x98\x5f\x85\x09\x3f\x1d\x2c\xdc\xdc\xdb\xe8\x23\xd5\x5e\xeb\x5a\xa9\xea\x93\xda\x17\x9d\x8f\x7e\xa9\xeb\x7c\xbc\x98\xdd\x7e\x1c\xfb\x35\xc2\x6f\x18\x7f\x98\x2c\x45\xed\x2c\x2d\xda\x7a\xd1\xdb\x59\x3a\x8b\x16\x85\x49\x69\x4f\xd1\x7e\xfc\x94\x72\xfa\xce\x3a\xb5\xd1\xdd\x94\x5f\x82\xe0\x15\xe9\x54\x65\xc1\x9f\x03\x00\xe6\x18\x54\x5a\x04\x0a\x00\x00")
+
+func bindataEventsOpenHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataEventsOpenH,
+		"/events/open.h",
+	)
+}
+
+
+
+func bindataEventsOpenH() (*asset, error) {
+	bytes, err := bindataEventsOpenHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/events/open.h",
+		size: 2564,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792220584, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataEventsRenameH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xff\x6e\xdb\xbc\x15\xfd\x5f\x4f\x71\x96\x02\x81\x1d\xb8\x56\x90\x6f\x18\x86\x06\x1e\xe6\x2f\x71\xf2\x19\x4b\x9d\xc2\x76\x5a\xf4\x2f\x82\x16\xaf\x6c\x22\x32\xa9\x91\x54\x1c\xa3\xcd\x03\xed\x35\xf6\x64\x03\x29\xc9\x92\x67\x27\x5d\x8b\x01\x41\x00\x93\x97\xf7\xdc\x5f\xe7\x5c\xc5\x67\xd1\x95\xce\xb7\x46\x2e\x57\x0e\xff\xfe\x17\x2e\xce\x2f\xce\x71\xfb\x30\xbe\xbb\x1b\x3e\x7c\x1c\xe1\xe6\xfe\x61\x3a\x19\x8f\xa6\x51\x74\x27\x13\x52\x96\x04\x0a\x25\xc8\xc0\xad\x08\xc3\x9c\x27\x2b\x42\x75\xd3\xc3\x67\x32\x56\x6a\x85\x8b\xfe\x39\x3a\xde\xe0\xa4\xba\x3a\xe9\x5e\x46\x5b\x5d\x60\xcd\xb7\x50\xda\xa1\xb0\x04\xb7\x92\x16\xa9\xcc\x08\xf4\x9c\x50\xee\x20\x15\x12\xbd\xce\x33\xc9\x55\x42\xd8\x48\xb7\x82\x6b\xbc\xf7\xa3\xaf\x95\x03\xbd\x70\x5c\x2a\x70\x24\x3a\xdf\x42\xa7\x6d\x2b\x70\x17\x45\x00\xb0\x72\x2e\xff\x10\xc7\x9b\xcd\xa6\xcf\x43\x94\x7d\x6d\x96\x71\x56\x5a\xd9\xf8\x6e\x7c\x35\x9a\xcc\x46\xef\x2f\xfa\xe7\x51\xf4\xa0\x32\xb2\x16\x86\xfe\x59\x48\x43\x02\x8b\x2d\x78\x9e\x67\x32\xe1\x8b\x8c\x90\xf1\x0d\xb4\x01\x5f\x1a\x22\x01\xa7\x7d\x9c\x1b\x23\x9d\x54\xcb\x1e\xac\x4e\xdd\x86\x1b\x8a\x84\xb4\xce\xc8\x45\xe1\xf6\x0a\x54\x47\x25\x2d\xda\x06\x5a\x81\x2b\x9c\x0c\x67\x18\xcf\x4e\xf0\xfb\x70\x36\x9e\xf5\xa2\x2f\xe3\xf9\x1f\xf7\x0f\x73\x7c\x19\x4e\xa7\xc3\xc9\x7c\x3c\x9a\xe1\x7e\x8a\xab\xfb\xc9\xf5\x78\x3e\xbe\x9f\xcc\x70\x7f\x83\xe1\xe4\x2b\xfe\x31\x9e\x5c\xf7\x40\xd2\xad\xc8\x80\x9e\x73\xe3\x63\xd7\x06\xd2\x97\x8e\x44\x3f\x9a\x11\xed\x81\xa7\xba\xec\x96\xcd\x29\x91\xa9\x4c\x90\x71\xb5\x2c\xf8\x92\xb0\xd4\x4f\x64\x94\x54\x4b\xe4\x64\xd6\xd2\xfa\xe6\x59\x70\x25\xa2\x4c\xae\xa5\xe3\x2e\xfc\x3e\x48\xa7\x1f\x9d\xc5\xd1\x3b\x99\x2a\x41\x29\xd8\x74\x34\x19\x7e\x1c\xb1\x3f\x58\xf4\x4e\x50\x2a\x15\xb5\x8f\xa2\x38\x86\x33\x3c\x21\x66\x48\xf1\x35\xe1\x3d\xe6\xfe\xa7\x05\x2f\x7b\x6f\xb7\xd6\xd1\x1a\xd5\x2d\x3d\x91\x72\x7d\xff\xe8\xef\x89\x7b\xfe\x00\x43\x4b\x69\x1d\x19\x8b\x44\x2b\x47\xcf\x2e\x5c\xe9\x4c\x30\x41\xca\x99\xed\x07\xe4\x5a\x2a\xe7\xab\xad\x43\x8e\xe5\x31\xac\x33\x45\xe2\x0a\x43\xf5\x78\x58\x5d\x98\x84\x02\x64\x70\xa1\x68\xc3\x84\x34\x07\xef\xa5\xd2\x82\x0e\x9f\x0b\xb2\x4e\xaa\x50\x0f\x08\x69\x28\x71\xda\x6c\x1b\x47\x3f\x17\x4b\xdb\x59\x08\x88\x31\xee\xaa\xd9\x60\xac\xd3\xe1\xd9\x86\x6f\x2d\x93\x2a\x93\x8a\xba\x5d\x58\xdf\x88\x04\x52\xb9\xbd\x52\x76\xca\x28\x91\x3b\x66\x68\x69\x71\x96\xb8\xe7\x5e\x85\x56\x63\x9f\x35\xa5\xda\x5d\x95\x29\x9e\x55\x15\x38\x78\xd1\x24\xd4\x8d\xbe\x05\x2a\x15\xbf\x5d\x20\xc9\x0b\x0c\xb0\xc8\x53\xb6\x24\xc7\xec\x3a\x67\xb9\xd1\x09\x59\xab\x0d\x93\xa2\xd3\xbd\x0c\x96\x7b\xae\x58\xe2\x69\xc7\x1c\xce\x04\x77\xbc\xfc\x55\xf9\x58\xf3\x9c\x65\x5a\x3f\x16\x39\xa3\x8c\xd6\x9d\xd3\xbd\x17\x8b\x42\x66\x82\x4c\x0f\xa7\x49\x5e\x54\x9e\x65\x8a\xce\x9f\x1a\x3f\xdd\x70\xe8\xff\x0c\xb9\xc2\x28\x9c\x97\x66\x71\x8c\x29\x59\x72\xc8\xb9\x5b\x85\x89\x7a\xa4\xad\x45\x27\xd1\x45\x26\xb0\xf6\x4c\x29\x72\x18\xb2\x3a\x2b\x42\x33\x65\x68\x89\x21\x6c\xb8\x85\xd5\x6b\x42\x46\xa9\xf3\xb4\x80\x47\x2b\x71\x1a\xdc\xf7\x7f\x4b\x2d\x2b\x87\xd4\x9a\x84\x79\x14\xf6\x48\x5b\x0c\xea\x00\x8e\x9a\x3a\x6e\x7c\xd5\xde\xb6\x4e\x0a\x63\xb5\x69\xee\xe2\x18\x43\x21\x50\x95\x39\x44\x13\x82\x29\xfe\xf2\x67\x94\x90\xa9\xcc\xb2\xba\x0d\xcc\xdf\x77\x4e\x8f\xc2\xb7\x4d\xaa\x72\xc6\x31\x3e\x19\xbd\x20\xb8\x6d\x4e\xaf\x07\x1e\xfe\x63\x80\xd1\xe7\xd1\x64\x5e\xb1\xfa\x32\x7a\xdd\xde\xd7\xa4\x1c\xaf\x01\x7c\xc6\x55\x5b\xa5\xd2\x9d\x66\x10\x9b\x10\x6e\x49\x91\xe1\x8e\xbc\x16\xf0\x47\x0a\x69\xd5\x62\xa5\x33\x81\xd2\x15\xb7\x2d\x66\x6e\x64\x96\x61\x41\x30\x54\x58\x12\xff\x73\x77\xea\xc1\xcd\x0d\x57\x42\xaf\x59\xf1\xdb\x45\xa7\xbb\x57\x67\x8f\xb7\xd6\x85\x72\x18\x5f\xb7\x67\xb9\x62\x8b\x0f\xff\x78\x66\x82\x0e\x73\x7b\x35\xa4\x80\xc0\xa4\xa8\xbc\x04\x8f\xbb\xc3\xce\x0e\xa4\x5b\x15\x39\x8e\x71\x1d\xfc\x36\xed\x6f\xbb\xf6\x49\x56\xb4\x1d\xa0\x09\xe2\x30\x86\x6a\x00\x85\xf4\xf3\x55\x11\xff\x75\xab\xda\x63\xa3\x04\x7b\x95\x52\xb4\xd9\x55\xaa\x17\x5a\x53\x3e\xf4\xe2\xea\x95\x54\x11\x09\x0b\xe9\x5e\xaf\x44\x05\xf4\x76\x31\xaa\x30\x5b\xa5\xb8\x09\xfe\x77\x72\x50\xc2\x75\x1a\x84\x1e\x6e\xc6\x77\xf3\xd1\x94\xcd\xa6\x57\x5d\x7c\xff\xfe\xba\xc1\x7c\x38\xbd\x1d\xcd\xbb\xe8\xe2\xdb\x4e\x48\x4a\xe9\xd0\xd9\x53\xbd\x2c\x5a\x12\x13\x8e\x03\xdb\x6d\x27\xa8\x6c\xdb\xe7\x74\x34\xbb\xbf\xfb\x3c\x0a\xa8\x97\x6d\x77\x33\x52\xe1\x7b\x21\x60\xef\x2e\x6a\xfd\x2b\x72\xc1\x1d\x1d\xd1\xbf\x1e\x4e\x1f\x69\xbb\x8f\xf1\xfb\xa7\x1b\x36\x9c\x7c\xad\xc6\xeb\x25\xda\x57\xbe\x97\x83\x35\xcb\x0c\xb9\x66\xd5\xfa\x1e\x55\xd6\x3a\xfd\xd5\xc5\xfb\x6b\x4b\xca\x07\x72\x6c\x51\xed\x76\xcb\x4e\xcd\x6a\x8a\x26\x85\x31\xa4\x1c\xcb\xa5\x60\x6e\xf9\x7f\xde\x2d\x65\x6d\x7f\x6e\xa7\x1c\x1d\x61\x43\xee\x89\x67\x18\xe0\x93\xd7\xc5\xdb\x19\x9b\x5e\xf9\xc9\x68\x4d\xab\x17\xef\x8a\x18\x81\xe6\xc7\x29\xfc\xdf\x9c\x78\x45\x3d\x8f\xb3\xbe\x7b\x79\x04\x6c\x4f\xc4\x7e\x9d\x7e\xed\x97\xd5\x83\x7d\x36\xd6\x64\x09\xac\x88\x7e\x82\x27\x25\xf9\xf0\x1d\xa3\x8f\xe3\x39\x0b\xab\xa5\xe5\xf6\x6a\x45\xc9\x23\x52\x9d\x65\xda\xcb\x8c\x20\xff\x39\x0a\xa9\x2c\x99\xba\x90\x32\x85\x22\xbf\xf4\xb8\xd9\xee\x86\xa8\x7c\xc1\xc2\x8b\x01\x32\xcd\x05\x6b\x1d\xd5\x43\xe4\x3f\x23\x5a\xc7\x6d\xfe\x17\x7f\xc5\x13\xcf\x0a\x6a\x36\x70\x6b\xea\xea\x55\xc2\x6a\x95\xab\xf6\xca\xb7\x97\xc6\xb4\xb9\xe8\x4b\xa5\x31\xf8\x71\x9f\x8f\xbe\x6d\xb5\xe5\x2d\x07\xb5\xd9\xe5\xdb\xc2\x12\x2a\x66\x59\xe9\xbf\x87\xd3\x06\xa8\x87\xd3\x90\xef\xa1\xb8\xd4\xad\xb8\xa6\x8c\x1c\x21\x64\x80\x40\xa2\xa8\x8d\x23\xc2\xf5\x8f\x48\xd6\x70\xe9\x25\x8a\xde\x91\x12\x32\x8d\xfe\x33\x00\xb1\x26\xbd\x7a\x73\x0e\x00\x00")
+
+func bindataEventsRenameHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataEventsRenameH,
+		"/events/rename.h",
+	)
+}
+
+
+
+func bindataEventsRenameH() (*asset, error) {
+	bytes, err := bindataEventsRenameHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/events/rename.h",
+		size: 3699,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792220584, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataEventsRmdirH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\xd1\x6e\xdb\xc6\x12\x7d\xe7\x57\x9c\xeb\x00\x86\x64\x28\xa2\xe1\x5b\xf4\x21\x86\x8b\x2a\xb6\x9c\x10\x75\xa4\x80\x92\x13\xe4\x69\xb1\xe2\x0e\xa5\x85\xa9\x5d\x76\x77\x68\x99\x68\xf3\x41\xfd\x8d\x7e\x59\xb1\x24\x65\x49\x89\x9a\xa2\x41\x01\x41\xc2\xce\xcc\xce\x9c\x99\x39\x7b\x14\x9f\x45\xd7\xb6\xac\x9d\x5e\xae\x18\x7f\xfe\x81\x8b\xf3\x8b\x73\xbc\xb9\x4f\xee\xee\x46\xf7\xef\xc6\xb8\x9d\xde\xa7\x93\x64\x9c\x46\xd1\x9d\xce\xc8\x78\x52\xa8\x8c\x22\x07\x5e\x11\x46\xa5\xcc\x56\x84\xce\x33\xc0\x07\x72\x5e\x5b\x83\x8b\xe1\x39\x7a\x21\xe0\xa4\x73\x9d\xf4\x2f\xa3\xda\x56\x58\xcb\x1a\xc6\x32\x2a\x4f\xe0\x95\xf6\xc8\x75\x41\xa0\xa7\x8c\x4a\x86\x36\xc8\xec\xba\x2c\xb4\x34\x19\x61\xa3\x79\x05\xde\x65\x1f\x46\x9f\xba\x04\x76\xc1\x52\x1b\x48\x64\xb6\xac\x61\xf3\xfd\x28\x48\x8e\x22\x00\x58\x31\x97\xaf\xe2\x78\xb3\xd9\x0c\x65\x83\x72\x68\xdd\x32\x2e\xda\x28\x1f\xdf\x25\xd7\xe3\xc9\x6c\xfc\xf2\x62\x78\x1e\x45\xf7\xa6\x20\xef\xe1\xe8\xd7\x4a\x3b\x52\x58\xd4\x90\x65\x59\xe8\x4c\x2e\x0a\x42\x21\x37\xb0\x0e\x72\xe9\x88\x14\xd8\x06\x9c\x1b\xa7\x59\x9b\xe5\x00\xde\xe6\xbc\x91\x8e\x22\xa5\x3d\x3b\xbd\xa8\xf8\x60\x40\x5b\x54\xda\x63\x3f\xc0\x1a\x48\x83\x93\xd1\x0c\xc9\xec\x04\xaf\x47\xb3\x64\x36\x88\x3e\x26\xf3\xb7\xd3\xfb\x39\x3e\x8e\xd2\x74\x34\x99\x27\xe3\x19\xa6\x29\xae\xa7\x93\x9b\x64\x9e\x4c\x27\x33\x4c\x6f\x31\x9a\x7c\xc2\x2f\xc9\xe4\x66\x00\xd2\xbc\x22\x07\x7a\x2a\x5d\xc0\x6e\x1d\x74\x18\x1d\xa9\x61\x34\x23\x3a\x28\x9e\xdb\x76\x5b\xbe\xa4\x4c\xe7\x3a\x43\x21\xcd\xb2\x92\x4b\xc2\xd2\x3e\x92\x33\xda\x2c\x51\x92\x5b\x6b\x1f\x96\xe7\x21\x8d\x8a\x0a\xbd\xd6\x2c\xb9\x39\x7f\xd5\xce\x30\x3a\x8b\xa3\x17\x3a\x37\x8a\x72\x88\xf4\xdd\x4d\x92\x8a\xb7\x22\x7a\xa1\x28\xd7\x86\xf6\x2c\x51\x1c\x83\x9d\xcc\x48\xb8\xb5\xd2\x0e\x2f\x31\x0f\x27\x0f\xd9\x2e\xde\xd7\x9e\x69\x8d\xd6\x49\x8f\x64\x78\x18\xae\xfc\x9c\xf1\xd3\x2b\x38\x5a\x6a\xcf\xe4\x3c\x32\x6b\x98\x9e\xb8\x71\x29\xed\x5e\xa1\xb4\xda\x70\x18\xb1\x6d\x1a\x53\xda\x51\xc6\xd6\xd5\xe0\x95\xe4\x26\x5c\x6a\xe3\xbf\xf4\x59\x28\x2a\x88\xa9\xcd\x43\x86\x5d\xfd\x75\xaa\xc6\xbc\x25\xd5\xb1\xcb\x42\x48\xee\x16\x29\x44\xaf\x27\x8b\x8d\xac\xbd\xd0\xa6\xd0\x86\xfa\x7d\xf8\x30\xb5\x0c\xda\xf0\x7e\xe7\x3d\xcf\xae\xca\x18\x25\x0b\x47\x4b\x8f\xb3\x8c\x9f\x06\xe8\x8c\xda\x58\x45\x38\x53\xda\x3d\x9b\x3a\x18\x67\xed\x6f\x3f\xfa\xad\xe1\x74\xf5\xff\x0b\x64\x65\x85\x2b\x2c\xca\x5c\x2c\x89\x85\x5f\x97\xa2\x74\x36\x23\xef\xad\x13\x5a\xf5\xfa\x97\x4d\xe4\x41\x1a\x91\x05\xfe\x0b\xc6\x99\x92\x2c\xdb\x53\x97\x63\x2d\x4b\x51\x58\xfb\x50\x95\x82\x0a\x5a\xf7\x4e\x0f\x6e\x2c\x2a\x5d\x28\x72\x03\x9c\x66\x65\xd5\x65\xd6\x39\x7a\xff\xdb\xe5\xe9\x37\xc6\xf0\x71\xc4\x95\x33\x38\x6f\xc3\xe2\x18\x29\x79\x62\x94\x92\x57\x46\xae\x09\x0f\x54\x7b\xf4\x32\x5b\x15\x0a\xeb\x40\xd9\xaa\x84\x23\x6f\x8b\x2a\xb0\x0c\xba\x99\xb8\x23\x6c\xa4\x87\xb7\x6b\x42\x41\x39\x07\x7e\x22\x54\x6b\xeb\xec\xea\xbe\xfc\x29\xf7\xa2\x65\x8c\x77\x99\x08\x55\xc4\x03\xd5\xb8\xda\x02\x38\x1a\xca\xd2\x85\xa9\x7d\x3b\x3a\xab\x9c\xb7\x6e\xe7\x8b\x63\x8c\x94\x42\x37\xe6\x06\x4d\x03\xa6\xfa\xf1\x87\xd0\x14\xae\x02\x97\x8b\xed\x1a\x44\xf0\xf7\x4e\x8f\x96\xdf\x0f\xe9\xc6\x19\xc7\x78\xef\xec\x82\xc0\x75\x49\x7f\x0f\xbc\xf9\xc6\x15\xc6\x1f\xc6\x93\x79\xfb\xbe\x2e\xa3\x7d\x74\x2d\x87\x9e\xb1\x1d\x4d\x12\x06\xd5\xc6\x5d\x21\x8c\xa1\xdb\xb5\x36\xb6\xd7\xf1\xec\xa0\xe1\xb5\xad\x0c\x23\xb9\xf9\x76\xc2\x26\x4a\x68\xd5\xe5\x6c\xf2\x3f\x1b\x7b\x4a\xbb\xfe\x0e\xe8\x4d\x53\xe5\x38\xca\x90\xab\x63\xfd\x55\xc7\xdb\xdd\xc5\x5b\x5d\x30\xb9\x1d\xff\xf2\xe6\xdc\xdb\xdd\x1f\xe0\x36\xb9\x9b\x8f\x53\x31\x4b\xaf\xfb\xc7\x38\xb9\xcd\x34\x23\xd3\x68\x78\xd3\x4a\x63\xdc\x3e\x83\xaa\x54\x92\xe9\xc8\x33\x18\xe0\xf4\x81\xea\xc1\x1e\xda\x01\x5e\xbf\xbf\x15\xa3\xc9\xa7\x6e\x89\xbb\x32\x9f\xbf\x94\x3c\xe1\x88\x77\xb2\x17\x34\xa5\x0b\xb6\xf9\x77\x8a\xe0\x77\xe9\x4f\x80\x71\x4c\x83\x9e\x95\xe5\x99\xcb\x5b\x65\xc9\x2a\xe7\xc8\xb0\x28\xb5\x12\xbc\xfc\x8f\x95\xa5\x1d\xe9\xbf\x53\x94\xa3\x0c\x74\xc4\x8f\xb2\xc0\x15\xde\xcf\x45\x3a\x7e\x33\x13\xe9\x75\x2f\xe3\xa7\x3d\xce\xa5\x41\x62\x1e\xa9\xd1\x21\xdf\x6d\xab\xb1\x34\x9a\xe1\x43\xf4\xe1\x6a\xd3\xf1\x6c\x7a\xf7\x61\x1c\x98\x84\xdf\x31\x7e\x97\xcc\x45\xf3\xea\xfa\x97\x07\x74\x69\xff\x4a\xfe\xa9\xb7\x5d\x0b\x9f\xa3\xe8\x05\x19\xa5\xf3\xe8\xaf\x01\x00\x15\xa5\xbb\x50\x71\x09\x00\x00")
+
+func bindataEventsRmdirHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataEventsRmdirH,
+		"/events/rmdir.h",
+	)
+}
+
+
+
+func bindataEventsRmdirH() (*asset, error) {
+	bytes, err := bindataEventsRmdirHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/events/rmdir.h",
+		size: 2417,
+		md5checksum: "",
+		mode: os.FileMode(436),
+		modTime: time.Unix(1677602370, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataEventsSetattrH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xed\x6e\xdb\x38\x16\xfd\xaf\xa7\x38\x9b\x02\x81\x1c\x38\x76\x90\x5d\xec\x8f\x06\x29\xd6\x4d\x9c\xd6\xd8\xd4\x29\x2c\xa7\x45\x7f\x11\x8c\x74\x65\x13\x91\x49\x0e\x79\x15\xc7\x33\xd3\x07\x9a\xd7\x98\x27\x1b\x90\xb2\x2d\x7b\xe2\xce\x47\x31\x40\xa0\x98\x97\x97\xe7\x7e\x9d\x7b\xfa\x27\xc9\x95\xb1\x2b\xa7\x66\x73\xc6\xaf\xbf\xe0\xfc\xec\xfc\x0c\xef\xee\x47\xb7\xb7\x83\xfb\x0f\x43\xdc\xdc\xdd\x4f\xc6\xa3\xe1\x24\x49\x6e\x55\x4e\xda\x53\x81\x5a\x17\xe4\xc0\x73\xc2\xc0\xca\x7c\x4e\x58\xdf\x74\xf1\x89\x9c\x57\x46\xe3\xbc\x77\x86\x34\x38\x1c\xad\xaf\x8e\x3a\x17\xc9\xca\xd4\x58\xc8\x15\xb4\x61\xd4\x9e\xc0\x73\xe5\x51\xaa\x8a\x40\xcf\x39\x59\x86\xd2\xc8\xcd\xc2\x56\x4a\xea\x9c\xb0\x54\x3c\x07\xb7\xe8\xbd\xe4\xcb\x1a\xc0\x3c\xb0\x54\x1a\x12\xb9\xb1\x2b\x98\x72\xd7\x0b\x92\x93\x04\x00\xe6\xcc\xf6\x75\xbf\xbf\x5c\x2e\x7b\x32\x66\xd9\x33\x6e\xd6\xaf\x1a\x2f\xdf\xbf\x1d\x5d\x0d\xc7\xd9\xf0\xf4\xbc\x77\x96\x24\xf7\xba\x22\xef\xe1\xe8\x87\x5a\x39\x2a\xf0\xb0\x82\xb4\xb6\x52\xb9\x7c\xa8\x08\x95\x5c\xc2\x38\xc8\x99\x23\x2a\xc0\x26\xe4\xb9\x74\x8a\x95\x9e\x75\xe1\x4d\xc9\x4b\xe9\x28\x29\x94\x67\xa7\x1e\x6a\xde\x6b\xd0\x26\x2b\xe5\xb1\xeb\x60\x34\xa4\xc6\xd1\x20\xc3\x28\x3b\xc2\xdb\x41\x36\xca\xba\xc9\xe7\xd1\xf4\xfd\xdd\xfd\x14\x9f\x07\x93\xc9\x60\x3c\x1d\x0d\x33\xdc\x4d\x70\x75\x37\xbe\x1e\x4d\x47\x77\xe3\x0c\x77\x37\x18\x8c\xbf\xe0\xff\xa3\xf1\x75\x17\xa4\x78\x4e\x0e\xf4\x6c\x5d\xc8\xdd\x38\xa8\xd0\x3a\x2a\x7a\x49\x46\xb4\x17\xbc\x34\xcd\xb4\xbc\xa5\x5c\x95\x2a\x47\x25\xf5\xac\x96\x33\xc2\xcc\x3c\x91\xd3\x4a\xcf\x60\xc9\x2d\x94\x0f\xc3\xf3\x90\xba\x48\x2a\xb5\x50\x2c\x39\x9e\x5f\x94\xd3\x4b\x4e\xfa\xc9\x2b\x55\xea\x82\x4a\x88\x6c\x38\x1d\x4c\xa7\x13\xf1\x5e\x24\xaf\x0a\x2a\x95\xa6\x3d\x5b\xd2\xef\x83\x9d\xcc\x49\x78\xca\x6b\xa7\x78\x25\x94\x36\x45\x38\xb2\x64\x76\x38\xc5\x34\x5c\x7b\xc8\x86\x0d\x7e\xe5\x99\x16\xd8\x5c\xd3\x13\x69\xee\x05\x94\xff\xe5\xfc\xfc\x1a\x8e\x66\xca\x33\x39\x8f\xdc\x68\xa6\x67\x8e\x57\x05\x69\x76\xab\xd7\xb0\x46\x69\x0e\xcd\x37\xb1\xe4\xc6\xbc\xe1\x48\x80\x8f\xde\x21\xee\x0b\x5f\x15\xac\xf0\xec\xea\x9c\x6b\x17\x58\x69\x2b\xa9\x62\x77\x96\x73\xc9\x98\x4b\x6b\x49\x37\x14\xd8\xa2\x09\x11\x5e\xc5\xb9\x0b\x91\xa6\xb2\x5a\xca\x95\x17\x4a\x57\x4a\x53\xa7\x03\x1f\x7a\x98\x43\x69\xde\xf6\x20\x56\x95\x36\x61\x60\x59\x38\x9a\x79\x9c\xe4\xfc\xdc\x5d\xc7\xde\x24\x7d\xd2\xfc\xdf\x9a\x9b\xfc\x4e\xc2\xb7\x93\xfc\x14\x49\x5e\xff\xfb\x1c\xb9\xad\x71\x89\x07\x5b\x8a\x19\xb1\xf0\x0b\x2b\xac\x33\x39\x79\x6f\x9c\x50\x45\xda\xb9\x88\x9e\x7b\xd0\x22\x0f\x0b\x21\x18\x27\x85\x64\xd9\x9c\xd6\x18\x0b\x69\x45\x65\xcc\x63\x6d\x05\x55\xb4\x48\x8f\xf7\x5e\x3c\xd4\xaa\x2a\xc8\x75\x71\x9c\xdb\x7a\x8d\xac\x4a\xa4\xff\x6a\x71\x3a\xd1\x18\xfe\x1c\x71\xed\x34\xce\x1a\xb7\x7e\x1f\x13\xf2\xc4\xb0\x92\xe7\x5a\x2e\x08\x8f\xb4\xf2\x48\x73\x53\x57\x05\x16\x81\xc3\xb5\x85\x23\x6f\xaa\x3a\xd0\x0e\x2a\xce\xcc\x11\x96\xd2\xc3\x9b\x05\xa1\xa2\x92\x03\x61\x11\xa2\x35\x71\xda\xb8\xa7\x6f\x4a\x2f\x1a\xae\x78\x97\x8b\x10\x45\x3c\xd2\x0a\x97\x9b\x04\x0e\xba\xb2\x74\xa1\x6b\x7f\xec\x9d\xd7\xce\x1b\xd7\xde\xf5\xfb\xf8\xe8\xcc\x03\x81\x57\x96\xbe\x8d\x1d\xbf\xb8\xc4\xf0\xd3\x70\x3c\xdd\x6c\xc4\x45\xb2\x03\x11\xa6\x14\xdf\x46\x5b\xfd\xdf\xff\x84\x9e\xe0\x32\xac\x41\xb5\x99\xa2\x08\xf7\xe9\xf1\xc1\x08\xbb\x2e\x9d\x16\x39\x23\x1e\x04\xaa\x6c\x91\x03\x39\x6c\xc8\x58\x38\x92\xc5\x37\xc0\xca\x4a\xce\x7c\x17\x5e\xfd\x48\xa6\x4c\x03\xcb\x4e\xdf\x28\x29\x9e\x64\xa5\x8a\x4e\x17\xc7\xbf\xb3\x5c\xfc\x75\xe4\x85\x29\xe8\x25\x70\xb0\xee\xe1\x46\x43\x5b\xc5\xa0\x28\x10\x85\xa2\xad\xe3\x20\x7a\x98\x76\xe3\x77\x89\x30\xcb\x35\x61\x95\x36\x69\xf3\xb3\x73\xb1\x0b\xb9\x30\xb5\x66\x8c\xae\x77\xb7\xa2\x79\x7e\x72\x18\xa5\xa0\x7d\x9c\x6f\x26\x11\x91\x85\x2a\xd6\x08\x11\x6d\x6b\x4c\xe3\x71\x67\x48\xd7\x11\x13\x91\x34\x2f\x70\x43\x4d\x6b\x15\xb8\x5c\xef\x6c\xfb\xf2\x46\x55\x4c\xae\xdd\xbd\x32\x9e\xd3\xf6\x7d\x17\x37\xa3\xdb\xe9\x70\x22\xb2\xc9\x55\xe7\xd0\x3e\x6e\x90\x32\xd2\x51\xcd\xda\x24\x36\x12\x50\xdb\x42\x32\x1d\x90\x80\x2e\x8e\x1f\x69\xd5\xdd\xc9\xb6\x8b\xb7\x1f\x6f\xc4\x60\xfc\x65\xdd\x9f\x36\xcc\xd7\x3d\xed\x8f\xba\x27\x1c\x71\x2b\xf8\x41\x45\xd7\xee\xa6\xfc\x6e\xf9\xff\x4e\x09\x0e\xa9\x1c\x92\xe1\xad\xb6\x6e\xd7\x71\xa3\xad\x79\xed\x1c\x69\x16\x56\x15\x82\x67\xff\xb0\xb6\x36\x8d\xfd\x7b\x9a\x7a\x90\x8a\x8e\xf8\x49\x56\xb8\xc4\xc7\xa9\x98\x0c\xdf\x65\x62\x72\x95\xe6\xfc\xbc\x43\xbd\x49\x10\xd9\x27\x8a\x4a\xec\xd7\x33\x8b\x96\xa8\x9a\x3e\x78\xef\x0f\x78\x32\xcc\xee\x6e\x3f\x0d\x03\x9f\xf0\x33\x86\x1f\x46\x53\x11\x45\xad\x73\xb1\x47\x9a\x82\x2a\x62\xfa\xb3\xda\xda\x12\xbe\x26\xc9\x2b\xd2\x85\x2a\x93\xdf\x06\x00\x74\xcc\xb5\xae\x84\x0a\x00\x00")
+
+func bindataEventsSetattrHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataEventsSetattrH,
+		"/events/setattr.h",
+	)
+}
+
+
+
+func bindataEventsSetattrH() (*asset, error) {
+	bytes, err := bindataEventsSetattrHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/events/setattr.h",
+		size: 2692,
+		md5checksum: "",
+		mode: os.FileMode(436),
+		modTime: time.Unix(1677602370, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataEventsSymlinkH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\xfd\x6e\x1b\xb9\x11\xff\x7f\x9f\x62\x2e\x01\xdc\x95\x6f\x2d\xb9\xbe\xa2\x7f\xc4\x55\x50\xc5\x96\x2f\xc2\xd9\xb2\x21\xc9\xb9\xba\x45\x41\xd0\xbb\xb3\x12\xe1\x15\xb9\x25\x67\x2d\xab\x87\x3c\x50\x5f\xa3\x4f\x56\x90\xcb\xfd\x92\xe4\xcb\x07\x0a\x04\x49\xc4\x1d\xce\xfc\xe6\xe3\x37\x33\x1c\x1c\x07\x17\x2a\xdf\x6a\xb1\x5c\x11\xfc\xf7\x3f\x70\x76\x7a\x76\x0a\x3f\xdf\x4f\xae\xaf\x47\xf7\x37\x63\xb8\xba\xbd\x9f\x4d\x27\xe3\x59\x10\x5c\x8b\x18\xa5\xc1\x04\x0a\x99\xa0\x06\x5a\x21\x8c\x72\x1e\xaf\x10\xfc\x97\x08\x3e\xa1\x36\x42\x49\x38\xeb\x9f\x42\x68\x05\xde\xf8\x4f\x6f\x7a\xe7\xc1\x56\x15\xb0\xe6\x5b\x90\x8a\xa0\x30\x08\xb4\x12\x06\x52\x91\x21\xe0\x4b\x8c\x39\x81\x90\x10\xab\x75\x9e\x09\x2e\x63\x84\x8d\xa0\x15\x50\xa3\xbd\x1f\x3c\x78\x05\xea\x91\xb8\x90\xc0\x21\x56\xf9\x16\x54\xda\x96\x02\x4e\x41\x00\x00\xb0\x22\xca\xdf\x0d\x06\x9b\xcd\xa6\xcf\x1d\xca\xbe\xd2\xcb\x41\x56\x4a\x99\xc1\xf5\xe4\x62\x3c\x9d\x8f\x4f\xce\xfa\xa7\x41\x70\x2f\x33\x34\x06\x34\xfe\xab\x10\x1a\x13\x78\xdc\x02\xcf\xf3\x4c\xc4\xfc\x31\x43\xc8\xf8\x06\x94\x06\xbe\xd4\x88\x09\x90\xb2\x38\x37\x5a\x90\x90\xcb\x08\x8c\x4a\x69\xc3\x35\x06\x89\x30\xa4\xc5\x63\x41\x9d\x00\x55\xa8\x84\x81\xb6\x80\x92\xc0\x25\xbc\x19\xcd\x61\x32\x7f\x03\x1f\x46\xf3\xc9\x3c\x0a\x7e\x9d\x2c\x3e\xde\xde\x2f\xe0\xd7\xd1\x6c\x36\x9a\x2e\x26\xe3\x39\xdc\xce\xe0\xe2\x76\x7a\x39\x59\x4c\x6e\xa7\x73\xb8\xbd\x82\xd1\xf4\x01\x7e\x99\x4c\x2f\x23\x40\x41\x2b\xd4\x80\x2f\xb9\xb6\xd8\x95\x06\x61\x43\x87\x49\x3f\x98\x23\x76\x8c\xa7\xaa\xcc\x96\xc9\x31\x16\xa9\x88\x21\xe3\x72\x59\xf0\x25\xc2\x52\x3d\xa3\x96\x42\x2e\x21\x47\xbd\x16\xc6\x26\xcf\x00\x97\x49\x90\x89\xb5\x20\x4e\xee\xf7\x9e\x3b\xfd\xe0\x78\x10\xbc\x15\xa9\x4c\x30\x05\x36\x7f\xb8\xb9\x9e\x4c\x7f\x61\x1f\x59\xf0\x36\xc1\x54\x48\xec\x9c\x05\x83\x01\x90\xe6\x31\x32\xb3\x5d\x67\x42\x3e\xc1\x09\x2c\xec\x6f\x03\xbc\x4c\xbf\xd9\x1a\xc2\x35\x54\x9f\xf1\x19\x25\xf5\xed\xb5\xbf\xc6\xf4\xf2\x0e\x34\x2e\x85\x21\xd4\x06\x62\x25\x09\x5f\xc8\x7d\x4a\x84\x7e\x07\xb9\x12\x92\x6c\xa8\x95\x73\x50\x48\x95\x60\x55\x10\x56\x98\x0b\xe7\x5c\x22\x34\xc6\xa4\xf4\xb6\xbc\x89\x92\xf4\x76\xef\x72\x79\x0c\x86\x74\x11\x53\xa1\x6b\x3d\x12\x37\x15\x34\x77\x5d\x65\x89\xe4\x6b\xdc\xbb\x6f\x05\x80\xb8\x5e\x22\x59\x25\x42\x2e\x03\xc6\x38\xf9\x9c\x33\x16\x86\x3c\xdb\xf0\xad\x61\x42\x66\x42\x62\xaf\x07\xc6\x06\x38\x06\x21\xa9\x1b\xa0\xb0\xc4\x00\x39\x31\x8d\x4b\x03\xc7\x31\xbd\x44\x1e\x98\xf7\xf1\x38\x11\xba\x3e\xf2\xc8\x8f\xcb\x7f\x23\x1b\x26\x43\x10\xaf\xb8\x86\x63\x8f\xb6\x17\xfc\xe6\x58\x51\xfc\x74\x06\x71\x5e\xc0\x10\x1e\xf3\x94\x2d\x91\x98\x59\xe7\x2c\xd7\x2a\x46\x63\x94\x66\x22\x09\x7b\xe7\x4e\xb2\xa3\x9b\xc5\x96\x41\x8c\xe0\x38\xe1\xc4\xcb\x5f\x5e\xc7\x9a\xe7\x2c\x53\xea\xa9\xc8\x19\x66\xb8\x0e\x8f\x3a\x37\x1e\x0b\x91\x25\xa8\x23\x38\x8a\xf3\xc2\x6b\x16\x29\x84\x3f\x34\x7a\x7a\xee\xd0\xfe\xd1\x48\x85\x96\x70\x5a\x8a\x0d\x06\x30\x43\x83\x04\x39\xa7\x95\x8d\x38\x3c\xe1\xd6\x40\x18\xab\x22\x4b\x60\x6d\x8b\xbe\xc8\x41\xa3\x51\x59\x61\xeb\x14\x84\xcb\xbb\x46\xd8\x70\x03\x46\xad\x11\x32\x4c\xc9\x56\x38\x58\x6b\xa5\x9d\xc6\xee\xc9\xfb\xd4\xb0\xb2\xd6\x8c\x8e\x99\xb5\xc2\x9e\x70\x0b\xc3\x0a\xc0\x41\xd1\x32\xc1\x5f\x90\x8e\x0b\x6d\x94\x6e\xbe\x0d\x06\x30\x4a\x12\xf0\x61\x76\xa2\xee\x4e\xf1\xe7\x3f\x59\xa7\x60\x68\x79\x90\x55\x69\x60\xf6\x7b\x78\x74\xd0\x7c\x5b\xc4\x87\x73\x30\x80\x3b\xad\x1e\x11\x68\x9b\xe3\xeb\xc0\xdd\xdf\x30\x84\xf1\xa7\xf1\x74\x51\xf1\xf3\x3c\xa8\x54\xdc\xa8\x42\x12\x4c\x2e\x5f\x57\x60\x83\xb4\xb6\x52\x4c\x24\x30\x04\x5b\x3b\xae\x16\xeb\xc3\x30\x11\xba\xd7\x68\x5c\xec\x70\x42\x18\xf9\x07\x02\xee\x2b\x2a\x02\x41\x20\x0c\x18\x8b\x8a\xa7\xe4\x1b\x8c\x8d\xab\xa9\x98\xe7\xec\x7e\x31\x15\x0e\xc4\xe1\x3c\xec\x8a\xb6\xe0\x1f\x90\xf6\xec\x63\x1e\xef\x10\x3c\x77\x1a\x97\x2e\x1d\xf4\x26\x7f\x9d\xdb\x3a\x66\x9e\x88\x43\xef\x63\x73\xf1\x4a\x64\x84\xba\xa9\xfe\xd4\xfd\x0e\x9b\xfb\x11\x5c\x4d\xae\x17\xe3\x19\x9b\xcf\x2e\x7a\x87\x18\x51\x69\x9a\xa3\x74\x33\xc8\x61\x76\x87\x15\x09\x8b\x3c\xe1\x84\x07\x48\x18\xc1\xd1\x13\x6e\xa3\x16\xda\x08\x3e\xdc\x5d\xb1\xd1\xf4\xc1\x97\x50\x63\xe6\x73\x60\x7b\x1c\xae\x05\x55\xcd\xa8\x8c\x20\x9c\xc0\x28\xcf\x51\x26\x66\xaf\xd7\xa9\x14\x78\xb7\x77\x57\x1d\x71\x3f\x99\x91\x9d\x2d\x60\x6a\x3d\x4e\x77\x1f\x6e\x65\xe6\x5a\xb3\xbb\x84\x3a\x85\xc7\x22\x4d\x51\xb7\xc9\xbd\xb6\x49\x6e\x2e\x96\xaa\x79\xa6\xe4\xb2\x59\x14\xbc\x09\xfb\x5f\x65\x3b\x81\xbb\xe4\x4a\x2c\xd9\x43\xed\xd6\x1d\x6b\xb3\xa9\x3d\x77\xbd\xd1\x56\xf9\xef\x5b\x18\xac\x79\xde\x1a\x4a\x3b\xcd\x7f\x6f\x46\xf9\xde\x6c\xe7\x48\x61\x77\x26\x52\x0d\x0e\xe7\x62\x7b\xca\xd9\x5c\xee\xa9\xec\xf4\xd1\xb6\x3e\x95\xb6\xf1\x79\x96\x7c\xfd\xa8\xd9\x4f\xee\xef\xce\x9b\x1d\x18\xc7\xbe\x67\x7f\xeb\x38\xb1\x96\x63\x95\x0b\x6c\x91\xcf\x0e\x82\x4c\xf1\xc4\xf3\x86\x35\xe9\x66\x36\x73\x61\x0f\x7e\x18\xc2\xe5\x78\xba\x98\x3d\xb0\xd9\x78\x7e\x7b\x7d\x6f\xf7\x20\x76\x37\x9e\x5d\xb1\x0f\xf7\x57\x57\xe3\x59\x0f\x7e\xab\xb9\x62\xb1\x74\x3a\x72\x6b\x8a\x75\xdc\xb5\x5e\xf8\x83\xd7\x66\xd8\x4e\xee\x4b\x06\x79\x4f\x6a\x12\x7b\xa1\x86\xad\x5d\x2a\x55\x27\xb5\xd7\x36\x44\xb9\xed\xd4\x4c\x23\x4f\x98\x21\x5d\x1b\x3a\x79\x5f\x96\x65\x04\xd3\xd1\xcd\x98\xdd\x8c\xfe\x16\x41\xf8\xac\x44\x02\xc7\x3d\x38\xd8\x9e\x76\xc0\x78\x23\x7f\x81\xd3\x2e\x9c\xda\x78\x1b\xd0\xe1\xe6\x68\x29\xc5\x32\x94\x4b\x5a\xc1\xb0\xba\x78\x54\x03\x6a\xee\x77\xfd\x68\xf9\x80\xcf\x14\x81\x11\xff\x46\x95\x86\x3b\x46\x7a\x76\x03\xd8\x39\xda\xd1\x88\x3a\xf5\xf9\x51\x05\xe5\x05\x85\x6e\xeb\x39\xaa\xc4\x4d\x64\x17\x97\xa8\x2a\xfb\xda\x90\xcf\x70\x25\xc6\xa8\x07\x3f\x42\xb8\x07\xbf\xd7\x0a\x58\x37\x47\x9f\x83\xf6\xbe\x53\xeb\xd9\x68\x9e\xe7\xa8\x6d\xb1\xb8\xc8\x54\x6d\xe0\x95\x8a\xb1\x32\xa6\x55\x2f\x3b\xce\x76\x06\x77\x9f\x44\xe2\xe1\xb8\x42\x6a\xeb\xef\xbd\x82\xd2\x56\xb7\x4a\x53\xbb\x0f\xd5\xc9\xdc\x4d\x44\xf9\xbd\x8e\x4c\xf1\xd3\x59\x2b\xec\xe5\x4e\xd2\x3b\x7f\x7d\x3c\x03\xe9\x42\xc6\xdc\xbe\x4f\x48\xd5\x81\x83\xc7\x2d\xa1\x89\xc0\x20\x82\x73\xcf\xed\x3e\xf5\x9b\x62\xcd\xcd\x53\xf7\xe5\x64\x3b\xdc\x33\x6a\x91\x0a\x3f\xf1\x7e\x97\x00\x6d\xdf\x4f\xde\xdb\xbe\xff\x0f\xef\xe6\x11\x84\x77\xa3\xc5\x47\xcf\x74\x36\x9f\xfc\x7d\x0c\x27\x0d\xac\x13\xf8\x63\xef\x9f\xdf\xcc\x97\xd7\xb8\x52\x63\xf4\xa1\xdd\x4d\xdf\xb7\x70\xa4\xbb\x04\xfe\x38\xfc\x0a\x65\xe7\xc1\xc1\x84\x76\x83\xf3\x5d\xf4\xfa\x16\x6a\xb5\xcd\x7d\x89\x5f\x1d\x1f\xbf\x22\x55\x15\xff\x9a\xaa\xfe\xbc\xff\x32\x64\x1a\xa9\x79\x1d\xda\x42\xf2\xe2\x2a\xfd\xee\xb7\xe2\x77\xbe\xc1\x2c\x94\x43\x73\xb1\x9e\x7c\xf5\xea\x5e\x4d\xbe\xb8\xd0\xda\x4e\x97\x5c\x24\x8c\x96\xff\xe7\x87\x54\x67\x02\x7d\xe5\x03\xaa\x91\x68\xaa\xa2\xaf\x91\x9e\x79\x06\x43\xb8\x5b\xb0\xd9\xf8\xe7\x39\x9b\x5d\xd8\x46\xdb\xda\xdb\xed\x4b\xa5\xdc\xa9\x0f\xee\xb9\xb5\x26\xfb\x1e\xa8\x76\x6f\xeb\xbf\x87\x2b\xa4\x0a\x0f\xef\xc5\x2d\x1b\x33\x3b\xe9\x9f\xb1\x5e\xe5\x0e\xbc\xb5\x9b\x55\xd1\xbe\x14\x5a\x9b\x9e\x20\xd3\x6e\x5c\xbe\xaa\x9c\x3e\xc7\x4f\x63\xfd\xe9\x6e\xbb\x6e\x79\xf8\x34\x76\xcb\x75\x99\x94\x03\x4b\xd0\xce\xad\x0a\x6d\x95\x9a\x04\x33\x24\xfc\x52\x6a\x9a\x0c\x7c\x0e\x82\xb7\x28\x13\x91\x06\xff\x1b\x00\x40\x8c\xf7\x8d\x61\x13\x00\x00")
+
+func bindataEventsSymlinkHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataEventsSymlinkH,
+		"/events/symlink.h",
+	)
+}
+
+
+
+func bindataEventsSymlinkH() (*asset, error) {
+	bytes, err := bindataEventsSymlinkHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/events/symlink.h",
+		size: 4961,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792222729, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataEventsUnlinkH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\xe1\x6e\x1a\x47\x17\xfd\xbf\x4f\x71\x3e\x47\xb2\x16\x8b\xb0\x96\xbf\xaa\x3f\x62\x51\x95\xd8\x38\x59\x85\x40\x04\x38\x91\x7f\x8d\x86\xdd\xbb\x30\xf2\x32\xb3\x9d\xb9\x6b\x58\xb5\x79\xa0\xbe\x46\x9f\xac\x9a\xdd\xc5\x40\x4d\x53\x35\xaa\x84\x40\x33\x73\xe7\xdc\x73\xef\x3d\x73\x88\x2e\x82\x1b\x53\x54\x56\x2d\x57\x8c\x3f\x7e\xc7\xd5\xe5\xd5\x25\xde\xdd\xc7\xa3\xd1\xe0\xfe\xe3\x10\x77\x93\xfb\xe9\x38\x1e\x4e\x83\x60\xa4\x12\xd2\x8e\x52\x94\x3a\x25\x0b\x5e\x11\x06\x85\x4c\x56\x84\xf6\xa4\x8b\xcf\x64\x9d\x32\x1a\x57\xbd\x4b\x84\x3e\xe0\xac\x3d\x3a\xeb\x5c\x07\x95\x29\xb1\x96\x15\xb4\x61\x94\x8e\xc0\x2b\xe5\x90\xa9\x9c\x40\xdb\x84\x0a\x86\xd2\x48\xcc\xba\xc8\x95\xd4\x09\x61\xa3\x78\x05\xde\xa3\xf7\x82\x87\x16\xc0\x2c\x58\x2a\x0d\x89\xc4\x14\x15\x4c\x76\x18\x05\xc9\x41\x00\x00\x2b\xe6\xe2\x4d\x14\x6d\x36\x9b\x9e\xac\x59\xf6\x8c\x5d\x46\x79\x13\xe5\xa2\x51\x7c\x33\x1c\xcf\x86\xaf\xaf\x7a\x97\x41\x70\xaf\x73\x72\x0e\x96\x7e\x29\x95\xa5\x14\x8b\x0a\xb2\x28\x72\x95\xc8\x45\x4e\xc8\xe5\x06\xc6\x42\x2e\x2d\x51\x0a\x36\x9e\xe7\xc6\x2a\x56\x7a\xd9\x85\x33\x19\x6f\xa4\xa5\x20\x55\x8e\xad\x5a\x94\x7c\xd4\xa0\x1d\x2b\xe5\x70\x18\x60\x34\xa4\xc6\xd9\x60\x86\x78\x76\x86\xb7\x83\x59\x3c\xeb\x06\x5f\xe2\xf9\xfb\xc9\xfd\x1c\x5f\x06\xd3\xe9\x60\x3c\x8f\x87\x33\x4c\xa6\xb8\x99\x8c\x6f\xe3\x79\x3c\x19\xcf\x30\xb9\xc3\x60\xfc\x80\x0f\xf1\xf8\xb6\x0b\x52\xbc\x22\x0b\xda\x16\xd6\x73\x37\x16\xca\xb7\x8e\xd2\x5e\x30\x23\x3a\x4a\x9e\x99\x66\x5a\xae\xa0\x44\x65\x2a\x41\x2e\xf5\xb2\x94\x4b\xc2\xd2\x3c\x91\xd5\x4a\x2f\x51\x90\x5d\x2b\xe7\x87\xe7\x20\x75\x1a\xe4\x6a\xad\x58\x72\xbd\x7e\x51\x4e\x2f\xb8\x88\x82\x57\x2a\xd3\x29\x65\x10\xf7\xe3\x51\x3c\xfe\x20\xde\x8b\xe0\x55\x4a\x99\xd2\x74\xb8\x15\x44\x11\xd8\xca\x84\x44\xa9\x73\xa5\x1f\xf1\x1a\x73\xbf\x74\x90\xcd\xec\x5d\xe5\x98\xd6\x68\x4f\xe9\x89\x34\xf7\xfc\xa5\x9f\x13\xde\xbe\x81\xa5\xa5\x72\x4c\xd6\x21\x31\x9a\x69\xcb\xf5\x51\xaa\xec\x1b\x14\x46\x69\xf6\x6d\x36\x75\x71\x4a\x9b\x94\xe0\xd8\x96\x09\x97\x96\x76\xb2\x48\x95\xa5\x84\x8d\xad\x6a\x00\xa9\xea\x62\xfd\x41\x9d\x9c\x0d\x52\xca\x89\xa9\x81\x25\xcd\xb6\x7a\x81\xdc\x6c\xbf\x84\xfe\x0b\x82\x10\x92\xdb\x01\x0b\x11\x86\x32\xdf\xc8\xca\x09\xe5\xeb\xa2\x4e\x07\xce\x77\x33\x81\xd2\x7c\xd4\x8f\xb0\x81\x45\xc1\xc2\xd2\xd2\xe1\x22\xe1\x6d\xb7\xcd\xd5\xd6\x74\x91\x2a\xfb\xbc\xd5\x92\xb9\x68\x7e\x3b\xc1\xaf\xb5\xd8\xcb\xff\x5f\x21\x29\x4a\xf4\xb1\x28\x32\xb1\x24\x16\x6e\x5d\x88\xc2\x9a\x84\x9c\x33\x56\xa8\x34\xec\x5c\xd7\x91\x47\x30\x22\xf1\x0f\x43\x30\x2e\x52\xc9\xb2\x59\xb5\x18\x6b\x59\x88\xdc\x98\xc7\xb2\x10\x94\xd3\x3a\x3c\x3f\xba\xb1\x28\x55\x9e\x92\xed\xe2\x3c\x29\xca\x16\x59\x65\x08\xff\xb7\xc7\xe9\xd4\x9b\xfe\x63\x89\x4b\xab\x71\xd9\x84\x45\x11\xa6\xe4\x88\x51\x48\x5e\x69\xb9\x26\x3c\x52\xe5\x10\x26\xa6\xcc\x53\xac\xbd\x96\xcb\x02\x96\x9c\xc9\x4b\x2f\x3f\xa8\xba\xdb\x96\xb0\x91\x0e\xce\xac\x09\x39\x65\xec\x85\x0b\x9f\xad\xc9\xb3\xcf\xfb\xfa\xa7\xcc\x89\x46\x46\xce\x26\xc2\x67\x11\x8f\x54\xa1\xbf\x23\x70\x32\x94\xa5\xf5\x5d\xfb\x76\x74\x52\x5a\x67\xec\xfe\x2c\x8a\x30\x48\x53\xb4\x6d\xae\xd9\xd4\x64\xca\x1f\x7f\xf0\x45\xa1\xef\x15\x9e\xef\xc6\x20\xfc\x79\x78\x7e\x32\xfd\x61\x48\xdb\xce\x28\xc2\x27\x6b\x16\x04\xae\x0a\xfa\x7b\xe2\xf5\x37\xfa\x18\x7e\x1e\x8e\xe7\xed\xbb\xbb\x0e\x0e\xe9\x35\x22\x7a\x26\x77\x12\xc5\x77\xaa\x89\xeb\xc3\xf7\xa1\x1d\xb6\xd2\x26\x6c\x85\x76\x54\xf1\xda\x94\x9a\x11\xdf\x7e\x1b\xb0\x8e\x12\x2a\x6d\x31\x6b\xfc\xe7\xcd\x30\x55\xb6\xb3\x27\x7a\x5b\x67\x41\x0d\xf3\x02\xd5\x83\xb5\xba\xef\xb7\xca\xdd\xdf\xbc\x53\x39\x93\xdd\x2b\x30\xab\xd7\xe1\xfe\x7e\x17\x77\xf1\x68\x3e\x9c\x8a\xd9\xf4\xa6\x73\x4a\x95\x3b\xa4\x19\xe9\xda\xde\xf7\x24\x76\x0f\xa1\x2c\x52\xc9\x74\xe2\x21\x74\x71\xfe\x48\x55\xf7\x80\x6d\x17\x6f\x3f\xdd\x89\xc1\xf8\xa1\x1d\xe3\x3e\xcd\xd7\x17\x5e\x28\x2c\xf1\xde\x0f\xbd\xa5\xb4\xd1\x26\xfb\x5e\x77\xfc\x3e\x13\xf2\x44\x4e\x19\xd1\xb3\xbd\x3c\x0b\x7a\x67\x2f\x49\x69\x2d\x69\x16\x85\x4a\x05\x2f\xff\x63\x7b\x69\xba\xfa\xef\x6c\xe5\xa4\x0a\x2d\xf1\x93\xcc\xd1\xc7\xa7\xb9\x98\x0e\xdf\xcd\xc4\xf4\x26\x4c\x78\x7b\xa0\xbb\xa9\xf7\x99\x27\xaa\xcd\xc8\xb5\x03\xab\x77\x6a\xe3\x70\x3e\xfa\x78\xba\xd3\xe1\x6c\x32\xfa\x3c\xf4\x62\xc2\x6f\x18\x7e\x8c\xe7\xa2\x7e\x7a\x9d\xeb\x23\xc5\x34\xff\x09\xff\x54\xdb\xbe\x84\xaf\x41\xf0\x8a\x74\xaa\xb2\xe0\xcf\x01\x00\x01\x20\x71\x47\x8f\x09\x00\x00")
+
+func bindataEventsUnlinkHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataEventsUnlinkH,
+		"/events/unlink.h",
+	)
+}
+
+
+
+func bindataEventsUnlinkH() (*asset, error) {
+	bytes, err := bindataEventsUnlinkHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/events/unlink.h",
+		size: 2447,
+		md5checksum: "",
+		mode: os.FileMode(436),
+		modTime: time.Unix(1677602370, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataFilterH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\xdd\x6e\xdb\x38\x16\xbe\xd7\x53\x9c\xb6\x80\x57\x0e\x1c\x2b\xc9\x2e\x16\x83\x7a\x52\xd4\xeb\xa4\x19\x63\xdc\xb8\xf0\xcf\x16\x73\x25\x30\xe2\x91\x4c\x58\x26\x35\x24\x15\xc7\x3b\x93\x07\xda\xd7\xd8\x27\x5b\x50\x22\x65\xc9\x56\xda\x24\x05\x06\x01\x14\x90\xe7\xff\x3b\x9f\x0e\x29\x07\x27\xde\x48\x64\x3b\xc9\x92\x95\x86\xff\xfd\x17\x2e\xce\x2e\xce\xe0\x66\x39\x9e\x4c\x86\xcb\xcf\xd7\xf0\x69\xba\x9c\xdd\x8e\xaf\x67\x9e\x37\x61\x11\x72\x85\x14\x72\x4e\x51\x82\x5e\x21\x0c\x33\x12\xad\x10\xac\xa4\x07\xff\x46\xa9\x98\xe0\x70\xd1\x3f\x03\xdf\x28\xbc\xb5\xa2\xb7\xdd\x81\xb7\x13\x39\x6c\xc8\x0e\xb8\xd0\x90\x2b\x04\xbd\x62\x0a\x62\x96\x22\xe0\x43\x84\x99\x06\xc6\x21\x12\x9b\x2c\x65\x84\x47\x08\x5b\xa6\x57\xa0\xf7\xde\xfb\xde\x6f\xd6\x81\xb8\xd3\x84\x71\x20\x10\x89\x6c\x07\x22\xae\x6b\x01\xd1\x9e\x07\x00\xb0\xd2\x3a\x7b\x1f\x04\xdb\xed\xb6\x4f\x8a\x2c\xfb\x42\x26\x41\x5a\x6a\xa9\x60\x32\x1e\x5d\xdf\xce\xaf\x4f\x2f\xfa\x67\x9e\xb7\xe4\x29\x2a\x05\x12\x7f\xcf\x99\x44\x0a\x77\x3b\x20\x59\x96\xb2\x88\xdc\xa5\x08\x29\xd9\x82\x90\x40\x12\x89\x48\x41\x0b\x93\xe7\x56\x32\xcd\x78\xd2\x03\x25\x62\xbd\x25\x12\x3d\xca\x94\x96\xec\x2e\xd7\x0d\x80\x5c\x56\x4c\x41\x5d\x41\x70\x20\x1c\xde\x0e\xe7\x30\x9e\xbf\x85\x7f\x0d\xe7\xe3\x79\xcf\xfb\x3a\x5e\xfc\x32\x5d\x2e\xe0\xeb\x70\x36\x1b\xde\x2e\xc6\xd7\x73\x98\xce\x60\x34\xbd\xbd\x1a\x2f\xc6\xd3\xdb\x39\x4c\x3f\xc1\xf0\xf6\x37\xf8\x75\x7c\x7b\xd5\x03\x64\x7a\x85\x12\xf0\x21\x93\x26\x77\x21\x81\x19\xe8\x90\xf6\xbd\x39\x62\x23\x78\x2c\xca\x6e\xa9\x0c\x23\x16\xb3\x08\x52\xc2\x93\x9c\x24\x08\x89\xb8\x47\xc9\x19\x4f\x20\x43\xb9\x61\xca\x34\x4f\x01\xe1\xd4\x4b\xd9\x86\x69\xa2\x8b\xf5\x51\x39\x7d\xef\x24\xf0\xde\xb1\x98\x53\x8c\x21\xfc\x34\x9e\x2c\xae\x67\xe1\x2f\xa1\xf7\x8e\x62\xcc\x38\xd6\xb7\xaa\x3d\xbb\x35\x9f\x8d\x4c\x77\xe0\x1c\x7e\xfe\x19\xce\x0f\xa5\x8b\xe1\xec\xe6\x7a\x61\xa5\x17\x9e\x17\x04\x86\x20\x1a\x65\x48\x91\x6b\xb9\x83\x53\x98\x08\xb1\x56\x55\x49\x99\x14\xf7\x8c\x22\x85\x52\xde\x2b\x70\xd0\x0a\x32\x22\x91\xeb\x9e\x69\x95\xc9\x9b\x71\x41\x51\x85\xa5\x2f\xd8\x90\xcc\x78\xfe\x58\xda\xbc\x87\x4c\x30\xae\x4d\xc3\x44\xe1\xd3\x86\xd2\x02\x52\x21\xd6\x26\x54\xa1\xbd\xc6\x63\xd5\xa6\xdf\x35\xee\x7a\x90\x49\x8c\x59\x9a\x22\xdd\x13\xb8\xd0\x32\xa8\xc2\x46\xe4\x5c\xc3\xf8\xca\x91\xb6\x0c\xe5\x85\x21\xd1\x96\x1b\x61\xe8\xfb\x24\xdd\x92\x9d\x0a\x19\x4f\x19\xc7\x6e\x17\x94\x69\x44\x04\x8c\xeb\x26\x1a\xbe\xd2\x32\x8f\xb4\xf5\x02\x27\x0e\x03\xbb\x9d\x11\xbd\x0a\xd7\xb8\x0b\x35\x9c\xac\x71\xd7\xf5\xfe\x28\x5e\x8c\x20\x28\x30\xac\x20\x2c\xb3\x7b\x12\x28\x63\xc2\x62\xf0\xef\xb2\x38\xdc\x90\x2c\x34\x98\xe4\x59\x88\x29\x6e\xfc\x4e\x43\xbd\x07\x26\x0a\x5c\x5e\xc2\xed\x72\x32\xe9\x42\x19\xae\x2d\x64\xd9\x9d\x32\x58\xa5\x74\x58\x4c\x58\x6a\x0d\x2a\x05\x93\x41\x26\xc5\x1d\x86\x12\x09\xf5\x3b\x4e\xa3\x07\x8a\xfd\x07\x45\xec\xbb\x8d\x6e\x0f\x3a\x25\x16\xa7\x1f\xaa\xbd\xbd\x9f\x35\xee\x4e\x3f\x30\x2e\xe0\x12\x12\xd4\x16\xcb\x90\x71\xe1\xb7\x28\xff\x68\xe9\xe6\x4f\xa2\xce\x25\x87\xb3\xbd\xd7\x47\x6f\xff\xb4\xd2\xf3\x81\xf7\xe8\xbd\x9c\x09\x4a\x46\x4d\x1a\x84\x91\x19\x76\xa6\xe7\x94\x68\x52\xae\x5c\xeb\x8f\x89\xb1\xc6\x1d\x5c\xc2\x1f\x8f\x65\x6a\x6b\xdc\xf5\x4b\x60\xf6\xb6\xa7\x1f\x62\x15\xe2\x3d\x72\xdd\x57\x32\x32\x30\x51\xdc\x6b\x17\x84\x0e\x19\xfd\x96\x89\xd3\x19\xd4\xcb\x6d\x12\xb9\x6e\x6b\x4c\x1c\x93\x3b\x06\xd6\xd7\x01\xa3\x89\x4c\x50\xff\x65\xd8\x94\xe1\x5e\x0a\x8f\xb5\x7a\x21\x42\xd6\xea\x18\xa4\x20\x00\xa6\x42\x7c\x88\xd2\x9c\x22\xb5\xa6\x70\x0a\xb3\x82\x62\x0a\xce\x81\x95\x73\x87\x93\x0d\xba\x19\x74\x30\x42\x81\x29\x37\x0c\x2a\x47\x46\x5d\x3d\x7f\x6c\x46\x2b\x8c\xd6\x2f\xe8\xd8\x71\xce\xed\x93\xed\xa0\x55\x8d\xf4\x8e\xfa\x65\x95\x7e\x57\x5a\x16\x8f\x81\xd7\x36\x43\x8c\xa4\x9a\x1f\x66\xd1\x9c\x1d\xa6\xf0\x6e\x9b\x65\xa8\xb4\xf4\x0d\xf0\x7d\xa3\x52\x79\x70\x1b\xdd\x1e\xf8\xf7\x82\x51\x38\xe9\x16\xc1\xfb\x35\x47\xb6\xbd\xad\x43\xa5\x51\x92\xb2\xad\x85\x37\xe5\x58\x71\x2d\xce\xa4\x88\x50\xb9\xd1\x13\xde\xa3\xa4\x2c\xd2\x6d\x6d\x2e\x48\x06\x6a\x25\xf2\x94\xc2\x1d\xc2\xda\xdc\xb2\x48\x14\x09\x49\xcd\x99\xaf\x05\x10\xe7\xcd\xd2\xcd\xf8\xff\x48\x22\x73\xee\xbf\x87\xf2\x3f\xc4\x22\xe7\xd4\x71\xa2\xa9\x6e\x38\xd1\x2b\x92\x73\x21\x9d\x9c\x29\xfe\x37\xed\x8c\x1c\x73\xf4\x2e\xc3\xf7\x60\x9e\x35\xf2\xd5\xa3\x3f\x9f\x33\xed\x20\xf8\xf9\x4f\x70\x52\xa6\xdd\x83\xfc\xef\x17\x45\x2c\x47\x1b\x33\xca\x6d\x49\x6f\x8e\x07\xb5\x6d\x8b\x6f\xcd\xa1\x03\x5f\x66\xd3\xd1\xf5\x7c\xee\x2e\x33\xc3\x91\xb9\x85\x85\x9f\x87\xf3\x5f\x8b\x49\x7f\x28\x9e\x4c\xa6\x5f\x07\xb5\xb9\x1e\x04\xb0\x78\x0a\x10\x77\x6a\x50\x29\xb2\x5a\xa7\x58\x6c\xae\x85\x24\x4d\xc5\x16\x52\xa6\x34\x6c\x89\x82\x2c\x57\x2b\xa4\xf6\xec\x64\xaa\x82\xcf\x42\x66\x42\x99\x4a\x4f\x0a\xb3\x30\x32\xa3\x04\x2e\xdb\xe9\xd5\x04\x4d\x85\x35\x93\x1e\x74\x8c\xe3\x26\x47\x1b\x2e\x4b\xc8\xe0\xcf\x3f\x0f\x42\x5d\x9a\x83\xed\xb1\x7e\x57\x73\x25\x9f\xc2\xc8\x4c\x02\xd5\xe8\x74\x24\xb8\xc6\x07\x6d\x08\x40\xb8\xad\x9b\x24\x84\x71\xa5\x5b\x18\xa1\x8c\xdb\x8f\x76\x2f\x34\x93\xf7\x68\xee\xb4\x38\xae\x10\x7d\x01\xa1\x9a\xb9\xbb\x01\x64\x97\x61\xa4\x1f\xcc\xb1\xe1\x96\x26\x11\x47\xab\x20\x80\x2f\x8c\x82\xaf\x13\x46\xbb\xd0\x01\xcd\x68\x81\xe1\x9e\x8a\x4f\xb6\x83\x51\xdb\x8a\x1e\x54\xbd\x31\xae\x4f\x3f\x64\x8c\x76\x07\x87\xac\x6d\xb9\x5e\xbc\x3a\x80\xae\x02\x3c\x56\x61\xde\x38\x95\x83\x97\xca\xbd\x50\x07\x8c\xff\x32\xbe\xea\xb6\xbc\x41\x67\x07\x2f\xc1\x48\x6c\x36\xde\xf7\x73\x8d\xc4\x66\x53\x25\xdb\xcc\xd5\x88\xba\x83\xd7\xa6\x39\x9a\x7e\xfe\xfc\x9c\x3c\x97\xe3\x2b\xe8\xc0\xcd\xf8\xea\x19\xc9\xe6\x4f\x03\x9b\x33\xfa\xfa\x5c\x97\xcf\x80\xf4\xdb\x99\x25\x4f\x67\x96\xfc\x48\x66\x37\xcf\x6c\x76\x22\x45\x9e\x15\xab\xfc\x9f\xff\x80\xa8\x58\x96\xf7\x43\x03\xa4\xb9\x6f\x47\xb9\x34\x17\xf2\xb0\x92\xf9\xdd\xc1\x33\x0a\xb3\xea\x55\x6d\x95\xf9\xeb\x6b\x1a\xdd\xcc\xa6\xcb\x2f\xdf\x2d\xeb\x87\x2e\xe8\xdf\xbf\x80\xf6\x20\xff\x09\xe2\x94\x24\xf5\x73\xea\x8d\xad\xc0\x16\xe4\x77\x5a\xaf\x8f\xae\x5c\x23\xfc\x6e\x19\xa6\x1f\xc5\xf5\xd4\xa2\xc3\x78\x12\x6e\xcc\xa7\xdf\x25\xa4\x82\xd0\xb0\x4d\xe6\x5a\x63\x52\x6a\xb7\xbd\x84\xb3\x96\xc0\xe7\xf5\xc0\xc6\xd8\x37\x05\x42\xc7\x7d\xe7\xcf\x67\xa3\xe2\x0c\xad\x2f\xf7\x4e\xea\x00\x98\xef\x9b\x7d\xed\x8d\x22\x8f\x0b\xdd\xc7\x7c\x32\x72\xf9\x0b\x43\x3d\xb8\xdb\x69\x8f\x6f\x3f\x23\x5e\x9d\x82\x95\x96\x5f\x77\xef\x90\x53\x16\x7b\xff\x1f\x00\x08\x55\x4f\x3f\x72\x13\x00\x00")
+
+func bindataFilterHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataFilterH,
+		"/filter.h",
+	)
+}
+
+
+
+func bindataFilterH() (*asset, error) {
+	bytes, err := bindataFilterHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/filter.h",
+		size: 4978,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792221307, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataMainC = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\xdd\x6e\xe3\x36\x13\xbd\xe7\x53\x0c\xfc\xdd\x24\x86\xd7\xce\xe7\xed\x55\x83\xbd\xf0\x26\xf2\xae\xb0\xb1\x1c\x48\x4e\xb7\x41\x51\x08\x8c\x34\xb6\x88\x48\xa4\x4a\x52\x71\x8c\x62\x1f\xa8\xaf\xd1\x27\x2b\x48\x4b\x6b\xcb\x92\x6c\x07\x05\xb6\xb9\x90\x43\xce\x70\xe6\xcc\x39\x33\xf4\xcf\xa8\x4f\x6e\x44\xbe\x91\x6c\x95\x68\xf8\xfb\x2f\x18\x5f\x8d\xaf\xe0\xd3\x83\x7b\x77\x37\x79\x98\x39\x30\x9d\x3f\xf8\x9e\xeb\xf8\x84\xdc\xb1\x08\xb9\xc2\x18\x0a\x1e\xa3\x04\x9d\x20\x4c\x72\x1a\x25\x08\xa5\x65\x00\xbf\xa0\x54\x4c\x70\x18\x0f\xaf\xe0\xc2\x38\xf4\x4a\x53\xef\xf2\x9a\x6c\x44\x01\x19\xdd\x00\x17\x1a\x0a\x85\xa0\x13\xa6\x60\xc9\x52\x04\x7c\x8d\x30\xd7\xc0\x38\x44\x22\xcb\x53\x46\x79\x84\xb0\x66\x3a\x01\xbd\x8b\x3e\x24\x8f\x65\x00\xf1\xa4\x29\xe3\x40\x21\x12\xf9\x06\xc4\x72\xdf\x0b\xa8\x26\x04\x00\x20\xd1\x3a\xff\x79\x34\x5a\xaf\xd7\x43\x6a\x51\x0e\x85\x5c\x8d\xd2\xad\x97\x1a\xdd\xb9\x37\x8e\x17\x38\xef\xc6\xc3\x2b\x42\x1e\x78\x8a\x4a\x81\xc4\x3f\x0a\x26\x31\x86\xa7\x0d\xd0\x3c\x4f\x59\x44\x9f\x52\x84\x94\xae\x41\x48\xa0\x2b\x89\x18\x83\x16\x06\xe7\x5a\x32\xcd\xf8\x6a\x00\x4a\x2c\xf5\x9a\x4a\x24\x31\x53\x5a\xb2\xa7\x42\xd7\x08\xaa\x50\x31\x05\xfb\x0e\x82\x03\xe5\xd0\x9b\x04\xe0\x06\x3d\xf8\x38\x09\xdc\x60\x40\xbe\xba\x8b\xcf\xf3\x87\x05\x7c\x9d\xf8\xfe\xc4\x5b\xb8\x4e\x00\x73\x1f\x6e\xe6\xde\xad\xbb\x70\xe7\x5e\x00\xf3\x29\x4c\xbc\x47\xf8\xe2\x7a\xb7\x03\x40\xa6\x13\x94\x80\xaf\xb9\x34\xd8\x85\x04\x66\xa8\xc3\x78\x48\x02\xc4\x5a\xf2\xa5\xd8\xaa\xa5\x72\x8c\xd8\x92\x45\x90\x52\xbe\x2a\xe8\x0a\x61\x25\x5e\x50\x72\xc6\x57\x90\xa3\xcc\x98\x32\xe2\x29\xa0\x3c\x26\x29\xcb\x98\xa6\xda\xae\x1b\xe5\x0c\x49\x7f\x44\xfe\xc7\x78\x94\x16\x31\x42\x2f\xa3\x8c\x0f\x93\x1e\x21\xa3\x11\x7c\x16\xe2\x19\x72\xc1\xb8\x56\x10\xe3\x92\x71\x66\x62\x18\xcb\xbb\xd6\x3f\x63\xf9\x88\xa9\x58\x03\x53\x16\x64\xca\x94\x36\x9a\xd2\x34\xb5\xeb\x67\x94\x1c\x53\x48\xf6\xe2\x16\xa6\x09\x9f\x36\x30\x0d\xee\xa5\x78\xc2\xa1\x4d\x3c\xbf\x77\x3c\x42\x02\xe7\xe6\xa2\xf7\x9c\x9b\xed\xd1\xcb\x52\x85\x22\x47\xde\xbb\x24\x8c\x6b\xd8\xee\x86\xd5\xee\x85\xd2\xb2\x88\x34\xe4\x3a\x94\xb8\x52\xd0\x8f\xf4\xeb\x25\xf9\xd3\xf6\x4e\x65\xa2\x3a\x81\xbe\x7d\x7e\x80\xef\xfe\x66\xd9\xbf\xbc\x5f\x84\xbe\xf3\x29\x08\xef\x27\xfe\xec\xff\x17\xe6\xec\xb5\x3d\x2a\x51\x17\x92\x83\x96\x34\x42\x9b\xdd\xd8\x06\x36\xd4\xe5\x35\xf9\x56\x21\x94\xa8\xdb\x41\x56\x86\xb3\x70\x36\x92\x85\x12\x75\x09\xe6\x9b\x65\x65\xf6\xe5\xd6\xf5\x9b\xb4\x64\xcf\x31\x93\x4d\x5e\xec\xf6\xb1\x84\xa5\x89\x71\x11\x23\xf4\x63\x26\xf7\x88\x29\x37\x3b\x99\x29\xdd\x62\xe4\x5a\x6e\xa0\x5f\xbe\xee\xce\x57\x86\x5a\x80\xf1\x5e\x80\x22\x13\x31\x86\x1a\xcc\x8b\xc9\x5b\xae\x6b\xfe\xef\xbb\xa4\xd8\x96\x66\xb5\x88\x99\x1c\x94\xd9\x06\x36\x58\xa7\x30\x35\x9a\x6a\xca\x9c\x64\xaa\x99\xbc\xa1\xcd\x83\x77\xe7\x7a\x5f\x9a\xe2\x14\x3c\x65\xfc\xb9\xa9\xce\x76\xff\x58\xd2\xba\x12\x3f\x58\x9e\x5a\xc5\x25\xd6\x43\xbe\x3b\x99\xae\xd7\x5c\xa3\xfa\x74\xd9\x2d\x99\x1b\x64\xfb\xb3\xd6\x41\x90\x59\xeb\x20\xd8\xed\x63\x29\xeb\xa4\xfe\x97\x4c\xcb\xac\xad\xb1\x3b\x89\xae\x15\x5c\xe3\xf9\x64\xcd\xcd\xb4\x0d\x96\xdb\x1b\xba\xbd\x9d\xcf\x6c\xe6\x8a\x0d\x91\xc6\xe1\x79\x54\xb5\x70\x5d\x4a\xc2\x71\x1d\x9e\xd6\x6a\xdc\x3c\x5f\xe5\xb1\x01\xce\x02\xd1\x79\x11\xed\xe6\x62\x57\xd1\x00\x4a\x64\xe5\x3f\xc7\x25\xec\x9c\x94\x37\xcd\x49\xeb\x94\x04\x8f\xb3\x76\x09\xd5\x26\x6b\x57\xb1\x34\x1c\x4b\x5b\xa7\xfa\x07\xcf\x4a\x24\xb8\xd2\x10\x25\x54\xda\x16\xe2\x34\x43\xd3\x3f\xfb\xdb\xe7\xe9\x56\x15\x7a\x38\x69\x56\x47\x13\xb6\x53\xaf\x03\xee\x6a\x92\x55\x51\x8f\xd0\xd7\x86\xa2\x21\x9c\xef\x78\x93\x99\xd3\xd4\x4d\xa2\x81\xd6\x94\x6d\xbb\x7f\x2c\xed\x01\xc1\xe7\x8f\x5f\xcb\xf8\xbc\x69\xfc\xde\x37\xcf\xbf\x7d\xfc\x7e\xea\x92\xb1\x2c\xfc\x5f\x0d\x60\x9d\xd4\x9a\x9e\xa7\x79\x6d\x41\xd3\x50\x73\x36\xbf\x75\xa7\x8f\x75\x35\xc3\x70\xa9\xb8\xd0\x6c\xb9\x09\x73\x2a\x91\xeb\xba\xa8\x07\xc6\x63\x08\x0e\x78\x3b\x8f\xd0\x7d\x55\xc3\xb0\x78\x3f\x86\x8c\xaa\x67\x73\xca\xae\x3a\x15\xac\x95\x9b\x89\x98\x2d\x37\xe5\x08\x95\xc4\x9b\x30\xad\x4c\x77\x16\xfc\x9d\xf0\x37\xd4\xdc\x02\xa3\xc1\x7a\xe0\x2c\x26\x8b\xc5\xc1\x87\x04\x85\x51\x21\x99\xde\x84\xb6\x87\x43\x85\x9a\x6a\x7d\xf0\x89\xa1\xdd\xe7\x18\x9c\x03\xa6\xcf\x93\xa0\xed\x7d\xcd\x24\x82\xbe\x7d\xee\x4e\x97\xbb\x9d\xfa\xd5\xc8\xa8\xd0\xd6\x44\x31\x01\x5a\x45\x39\x4e\xc7\x77\x65\xde\xce\x48\x1b\xa6\x86\x42\xf7\xfe\xfc\xc6\x09\x02\x58\xf8\x4e\x75\xd7\xd9\xa9\xb6\xdf\x0b\x47\x2a\x4a\x30\xde\x3e\xc3\x5c\x8a\x08\x95\x0a\x97\x42\x56\x17\xef\xce\x33\x6c\xfa\x54\xd0\x9a\x96\x90\x4a\xd3\x4b\xe6\xd9\x8a\xb5\x16\xc5\x7a\xed\x78\x3b\x01\x0e\x5f\x99\x3e\x05\xce\xf8\x5c\xbc\x08\x16\x9f\x01\xc1\xfa\x36\xc8\xba\x99\x7b\x0b\xe7\xd7\xc5\xb9\x90\x30\x3a\x0d\x09\xa3\x76\xbe\x8c\xe5\x5c\xbe\x8c\xef\x1e\x5f\xf6\xad\x38\x2c\x7f\x9e\xf9\xed\x77\xb0\x60\xcb\x65\xef\x12\x3e\x40\xef\xd3\xfd\x5d\xef\x9a\xd8\xdb\x06\xc2\x97\xf2\x87\x26\xeb\x56\x2e\xac\xdb\xd5\xeb\x74\x3a\x9d\x4e\xa7\x53\xe7\x9a\xfc\x33\x00\xe9\xc3\x95\x22\xda\x12\x00\x00")
+
+func bindataMainCBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataMainC,
+		"/main.c",
+	)
+}
+
+
+
+func bindataMainC() (*asset, error) {
+	bytes, err := bindataMainCBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/main.c",
+		size: 4826,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792221307, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataMainH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\xdd\x6e\xe3\x36\x13\xbd\xd7\x53\x0c\x94\x8b\x6f\x37\xb0\xa4\x20\xf8\x7a\xd3\x16\x05\xbc\xd9\x6c\x6b\x34\x75\x8a\x38\xe9\x62\xaf\x0c\x8a\x1c\x49\xd3\xa5\x48\x96\x3f\x76\xd4\x37\xea\x6b\xf4\xc9\x8a\x91\x92\xc6\x5e\x6b\x81\x5c\xf4\xce\xe6\x99\x19\x9e\x99\x33\x3c\xaa\xce\xb3\x2b\xeb\x06\x4f\x6d\x17\xe1\xef\xbf\xe0\xf2\xe2\xf2\x02\x7e\x7c\x58\xdd\xdc\x2c\x1f\x7e\xb9\x86\x0f\xb7\x0f\x77\xeb\xd5\xf5\x5d\x96\xdd\x90\x44\x13\x50\x41\x32\x0a\x3d\xc4\x0e\x61\xe9\x84\xec\x10\x9e\x90\x05\xfc\x86\x3e\x90\x35\x70\x59\x5e\xc0\x1b\x0e\xc8\x9f\xa0\xfc\xed\x77\xd9\x60\x13\xf4\x62\x00\x63\x23\xa4\x80\x10\x3b\x0a\xd0\x90\x46\xc0\x47\x89\x2e\x02\x19\x90\xb6\x77\x9a\x84\x91\x08\x7b\x8a\x1d\xc4\x97\xea\x65\xf6\xe9\xa9\x80\xad\xa3\x20\x03\x02\xa4\x75\x03\xd8\xe6\x30\x0a\x44\xcc\x32\x00\x80\x2e\x46\xf7\x6d\x55\xed\xf7\xfb\x52\x8c\x2c\x4b\xeb\xdb\x4a\x4f\x51\xa1\xba\x59\x5d\x5d\xaf\x37\xd7\xc5\x65\x79\x91\x65\x0f\x46\x63\x08\xe0\xf1\x8f\x44\x1e\x15\xd4\x03\x08\xe7\x34\x49\x51\x6b\x04\x2d\xf6\x60\x3d\x88\xd6\x23\x2a\x88\x96\x79\xee\x3d\x45\x32\xed\x02\x82\x6d\xe2\x5e\x78\xcc\x14\x85\xe8\xa9\x4e\xf1\x68\x40\xcf\xac\x28\xc0\x61\x80\x35\x20\x0c\xe4\xcb\x0d\xac\x36\x39\xbc\x5b\x6e\x56\x9b\x45\xf6\x71\x75\xff\xd3\xed\xc3\x3d\x7c\x5c\xde\xdd\x2d\xd7\xf7\xab\xeb\x0d\xdc\xde\xc1\xd5\xed\xfa\xfd\xea\x7e\x75\xbb\xde\xc0\xed\x07\x58\xae\x3f\xc1\xcf\xab\xf5\xfb\x05\x20\xc5\x0e\x3d\xe0\xa3\xf3\xcc\xdd\x7a\x20\x1e\x1d\xaa\x32\xdb\x20\x1e\x5d\xde\xd8\x49\xad\xe0\x50\x52\x43\x12\xb4\x30\x6d\x12\x2d\x42\x6b\x77\xe8\x0d\x99\x16\x1c\xfa\x9e\x02\x8b\x17\x40\x18\x95\x69\xea\x29\x8a\x38\xfe\x3f\x69\xa7\xcc\xce\xab\xec\x8c\x8c\xd4\x49\x21\x7c\xaf\xc9\xa4\xc7\xea\xb3\xb4\xa6\xa1\xb6\xec\x7e\x38\x81\x76\xd3\x5a\x30\x94\x9d\x39\x2f\xda\x5e\x80\x64\x12\xa0\x48\xb4\xc6\x86\x48\x12\x5c\x0a\xdd\xd7\x51\x6a\x8d\x65\x69\xf2\xe2\xa3\x50\x8a\x5b\x2e\x6c\x53\x38\x21\x3f\xa3\x2a\x7a\xec\x6b\xf4\xf9\xeb\xb2\xbd\x17\x43\x51\xdb\x64\x54\x78\x5d\x46\x32\x29\xa0\x2a\xb4\xa8\x51\xbf\x2e\xa3\xf1\xa2\xc7\xe2\x89\x67\x9e\x65\xd5\x39\xac\x0c\xdc\xf0\x2c\xe0\x9b\xf2\xff\x20\x42\xbf\x25\xa3\xc9\x20\xec\x45\x00\x32\xd1\x5b\x95\x24\xaa\x05\xd4\x29\x02\xc5\xff\x85\xf1\x95\x84\xe4\x9c\xf5\xbc\x30\xf5\x30\x35\x55\x66\x70\x0e\x77\xa8\xb0\xe1\x64\x8a\xbc\x8e\xbf\xa7\x10\xb9\x24\xff\x46\x33\xae\x6c\x48\x52\x62\x08\x4d\xd2\xe3\x8b\x22\x3d\x2a\xc9\xc9\xac\x5b\xa3\xb0\x39\xe0\x90\x9d\x25\xf3\xe5\xc9\xd3\x05\x2f\x47\x1c\x9f\x9d\xa1\x51\xd4\x70\x3b\xef\xb0\xb1\x1e\xa1\x76\xcd\xb6\x43\xed\xd0\x87\xb2\x03\xe2\x56\x46\xe1\xd5\x02\x92\x70\xc4\x78\xd9\x41\x27\x02\xd4\x88\x86\xb9\xbf\x04\xec\x3b\x92\x1d\x78\x6c\xd0\xa3\x91\x18\x60\x5a\xa3\x38\x38\x0c\x65\x57\xc2\x3d\xdb\x03\xfb\x45\xed\xc9\xb4\x53\x2e\xb3\xd8\xee\x2c\xb7\xa3\x71\xdb\xda\x68\x61\x64\x4a\xdc\x1e\x50\x33\xad\x71\x1c\x27\x26\xd8\xaa\xec\x78\xe9\x34\x03\xf4\x10\x30\x26\xc7\xfb\x0d\x9f\xd1\x1b\xe4\xe9\xf0\xce\x06\x9e\xcc\x18\xc8\x33\x06\x65\xf1\x68\xfe\x90\xf3\x74\x9f\xaf\x05\xbe\x36\x87\x01\x23\x67\xc1\xc6\x82\x7f\xd6\xe3\x94\x5d\xb4\x10\x6c\x8f\x40\x66\x27\x34\x29\xe6\x0f\xd2\x2a\x1c\x53\x57\xcd\x4c\x06\x05\x10\x32\x26\xa1\xf5\xc0\x2e\x39\x4a\xcf\x4f\xaf\x76\x0d\x38\x6f\x5b\x2f\xfa\x05\x27\x8b\x43\x65\x01\xbd\xb7\x1e\xf6\xa4\x35\x3b\x17\x0a\x7f\xa2\xf5\xd1\x2d\x87\x92\x7f\x01\x4c\x12\x1f\x2e\xc0\x51\xc0\x9b\xc7\xb2\x2c\xdf\xc2\xe1\x40\xde\xe4\xcf\xdd\xb1\xab\xdb\x99\xaa\xf9\xdb\xec\xc4\x13\x5c\xf4\x42\x22\x5b\xc2\x57\x5f\x94\xb3\xee\xbf\xf0\x8b\xd6\xa4\x62\x27\x3c\xf1\xcb\x28\x02\xfd\x89\xaa\xe0\x25\x2b\x8c\x8d\x85\x88\x05\x1a\x95\x1f\xb0\xe3\xbd\xad\xa6\x55\x1c\x3d\x30\xcc\x19\x5a\x90\x1d\xaa\x39\xa0\x09\xdb\x10\x7d\x92\x71\x0e\x54\x72\xfc\x0e\xcd\xa6\xcd\xc5\xf7\x36\x99\xd9\x42\x4f\x6f\xe4\x14\x68\xc2\xd6\x91\x99\x43\x46\xc6\x55\xa0\xd6\x08\xcd\xf8\x49\x80\x09\xce\xdb\xc7\x61\x2e\xd7\x91\xda\x1a\xd1\x63\x70\xcf\x8a\x9d\xe4\x6e\xa5\xed\xfb\xc9\xe1\xbf\x2e\x18\xcb\x59\x55\x70\x95\x42\xb4\x3d\xe0\xbb\x5f\x3f\x3c\xdb\x41\x78\x29\x99\xd7\xae\xa9\x46\xdf\xc8\x4f\x0f\xb7\xbd\x70\xf3\xc0\xbf\x2e\x74\x08\x4a\x6b\x42\x3c\x3e\x72\xde\xb2\x33\x1e\x1f\x4e\x92\x7d\x71\xa8\xd0\x44\x3f\x1c\x9f\x35\xa4\x23\xfa\xd9\x8a\xdb\xe8\x11\x8f\x11\xdc\xa1\x89\xa1\xc2\x1d\x9a\x18\xca\x2e\xcf\xfe\x19\x00\x4f\x2a\x0f\xb3\x6b\x09\x00\x00")
+
+func bindataMainHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataMainH,
+		"/main.h",
+	)
+}
+
+
+
+func bindataMainH() (*asset, error) {
+	bytes, err := bindataMainHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/main.h",
+		size: 2411,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792221307, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataProcessH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x55\xdd\x6e\xe3\x36\x13\xbd\xd7\x53\x0c\xb2\x40\x3e\x2b\x9f\x7f\x52\xef\x62\x2f\x9a\xdd\x00\x5e\xc7\xd9\x35\x36\xb1\x83\xd8\xe9\x22\x28\x0a\x82\x16\x47\xf2\x60\x29\x92\x25\xa9\x38\x6e\xbb\x0f\xd4\xd7\xe8\x93\x15\x94\x64\x59\x4e\xdc\x3b\xce\x0c\x75\x78\xe6\xcc\x8f\x06\x67\xd1\x58\x9b\xad\xa5\x6c\xed\xe1\x9f\xbf\x61\x78\x3e\x3c\x87\xcf\x0f\xd3\x9b\x9b\xd1\xc3\xed\x04\xae\xe7\x0f\xf7\xb3\xe9\xe4\x3e\x8a\x6e\x28\x41\xe5\x50\x40\xa1\x04\x5a\xf0\x6b\x84\x91\xe1\xc9\x1a\xa1\x8e\x74\xe1\x17\xb4\x8e\xb4\x82\x61\xff\x1c\x3a\xe1\xc2\x49\x1d\x3a\x89\x2f\xa2\xad\x2e\x20\xe7\x5b\x50\xda\x43\xe1\x10\xfc\x9a\x1c\xa4\x24\x11\xf0\x39\x41\xe3\x81\x14\x24\x3a\x37\x92\xb8\x4a\x10\x36\xe4\xd7\xe0\xf7\xe8\xfd\xe8\xb1\x06\xd0\x2b\xcf\x49\x01\x87\x44\x9b\x2d\xe8\xb4\x7d\x0b\xb8\x8f\x22\x00\x80\xb5\xf7\xe6\xe7\xc1\x60\xb3\xd9\xf4\x79\xc9\xb2\xaf\x6d\x36\x90\xd5\x2d\x37\xb8\x99\x8e\x27\xb3\xc5\xa4\x37\xec\x9f\x47\xd1\x83\x92\xe8\x1c\x58\xfc\xbd\x20\x8b\x02\x56\x5b\xe0\xc6\x48\x4a\xf8\x4a\x22\x48\xbe\x01\x6d\x81\x67\x16\x51\x80\xd7\x81\xe7\xc6\x92\x27\x95\x75\xc1\xe9\xd4\x6f\xb8\xc5\x48\x90\xf3\x96\x56\x85\x3f\x10\x68\xc7\x8a\x1c\xb4\x2f\x68\x05\x5c\xc1\xc9\x68\x01\xd3\xc5\x09\x7c\x1a\x2d\xa6\x8b\x6e\xf4\x6d\xba\xfc\x32\x7f\x58\xc2\xb7\xd1\xfd\xfd\x68\xb6\x9c\x4e\x16\x30\xbf\x87\xf1\x7c\x76\x35\x5d\x4e\xe7\xb3\x05\xcc\xaf\x61\x34\x7b\x84\xaf\xd3\xd9\x55\x17\x90\xfc\x1a\x2d\xe0\xb3\xb1\x81\xbb\xb6\x40\x41\x3a\x14\xfd\x68\x81\x78\xf0\x78\xaa\xab\x6a\x39\x83\x09\xa5\x94\x80\xe4\x2a\x2b\x78\x86\x90\xe9\x27\xb4\x8a\x54\x06\x06\x6d\x4e\x2e\x14\xcf\x01\x57\x22\x92\x94\x93\xe7\xbe\xb4\x5f\xa5\xd3\x8f\xce\x06\xd1\x1b\x4a\x95\xc0\x14\xd8\xdd\xfd\x7c\x3c\x59\x2c\xd8\x17\x16\xbd\x11\x98\x92\xc2\x03\x5f\x34\x18\xc0\x72\xf9\xc8\x66\xa3\xdb\x09\xbb\x99\xcc\xa0\x07\xb7\xfc\x99\xf2\x22\x07\x89\x2a\xf3\xeb\x5d\x05\x97\xcb\x47\x50\x3c\xc7\x06\xe5\xe0\xab\x9f\xde\x97\x48\xc6\xea\x04\x9d\x63\x89\x7f\x66\x1e\x7a\x30\xd6\x2a\x34\x83\x03\x2e\x65\x99\x64\x7d\x01\x12\xad\x3c\x3e\x7b\x48\xb4\x94\x98\x04\xd1\x83\x0c\xbc\x6a\x38\xb7\x75\x1e\x73\xc0\x27\x54\x3e\x72\xde\x16\x89\x3f\x44\x8e\xfe\x2c\x9b\x68\x30\x80\xbb\x1a\x4f\x70\xcf\x4b\x5f\xf1\xfe\x1d\x78\xca\xd1\x79\x9e\x9b\x8b\xca\xf5\x76\x08\x86\xc4\xde\xf0\x6d\xa3\x68\x1b\xd9\xce\x48\xd6\xdc\x86\x76\xcf\x7f\x5d\x8e\x16\x5f\xd9\x78\x7e\x7b\x1b\xf2\xfc\x6d\x7f\xd5\x1c\x40\xe6\xca\x33\xe5\x5a\x51\x12\x87\x36\x17\x82\x54\x56\x3b\xde\xbf\x83\x24\xb3\xba\x30\x2c\x60\xfc\xb8\x28\xb5\x4b\x49\x4a\xb6\x4b\x33\xe4\x03\x3d\xb8\x26\x29\xdd\x4e\xb8\x27\x12\x28\x5e\x48\xdc\x8c\xe1\x4b\x61\xf9\x13\x27\x59\x0e\x48\x6a\x75\x0e\xf8\xe9\xee\x3a\x62\x8c\xfb\xba\xc9\x19\xeb\x74\xb8\xdc\xf0\xad\x63\xa4\x24\x29\x8c\x63\x70\xa1\xa3\x92\x52\xc1\x57\x5c\x3a\xc7\xca\x00\x67\x81\x66\xbc\xaf\xc6\x58\xe7\x79\x79\x5e\x99\x94\x65\xe8\x59\x52\x58\x8b\xca\xb3\xa0\x64\xe7\x34\xdc\xee\x5d\x86\x73\x17\x1c\xfd\x81\x3a\xed\xec\x5d\x71\x7c\x11\x35\x55\x25\x01\xa7\xb0\x24\xd1\xc8\x45\x02\x3e\xbe\x42\x35\x24\x98\xcf\x48\x74\xe2\x4a\xd7\x0a\xcc\x94\x77\x49\xc0\xe5\x25\xbc\x1d\xb6\x23\xbe\x8e\xec\x1f\x7a\x98\x5e\xc1\x29\x7c\x9e\x5e\x55\xfb\x70\x8d\xd2\xa0\x05\x8b\xbe\xb0\xca\x41\x46\x02\x3e\x7c\x80\xb7\x43\xf8\x0b\x0a\x12\x71\xc3\xa6\x70\x68\x8f\x32\x2a\x48\xb0\x57\x84\x8a\xf2\x6a\xf5\x4d\xdb\x9f\xb5\xfc\x3b\xb2\x8d\x02\x21\x8b\x4e\x48\x6e\x37\x7f\x16\xb9\x04\xc3\xc3\x33\x15\x91\xba\x22\x9e\xbb\xef\xac\x3e\x9f\x05\x03\x3e\x42\xe7\x58\x2c\x7e\x49\x36\x44\x77\x4c\x8f\x7d\x50\x3d\x76\xd1\x14\xd4\x58\xbd\x42\x66\x91\x8b\xce\x69\x15\x6b\xca\x58\xf3\xea\xc2\x69\x00\xed\x5d\x06\xb2\xac\x76\x1e\x07\xa8\x6b\x65\x48\xbc\xe8\x85\x30\x57\x71\x17\xea\x17\x7a\x97\x41\x83\x56\x6b\xcc\x78\x8e\xce\xf0\x04\x5d\x77\x47\xba\x9c\xbd\x9d\x1b\xc8\xa9\xff\xf9\xb0\x78\xb5\x0d\x6b\x65\xb5\x2d\xc7\xe3\x3b\x5a\x85\x12\xd6\xc8\x05\x5a\xd7\x4e\x59\x39\x63\xf5\xf3\x16\xce\xea\xc3\x71\xba\x75\xb0\xe1\x5a\xdb\xfb\x8c\x77\x8e\x03\x39\x0f\x99\x9d\xb5\x97\xc4\xcb\x07\xaa\x58\x83\x5f\x99\x01\xbe\x06\xee\x5d\xd6\xae\xe3\x9f\x57\x72\xbe\x00\x69\x3b\xe3\x2e\x74\x9e\x34\x09\x38\x8b\x2b\x07\xfc\x1f\xa4\xe6\x82\x55\x16\x23\x55\xe4\x4c\xa7\xa9\x43\xdf\x89\xeb\x2c\xc2\x42\x2f\xf7\x18\x4b\xb5\x65\xc9\x9a\xa4\xb0\xa8\x80\xea\x8d\x44\x02\xf6\xc9\xd5\x5d\x1a\xc4\xe8\x42\x51\xfd\xb1\xc9\x43\xc2\xa5\x2c\x7f\xb9\x6e\xcd\x2d\x76\xc6\x37\xf3\xd9\x84\xcd\x26\xdf\xee\xa6\x57\x07\x5d\x1c\x46\xb9\xa5\x94\x21\xf1\x9f\x4a\x55\xb1\x26\xc9\xca\x3c\x50\xea\x08\xe9\xf8\x38\x56\xdd\x72\x87\x88\x6d\x67\xc0\xad\x4e\xa1\xc4\xfd\xa0\x52\xab\x19\xc7\xe5\x12\x87\xa7\x61\x6b\xaa\x9b\xc5\x7e\x64\x3d\x34\xb1\xdd\xd8\x55\x9b\xa6\x5c\x49\x3f\xa2\xe8\x0d\x2a\x41\x69\xf4\xef\x00\x57\xfd\xd8\xb3\xf3\x09\x00\x00")
+
+func bindataProcessHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataProcessH,
+		"/process.h",
+	)
+}
+
+
+
+func bindataProcessH() (*asset, error) {
+	bytes, err := bindataProcessHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/process.h",
+		size: 2547,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792221307, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataProcesstreeH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x55\x7f\x6b\xdb\x48\x13\xfe\x5f\x9f\x62\x48\xa1\xd8\xc1\xb1\x42\xfa\xf2\x72\x34\xa4\x9c\xeb\x2a\x57\x51\xc7\x2e\x96\x7d\xbd\x72\x1c\xcb\x5a\x3b\x92\x96\x48\xbb\x7b\xbb\xa3\x3a\xe6\x9a\x0f\x74\x5f\xe3\x3e\xd9\xb1\xfa\x61\xc7\x4d\x9b\xd2\x23\x20\xa2\x9d\x67\x66\x9e\x9d\x79\x1e\x39\x3c\x0d\xa6\xda\xec\xac\xcc\x0b\x82\x7f\xfe\x86\x8b\xf3\x8b\x73\xf8\x65\x1d\xcf\x66\x93\xf5\x4d\x04\xd7\x8b\xf5\x72\x1e\x47\xcb\x20\x98\xc9\x14\x95\x43\x01\xb5\x12\x68\x81\x0a\x84\x89\xe1\x69\x81\xd0\x45\x46\xf0\x2b\x5a\x27\xb5\x82\x8b\xf1\x39\x0c\x3c\xe0\xa4\x0b\x9d\x0c\x2f\x83\x9d\xae\xa1\xe2\x3b\x50\x9a\xa0\x76\x08\x54\x48\x07\x99\x2c\x11\xf0\x2e\x45\x43\x20\x15\xa4\xba\x32\xa5\xe4\x2a\x45\xd8\x4a\x2a\x80\x0e\xd5\xc7\xc1\xc7\xae\x80\xde\x10\x97\x0a\x38\xa4\xda\xec\x40\x67\x0f\x51\xc0\x29\x08\x00\x00\x0a\x22\xf3\x32\x0c\xb7\xdb\xed\x98\x37\x2c\xc7\xda\xe6\x61\xd9\xa2\x5c\x38\x8b\xa7\xd1\x3c\x89\xce\x2e\xc6\xe7\x41\xb0\x56\x25\x3a\x07\x16\xff\xac\xa5\x45\x01\x9b\x1d\x70\x63\x4a\x99\xf2\x4d\x89\x50\xf2\x2d\x68\x0b\x3c\xb7\x88\x02\x48\x7b\x9e\x5b\x2b\x49\xaa\x7c\x04\x4e\x67\xb4\xe5\x16\x03\x21\x1d\x59\xb9\xa9\xe9\x68\x40\x3d\x2b\xe9\xe0\x21\x40\x2b\xe0\x0a\x4e\x26\x09\xc4\xc9\x09\xbc\x9e\x24\x71\x32\x0a\x3e\xc4\xab\xb7\x8b\xf5\x0a\x3e\x4c\x96\xcb\xc9\x7c\x15\x47\x09\x2c\x96\x30\x5d\xcc\xdf\xc4\xab\x78\x31\x4f\x60\x71\x0d\x93\xf9\x47\x78\x17\xcf\xdf\x8c\x00\x25\x15\x68\x01\xef\x8c\xf5\xdc\xb5\x05\xe9\x47\x87\x62\x1c\x24\x88\x47\xcd\x33\xdd\x6e\xcb\x19\x4c\x65\x26\x53\x28\xb9\xca\x6b\x9e\x23\xe4\xfa\x13\x5a\x25\x55\x0e\x06\x6d\x25\x9d\x5f\x9e\x03\xae\x44\x50\xca\x4a\x12\xa7\xe6\xfd\xd1\x75\xc6\xc1\x69\x18\x3c\x93\x99\x12\x98\x01\x7b\xbf\x5c\x4c\xa3\x24\x61\xab\x65\x14\xb1\xb7\x2c\x78\x26\x30\x93\x0a\x1f\x07\x82\x30\x04\x97\x16\x28\x98\xb1\x3a\x45\xe7\x58\xa6\xed\x2d\xe3\x36\x77\x70\x06\x13\x9b\xd7\x15\x2a\x72\xfd\x46\x1b\x68\xf8\x38\x01\xc8\xf2\x14\x8d\x96\x8a\x46\xe0\x10\x7d\xd9\xd0\xed\x5c\x78\x8b\x56\x61\x19\x0a\xdc\xd4\x79\xe8\x41\x52\xe5\x21\x7e\xf2\x35\xc3\x6f\x15\x0b\x33\x6d\x2b\x4e\x81\x23\x5b\xa7\xf4\x2d\x7a\xc1\x5f\x8d\xa6\x6a\xe5\x64\xae\x50\x80\x2b\xb4\x25\x2f\xd6\x4a\x2b\x46\x3b\x83\x97\xc7\xf1\xb4\xe0\xb6\x0f\x67\x25\xcf\xdd\x13\x71\x63\x11\x2b\x43\x2c\xd5\xb5\xa2\x16\x27\xd5\xbe\xb8\x91\xe2\xb2\x15\x74\x53\xd3\x70\x8b\xca\x63\xab\xea\xf7\xd5\x24\x79\xc7\xa6\x8b\x9b\x1b\x36\x8b\xe6\x7f\xb4\x99\x46\x0a\x46\x3d\xaa\xc9\xdd\xa7\xa6\x85\x2c\xc5\xf7\x32\x5b\x50\x93\x78\x7f\x19\xf8\xc9\xfa\x39\xe2\xd1\x44\xfc\xae\x84\x70\x8d\xa4\x14\x6e\x81\xb8\xbb\xf5\xb6\xf0\xef\x46\x0a\x6f\x69\x42\x0b\xdb\x02\x55\x83\x69\xe3\x05\x27\xf0\xdb\x43\x01\x92\x40\x3a\xef\xa2\xe3\x0c\x6f\xa6\xa6\xa3\x87\x36\xa1\xb6\x69\x17\x76\x8d\x27\x77\x7d\x27\x54\x24\xed\x01\x43\xb6\x15\xc2\xcf\x7e\x5d\x2f\x81\x7f\x29\xa6\x83\x66\x02\xc6\x38\x75\x4e\x64\x6c\x30\xe0\xe5\x96\xef\x1c\x93\xaa\x94\x0a\x87\x43\x70\x5e\xf6\x69\xb3\x83\xc7\x77\x1f\x3c\xad\x13\x38\xf5\xcf\x61\x27\x97\x30\x84\xd5\x51\x6b\xb0\xb5\xda\x5f\x3c\xd5\x8a\xf0\x8e\x7a\x82\xed\xce\x46\x50\x6a\x7d\xeb\x07\x05\x92\x5c\x33\x9c\x01\xe5\x52\x0c\xbd\x29\x7d\x9a\xda\xc7\x48\x8a\xa6\x4b\xfd\xff\xff\x81\x14\x70\x05\x1b\x93\xb1\x1c\x89\xa5\xb5\xed\xd7\xcf\x7c\xee\x60\xd8\xa9\xef\xc5\x45\x53\xf0\xca\xc3\x5f\xbd\x82\x17\x17\xdd\xf9\x4f\x70\xca\x53\x6f\xf5\xae\x48\xc5\x0d\xf3\x34\x6a\xc3\xb0\xc4\x6a\xf0\xdc\x6b\xa3\xdf\x91\x7f\xe9\x0a\xca\x0c\x06\x7d\xe2\x15\xcc\xd7\xb3\xd9\x10\xda\x9b\xf7\xed\xa8\x6b\x77\xb9\x3f\xfd\x81\x46\xb4\x6f\xf4\xfd\x66\xfe\xcf\x22\xd5\x56\xc1\xf9\x21\xe7\x3e\x38\x3c\x3d\x9d\xbd\xba\xe1\xca\x2b\xc4\x9d\xbd\x7a\xa0\xf7\x6e\x14\xed\xc9\x9e\xe6\xa0\x1f\xcd\x73\xe8\x3f\x67\xd7\xf1\x6c\x15\x2d\xd9\x64\xea\xbf\xca\xec\x66\x92\xbc\x1b\xc2\xe7\x2f\xa3\xf1\xfc\x6d\xb4\x8c\x57\xd1\x9b\x96\x4d\x7f\xdb\xda\x08\x4e\xf8\xb5\xdb\xee\x99\xec\xff\x6f\x1b\x8f\xe0\xf5\xfb\x6b\x36\x5f\x44\xbf\xc5\xc9\xaa\x9b\xc7\xe1\xa6\xf7\x5f\xf1\x28\xde\x49\x82\x33\x58\x62\xa5\x3f\x61\x6b\x53\x7f\xe4\xbf\xf2\x8d\x15\x33\xab\xab\x2f\xcd\x2a\x33\xef\xca\x2d\x77\xc0\x85\x68\x7f\x01\x1f\x8b\x7f\xec\xe5\xec\xfb\xa1\x22\x2b\xd1\x81\xa9\x5d\xd1\x82\x6b\x87\x16\x9c\xe1\x29\x02\xb7\x08\x25\x66\x04\xb5\x22\x5d\x7b\x9f\x8c\xff\xb3\xe5\x3c\xef\x41\x6f\xa7\x83\xa0\xbe\xab\xf4\x1f\x53\x34\x3d\xa1\x68\xf8\xfc\xf9\x09\x0d\xec\xb7\x3c\xf4\xf8\xf3\x87\x8a\x3c\x56\xe3\xfd\x91\x0a\x04\x96\x48\xf8\x34\x95\xa3\x1d\x3f\x43\x25\x64\x16\xfc\x3b\x00\xbc\x64\x06\x13\xab\x09\x00\x00")
+
+func bindataProcesstreeHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataProcesstreeH,
+		"/process_tree.h",
+	)
+}
+
+
+
+func bindataProcesstreeH() (*asset, error) {
+	bytes, err := bindataProcesstreeHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/process_tree.h",
+		size: 2475,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792221307, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _bindataStructsH = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5a\xdb\x72\xdb\x38\xd2\xbe\xd7\x53\x74\x69\x2e\x26\x72\x1c\xd9\x96\x1d\xcf\xc1\xe3\x0b\xc5\xa6\x13\x55\x24\x59\x25\xc9\x93\xe4\x4f\xa5\x58\x10\xd9\x92\x50\x26\x01\xfe\x00\x68\x59\x3b\xb5\x0f\xb4\xaf\xb1\x4f\xb6\xd5\xe0\x99\x92\x0f\x51\x66\xb2\xb3\x57\x36\x71\xe8\x6e\x7c\xf8\xba\xd1\x68\xe8\x60\xaf\x71\x21\xa3\xb5\xe2\x8b\xa5\x81\x7f\xff\x0b\x3a\x87\x9d\x43\x78\x7b\xd3\xeb\xf7\xbb\x37\x03\x07\xae\xae\x6f\xc6\xc3\x9e\x33\x6e\x34\xfa\xdc\x43\xa1\xd1\x87\x58\xf8\xa8\xc0\x2c\x11\xba\x11\xf3\x96\x08\x69\xcf\x3e\xfc\x8e\x4a\x73\x29\xa0\xd3\x3e\x84\x17\x34\xa0\x99\x76\x35\x5b\x67\x8d\xb5\x8c\x21\x64\x6b\x10\xd2\x40\xac\x11\xcc\x92\x6b\x98\xf3\x00\x01\xef\x3d\x8c\x0c\x70\x01\x9e\x0c\xa3\x80\x33\xe1\x21\xac\xb8\x59\x82\x29\xa4\xb7\x1b\x9f\x52\x01\x72\x66\x18\x17\xc0\xc0\x93\xd1\x1a\xe4\xbc\x3c\x0a\x98\x69\x34\x00\x00\x96\xc6\x44\xbf\x1e\x1c\xac\x56\xab\x36\xb3\x56\xb6\xa5\x5a\x1c\x04\xc9\x28\x7d\xd0\xef\x5d\x38\xc3\x89\xf3\xaa\xd3\x3e\x6c\x34\x6e\x44\x80\x5a\x83\xc2\xff\x8f\xb9\x42\x1f\x66\x6b\x60\x51\x14\x70\x8f\xcd\x02\x84\x80\xad\x40\x2a\x60\x0b\x85\xe8\x83\x91\x64\xe7\x4a\x71\xc3\xc5\x62\x1f\xb4\x9c\x9b\x15\x53\xd8\xf0\xb9\x36\x8a\xcf\x62\x53\x01\x28\xb3\x8a\x6b\x28\x0f\x90\x02\x98\x80\x66\x77\x02\xbd\x49\x13\xde\x74\x27\xbd\xc9\x7e\xe3\x43\x6f\xfa\xee\xfa\x66\x0a\x1f\xba\xe3\x71\x77\x38\xed\x39\x13\xb8\x1e\xc3\xc5\xf5\xf0\xb2\x37\xed\x5d\x0f\x27\x70\x7d\x05\xdd\xe1\x27\x78\xdf\x1b\x5e\xee\x03\x72\xb3\x44\x05\x78\x1f\x29\xb2\x5d\x2a\xe0\x04\x1d\xfa\xed\xc6\x04\xb1\xa2\x7c\x2e\x93\xdd\xd2\x11\x7a\x7c\xce\x3d\x08\x98\x58\xc4\x6c\x81\xb0\x90\x77\xa8\x04\x17\x0b\x88\x50\x85\x5c\xd3\xe6\x69\x60\xc2\x6f\x04\x3c\xe4\x86\x19\xfb\xbd\xb1\x9c\x76\x63\xef\xa0\xf1\x03\x9f\x0b\x1f\xe7\xe0\x0e\xba\xa3\x89\xfb\xce\x6d\xfc\xe0\xe3\x9c\x0b\x2c\x1a\x1a\x07\x07\x80\x77\x28\x8c\x6b\xd6\x11\xc2\x2b\xb8\xb4\x03\xb4\x35\xc6\x36\xc9\x79\x42\x00\xbd\xd6\x06\xc3\x64\x70\x03\x45\x9c\xfe\x6b\xe7\x35\xfe\xb0\xfb\xe9\xfc\xee\x0c\xa7\xee\xf5\xc8\x19\xee\x97\xbe\x07\xef\x2f\x7b\xe3\x72\x43\xbf\x37\x7c\x5f\xfe\x1e\x3b\xc3\xee\xc0\x29\xb7\xdc\x0c\x37\xc6\x0c\x6a\x42\x06\xd7\x97\xbd\xab\x4f\xe5\x96\x89\x33\xed\x4e\xa7\x95\x41\x93\x4f\x83\x44\xd0\x3f\xcf\xec\x4a\xe7\xda\x4d\x8d\x86\x57\x70\x55\x5f\x15\x68\xa3\x62\xcf\xc4\x0a\x1b\xc9\x7f\xa5\xf1\xe9\x0a\xd3\xf6\x48\x49\x0f\xb5\x76\x3d\x73\xef\x16\x5f\x3e\x33\xec\xcc\x0e\xe3\xc2\xc0\x3c\x60\x0b\x5d\x7c\x86\xd2\xc7\xe4\x2b\x3e\x3d\x01\xad\x3c\x37\x62\x66\xe9\xde\xe2\xba\x68\x35\x4c\x2d\xd0\x6c\xe9\xa0\xe1\x5c\x14\x12\x8e\x3b\x85\x84\x00\xc5\xc2\x2c\x0b\x45\xd4\x11\xca\x58\x18\x97\xfb\x1b\xa2\x6b\x42\xca\x0a\xeb\x72\xd2\xbe\xaa\x28\xea\x50\x68\xee\x58\x50\x08\xb1\x08\x9d\xd5\x21\xd6\xf0\x0a\x46\xa8\xe6\x30\x8b\xe7\x73\x54\x14\x4d\xac\x63\x6a\x14\xfe\x26\xa1\x34\xcc\x98\x77\x4b\xfd\xb1\x46\x05\x3a\x62\x5e\xbe\x09\xb3\x68\xee\x86\x2c\x72\x89\xc8\x13\xe7\xe2\x45\x33\x64\x91\x3e\xc8\xf5\x34\x5b\x25\x9d\xe7\x90\xec\x53\x9b\x58\x09\xe7\xf0\x66\x74\x45\x54\x77\xa7\x9f\x46\x8e\x3b\x72\xc6\x57\x6e\xc2\x15\x72\xdf\x94\x3c\xed\x5b\x5c\xbb\x9a\xff\x03\xe1\x1c\x0e\xd3\xa6\x3b\x16\xc4\x58\x6f\x0c\xd9\xbd\x8b\xc2\x28\x8e\xba\xd4\x1a\x71\x61\x9d\xf3\x1c\x46\xbd\xa1\x3b\xbc\x1e\xa6\x44\x6e\x0b\x16\xa2\x5d\x07\x9c\x43\xb3\x99\x53\xd0\xf9\xe8\x5c\xb8\x57\xbd\xbe\xe5\xbc\xdb\x77\x86\xf0\x0a\x06\xec\x9e\x87\x71\x08\x56\x61\x1a\x2b\xf1\x1e\xbd\xd8\xd8\xe0\x46\x7c\x00\x8d\xc2\x24\xf1\x96\x09\xdb\x99\x7a\x62\xe6\xd1\x9b\x72\x3b\xaf\x4f\x73\x85\xdd\xf1\xdb\xc9\x23\xca\x98\x5a\xc4\xa1\x05\xf0\x01\x35\xfb\x10\xc6\xda\xc0\x0c\x81\x41\x24\x57\xa8\x28\xa4\x77\xf2\x70\x52\xd5\xf1\xfa\xa8\x63\x97\x4a\x56\x96\xfc\x6d\x94\x38\x8d\x95\x1a\x53\xd4\xaa\x7b\xdd\x7e\x4e\x92\x48\x46\x71\xc0\x4c\x12\x22\x53\xf7\x02\x8f\x4e\x08\xd2\xbb\x49\x91\xb2\xa6\xaf\xf3\x54\x22\x30\xb1\x91\x76\xab\xe2\x02\xd4\xc1\xd4\x42\x57\x1a\xbd\x25\x53\xf9\xf0\xcf\x1b\x90\x7f\x29\x8d\xa2\xb9\x9f\x2b\xb8\x7c\xc9\x7d\x24\x33\xe3\x69\x47\x89\xb6\x63\xf6\xf5\xee\x52\x55\xd9\x6c\xd5\x6d\xf8\xfb\x3b\x4e\x69\x8f\x67\x31\x0f\xe8\xb8\x23\xcf\x89\x72\xc0\x6c\x6b\x89\xb3\x7a\x9f\xe8\xb3\x06\x5f\x8a\x1f\x0d\xcc\xb9\x01\x29\xa8\x05\xb4\xa1\x58\x93\xf9\xd9\x9b\xd1\x15\x81\xb1\x50\x2c\x7c\x1c\xc2\x4d\x03\x9a\xad\x6d\x56\x3d\x05\xe5\xc5\xe8\xe6\x21\x18\x09\x4d\x39\x7f\x11\x1f\x77\x5a\xdb\x00\x4d\xbb\x53\x2b\x4b\xaa\x4d\x6b\x2b\xd4\x47\x3b\x42\xed\x93\x88\xb5\x6b\x1d\xce\xba\xee\xa5\x6d\x48\x3d\x30\xf7\xd7\x1c\xf9\xa4\xdd\x93\xc2\xe0\x3d\x05\x09\xb3\x42\x14\x70\x1b\x29\x39\x43\x0d\xc9\x5c\x26\x7c\x50\x68\x62\x25\x32\x94\xab\x5a\xaa\x6e\x9b\x45\x74\xb7\xf8\xf7\xac\xdc\x6f\xcf\x31\xd8\xa3\xb3\xce\xe7\xaa\xd2\x95\x88\x4d\xfb\xec\xff\xdb\x66\xa6\xa7\xdb\x43\x93\xb3\xee\xd2\x7c\x4f\x0a\x6d\x12\xdf\xde\xd3\xeb\x30\xe0\xe2\xd6\x4d\x86\x15\x01\xc3\x8b\x95\x96\xea\x6c\x1b\x8e\x75\x14\xc3\x12\x73\xb5\x91\x0a\xc1\xdf\x0a\xb2\xce\xf1\xec\x54\xb8\xaa\x1f\x27\x6b\x59\x75\xb3\x55\xb5\xe4\x51\x82\xf6\xc7\x37\xee\xbb\xee\xe4\xdd\xc3\xe4\x3c\x3d\x79\x06\x39\xcb\x0a\x1f\xa4\xe7\xe1\xe1\xae\xc1\xa0\x22\x3e\x73\xbc\x1a\xc2\x59\x73\x19\x69\x85\x7e\xec\x25\xc7\x0a\xb3\x99\x0d\x9d\x27\x74\x1a\x54\x62\xc3\xf3\xa1\x2d\x45\x82\xad\x26\x3d\x0a\xf5\x9f\x11\x04\x9e\x85\xf3\xe9\x8e\x28\x67\x89\xa8\x8d\x01\x93\xaa\xdb\xb3\xe4\x8a\x70\x8b\xeb\x82\xc1\x76\xfc\x5c\xb1\x05\xe5\x12\x6e\xe9\x64\xcf\xc9\x5a\x92\x98\x00\x13\x0b\xcd\x17\x02\x7d\x08\xa4\x58\x90\x73\x16\xce\x54\x4b\x62\x8f\x3b\x10\x31\xdf\xe7\x62\x51\x9c\xa3\x35\x7d\x1b\x46\x56\x2c\x83\x00\xd9\x1c\xfc\x58\x11\xd1\xc8\x76\x32\x06\x14\x6a\x19\x24\x47\x6b\x7a\x24\x56\x6c\x2d\xa4\xd7\xf2\x8a\x62\x21\x11\x53\x79\x78\xb2\xe1\x81\xd0\xfc\x6c\x73\x82\x41\xf7\xe3\x97\xed\xd6\xea\xda\xe1\x55\x32\x33\x1f\xd2\x86\xe9\x12\x4b\x27\x7c\xe6\xfa\xb0\xe2\x41\x00\x0a\x3d\xba\x19\xda\x5d\x28\xa4\xce\x95\x0c\x93\x5b\x7b\xc8\xa2\xf6\xe3\x44\xae\x1a\xd4\x6c\xd5\x2d\xfc\xb6\x38\xb1\x01\xd4\x33\xd8\x5c\x31\xe0\x41\x3a\x1f\xee\x1e\x37\x46\xdd\xe9\x3b\xf7\xcd\xcd\xd5\x95\x33\x76\x27\xbd\xff\x73\x88\xd7\xe5\xa4\x9b\x02\x6c\x2d\x0f\xb3\x1e\x0e\x6c\xf3\xce\xd2\x26\x81\x03\x76\x8b\xa0\x89\x70\x66\xc9\x0c\x09\x51\xb6\x92\xc0\x40\xd8\x3b\xfd\x6a\xc9\xbd\x65\x59\x2d\x9c\x43\x67\x6f\x4f\xc0\x4b\xc8\x18\x42\x62\x44\x9e\x5f\x7b\x4b\xa9\x51\x80\x96\x89\xc0\x41\xf7\xa3\x4b\xb3\xe1\x65\xf1\xef\x01\x74\xe0\x37\x2b\x85\xa6\x76\xf6\xf6\x8e\x8e\x4b\xe2\xe0\x1c\x7e\x3e\xfa\xa5\x03\x2f\xa1\xf3\xfa\x35\x7d\x9c\x9c\xfc\x94\x27\xea\x1b\xeb\xb7\xbd\x8d\xfa\xa9\xbb\x52\x2c\x8a\x50\xe5\x5e\x5a\xef\x37\x80\x77\x65\xc6\x13\x64\x9f\xeb\xb2\x53\xe6\x3f\x49\x41\x5d\x0a\xa2\x95\xef\xbf\x3e\x7a\x6e\x2e\x78\x3b\xe5\x4e\xbe\x81\x72\x59\xae\x90\x21\xf7\x0a\x26\x49\x4b\xfd\xfa\x93\x91\xb0\x14\x91\xa8\x68\x40\x71\x96\x99\x34\x8b\xb5\xb7\xe7\x2c\x76\xe9\xa2\xfe\x56\x4a\x78\x89\x13\x56\x7c\x92\x9c\x10\x19\xed\xa5\x2e\x29\x1e\xb2\xb9\x49\x63\x86\x9d\x92\xed\x7c\xdd\xc8\xe7\x6d\x7b\xa2\x21\x0f\x75\xf0\x12\x8e\xbe\x9c\xd5\xd7\xfd\x58\xb2\x4e\xa6\xa7\xe3\xd2\x25\x64\x20\x14\x51\x88\x12\x47\xcd\xc5\x22\x28\x1a\x37\x20\x7a\x9c\x64\x35\x4b\x9a\xad\x0d\xdb\xbe\x57\xca\x5e\x83\xf9\xcf\xcd\xda\x6d\x72\xe7\xbb\x36\x35\xae\x1c\x2f\xb3\xb5\xdd\xf0\xa8\x74\xc9\x0c\xd1\x2c\xa5\x0d\x6f\xb7\x42\xae\xd2\x28\x95\xce\x5c\xa1\x42\x60\x81\x42\xe6\xaf\x13\xa4\xef\xd0\xa7\x82\xea\x73\xef\x9a\x15\x43\x9a\xad\x9a\x61\xdf\xf1\x54\x89\x7f\xfe\xf3\xdd\x39\x59\x85\x3b\xe7\x81\xd9\x20\x75\xda\x98\x31\x39\xc9\x28\x5f\xd8\x19\xfb\x49\x3a\x03\xbd\xcb\x16\x78\x32\x8e\x02\xd4\x10\xc5\x7a\x99\x6c\xcf\x73\x91\xad\x28\x6f\xb6\x6a\xc6\xfc\x0d\x90\x3d\xea\x7c\x03\xb4\xce\xc7\x8b\xfe\xcd\xa5\x73\xe9\xda\x80\x92\x1e\xcf\x95\x72\xd5\x0b\x2e\xbc\x20\xf6\xb3\x04\xce\x28\xc6\x03\xfa\x18\xde\xf4\xfb\x36\x24\x31\xcf\xa0\x6a\x65\x41\xc4\xe7\x0a\x3d\x23\xd5\x1a\xac\xc6\x24\x90\x7a\x4c\xc0\x0c\x69\x2b\xf1\x9e\x84\x25\xdc\xbe\x45\x25\x30\x48\xf7\xa0\x28\x6b\x6d\x18\x74\x7a\x92\x16\x23\x92\xa9\x2e\x09\x7e\x22\x3d\x4e\x8d\xa9\x4c\xb1\xe9\x59\xb6\xd7\x75\x61\x7f\x14\x11\x36\xad\x33\xd5\xcd\x28\xa2\x6c\x4d\x6a\x95\x8f\x91\xb2\x54\x4c\x4f\x15\xba\x9c\xf2\x3b\xb4\x11\x33\xc9\x13\x57\xcc\x78\xcb\x0c\x4c\x81\xab\x1c\x30\x72\x93\x15\x25\x22\x29\x70\x36\x24\x24\x74\x25\xa5\x5f\xc1\xd8\xaa\x7d\xcd\x56\xdd\xe0\x47\x39\xfb\x1c\xbe\x56\xe4\xed\x48\xda\xc3\xce\xc9\x8e\x94\x1d\x8d\xaf\x2f\x9c\xc9\x84\xaa\x80\x53\x67\xec\x76\xfb\xfd\xeb\x0f\xa5\x52\x67\xea\x98\xcc\xb3\xa7\x79\xb6\x2d\x2c\x08\xe4\xaa\x74\x6c\x13\x5d\x59\x7e\xfb\xc8\x53\xb5\x6d\xa2\x8f\xb6\x28\xbd\x74\x86\x9f\x9e\xd4\xe9\x2b\x19\x7d\xad\x4a\x2b\xb8\xb3\x6d\x99\x17\xf4\xd8\xe5\x0e\xba\x93\xf7\xd6\x43\x75\x5e\x4d\x4b\xb5\x96\xa5\xe7\x51\x91\x2e\xaa\x0f\xa9\x2a\x4b\x3c\xde\xa2\xb1\x37\x7c\xe7\x8c\x7b\x53\xe7\x92\xde\x6d\x02\xb6\xc8\xf4\x45\xdc\x2f\xcb\xa7\xfd\x64\xfe\x16\x97\x86\xd5\x12\xe9\x35\x32\x19\x8b\x45\x61\x75\x2e\xd5\xad\xbd\x68\x49\x9d\xc4\x84\x4c\x8a\xa2\x44\x2c\x94\x74\xf4\xd9\xb9\xa4\xcd\x30\x7d\x0b\x78\xcf\x8d\x6e\x3f\xb4\x90\xc2\xd0\x23\xf8\xed\x37\xf8\xa9\x52\xef\x4d\xb4\x3f\xf6\xd2\x56\x47\x2d\x79\x68\xdb\x32\x3f\xbd\x8d\xd6\xb4\x8f\x7a\x97\xfb\xdb\xda\x2f\xae\x07\x83\xad\x1d\x37\x0f\x4c\x78\xfb\x90\xa0\xb7\xe3\xeb\x9b\xd1\xd6\xae\x41\xf7\x63\xee\x16\x9e\x0c\xc3\xf4\x76\xfc\x8c\xc8\x68\x47\xa7\xbb\x58\x0a\x8b\x25\x21\xa5\x88\x48\xad\x9f\xa7\xdd\xc9\x7b\xbb\xa8\x5a\x5d\x9d\xfb\xd9\x59\x58\x0d\x84\x89\xc7\x49\x45\x75\xb0\x75\xcd\x0d\x52\x1a\x25\x89\xa6\xe1\xfe\xf6\x73\xb9\x0d\x1f\x32\x16\x64\x3b\x64\x14\x62\xba\xbb\xb3\x94\x31\x44\xff\x3b\x66\xd0\xb7\x99\x38\xf8\xa8\x3d\x14\x3e\xcb\x35\x51\x44\x4d\x74\x51\x8a\x65\x89\x6a\xe8\xa2\x97\x94\x03\x33\x9e\xad\x6d\x37\x31\x93\x5e\x8a\x1f\x0d\xaf\xc5\x8a\x9b\xad\xf2\xf2\x77\x0f\xab\x4f\x24\xb2\x0f\xc6\xd0\xd3\xe3\x9f\x77\x0d\xa2\xe5\xfd\xff\xca\x7d\x4b\xb7\xc2\x46\xe7\x1d\xf2\xa9\x92\xe6\x66\xab\x62\xc7\x37\x9f\x4b\x05\x7b\x77\x81\xf3\xe4\xf0\x97\x5d\x8b\x76\x31\xf7\x77\x03\x33\xe6\xfe\x2e\x18\x16\xfa\x9a\x2d\x88\xff\x8b\x14\xfc\x06\xcc\x16\xbb\x62\xb6\xd8\x0d\xb3\x42\x5f\xb3\x05\x8b\xff\x4d\xcc\xbc\x85\x92\x71\xb4\x1b\x6c\xc9\x5c\xe8\x5d\xee\x02\x5e\x45\x71\xb3\x55\x33\xe4\x1b\x20\x3c\x3d\xf9\xce\x10\x56\xcf\x75\xed\x5a\xd8\x5c\xcf\x5e\x14\x5f\xc1\x30\x0e\x67\xc9\x43\xbb\x6d\xcf\x53\x13\x39\x07\x64\xde\x32\x3f\x87\xd2\x85\x13\x65\xd2\x53\x8a\x89\x74\x4a\xc0\xb5\x01\xae\xe9\xfd\x13\xc3\xc8\xac\xf7\x29\x40\x6c\x6e\x47\x2a\x29\xbb\x21\x51\x3d\xfb\x47\xfb\x53\x2f\x6e\x3f\x6c\xf2\x18\xa1\x4f\x69\x12\xb7\x37\x17\xb0\x7f\xb8\xa0\xdf\x78\xd5\x37\xef\xa9\x13\xeb\xe1\x35\x37\x5b\x8f\x22\xf2\xd7\x55\x03\x4b\xdd\xd5\xbd\xdd\x96\xe2\xec\xb4\xd3\x93\xde\xf0\x6d\xdf\x71\xaf\xc6\xdd\xb7\x03\x67\x38\x9d\xe4\xc5\xe7\xf4\x67\x60\x74\x5a\xd0\x4d\x8d\xcd\x64\x6c\x36\xaa\xd4\x04\x3c\x82\x66\xa1\x7d\x64\xf5\xb9\xcd\xb1\xed\xaf\xdf\x50\xef\xc3\x52\xae\x30\x79\x08\xa0\xf4\x82\x76\xc7\xae\x51\xd3\x5e\xdb\x97\x02\x29\x82\x35\x2c\x65\x40\xbf\x69\x4b\xdf\x18\x98\x01\x06\x86\x87\x68\x45\x2b\x9c\xd3\xeb\xc3\x0a\xed\xe5\xd8\x5b\x4a\xa9\xf1\x57\x2a\x30\x1f\x75\xaa\x85\x65\xe2\x7a\x5e\x58\x3e\x39\x7e\x7d\x94\xa7\xc1\xdb\x17\x68\x87\x90\x1d\x49\x01\xaf\x54\xda\x7f\xe4\xad\xa6\x56\xec\xd3\x5f\xf1\x64\xb3\xa1\xa6\x51\xbf\x4b\x6f\xb5\xb3\xc8\x20\x6b\x02\xea\x17\xea\xed\x06\xfe\xd5\xef\x34\x75\xa3\x9a\xad\x4d\x3b\xbf\x63\xed\x27\x1d\x5c\x33\xc1\x35\xdb\x5d\x68\xe7\x5a\xdb\x0f\x28\x7c\x3e\x6f\xfc\x67\x00\xa3\x4c\x77\x32\xfb\x2a\x00\x00")
+
+func bindataStructsHBytes() ([]byte, error) {
+	return bindataRead(
+		_bindataStructsH,
+		"/structs.h",
+	)
+}
+
+
+
+func bindataStructsH() (*asset, error) {
+	bytes, err := bindataStructsHBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{
+		name: "/structs.h",
+		size: 11003,
+		md5checksum: "",
+		mode: os.FileMode(420),
+		modTime: time.Unix(1792222729, 0),
+	}
+
+	a := &asset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+
+//
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+//
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+}
+
+//
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+// nolint: deadcode
+//
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+//
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or could not be loaded.
+//
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+}
+
+//
+// AssetNames returns the names of the assets.
+// nolint: deadcode
+//
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+//
+// _bindata is a table, holding each asset generator, mapped to its name.
+//
+var _bindata = map[string]func() (*asset, error){
+	"/bpf/bpf.h":         bindataBpfBpfH,
+	"/bpf/bpf_helpers.h": bindataBpfBpfhelpersH,
+	"/bpf/bpf_map.h":     bindataBpfBpfmapH,
+	"/const.h":           bindataConstH,
+	"/dentry.h":          bindataDentryH,
+	"/events/events.h":   bindataEventsEventsH,
+	"/events/exec.h":     bindataEventsExecH,
+	"/events/link.h":     bindataEventsLinkH,
+	"/events/mkdir.h":    bindataEventsMkdirH,
+	"/events/modify.h":   bindataEventsModifyH,
+	"/events/open.h":     bindataEventsOpenH,
+	"/events/rename.h":   bindataEventsRenameH,
+	"/events/rmdir.h":    bindataEventsRmdirH,
+	"/events/setattr.h":  bindataEventsSetattrH,
+	"/events/symlink.h":  bindataEventsSymlinkH,
+	"/events/unlink.h":   bindataEventsUnlinkH,
+	"/filter.h":          bindataFilterH,
+	"/main.c":            bindataMainC,
+	"/main.h":            bindataMainH,
+	"/process.h":         bindataProcessH,
+	"/process_tree.h":    bindataProcesstreeH,
+	"/structs.h":         bindataStructsH,
+}
+
+//
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+//
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, &os.PathError{
+					Op: "open",
+					Path: name,
+					Err: os.ErrNotExist,
+				}
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, &os.PathError{
+			Op: "open",
+			Path: name,
+			Err: os.ErrNotExist,
+		}
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+
+type bintree struct {
+	Func     func() (*asset, error)
+	Children map[string]*bintree
+}
+
+var _bintree = &bintree{Func: nil, Children: map[string]*bintree{
+	"": {Func: nil, Children: map[string]*bintree{
+		"bpf": {Func: nil, Children: map[string]*bintree{
+			"bpf.h": {Func: bindataBpfBpfH, Children: map[string]*bintree{}},
+			"bpf_helpers.h": {Func: bindataBpfBpfhelpersH, Children: map[string]*bintree{}},
+			"bpf_map.h": {Func: bindataBpfBpfmapH, Children: map[string]*bintree{}},
+		}},
+		"const.h": {Func: bindataConstH, Children: map[string]*bintree{}},
+		"dentry.h": {Func: bindataDentryH, Children: map[string]*bintree{}},
+		"events": {Func: nil, Children: map[string]*bintree{
+			"events.h": {Func: bindataEventsEventsH, Children: map[string]*bintree{}},
+			"exec.h": {Func: bindataEventsExecH, Children: map[string]*bintree{}},
+			"link.h": {Func: bindataEventsLinkH, Children: map[string]*bintree{}},
+			"mkdir.h": {Func: bindataEventsMkdirH, Children: map[string]*bintree{}},
+			"modify.h": {Func: bindataEventsModifyH, Children: map[string]*bintree{}},
+			"open.h": {Func: bindataEventsOpenH, Children: map[string]*bintree{}},
+			"rename.h": {Func: bindataEventsRenameH, Children: map[string]*bintree{}},
+			"rmdir.h": {Func: bindataEventsRmdirH, Children: map[string]*bintree{}},
+			"setattr.h": {Func: bindataEventsSetattrH, Children: map[string]*bintree{}},
+			"symlink.h": {Func: bindataEventsSymlinkH, Children: map[string]*bintree{}},
+			"unlink.h": {Func: bindataEventsUnlinkH, Children: map[string]*bintree{}},
+		}},
+		"filter.h": {Func: bindataFilterH, Children: map[string]*bintree{}},
+		"main.c": {Func: bindataMainC, Children: map[string]*bintree{}},
+		"main.h": {Func: bindataMainH, Children: map[string]*bintree{}},
+		"process.h": {Func: bindataProcessH, Children: map[string]*bintree{}},
+		"process_tree.h": {Func: bindataProcesstreeH, Children: map[string]*bintree{}},
+		"structs.h": {Func: bindataStructsH, Children: map[string]*bintree{}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	if err != nil {
+		return err
+	}
+	return os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+	children, err := AssetDir(name)
+	// File
+	if err != nil {
+		return RestoreAsset(dir, name)
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _filePath(dir, name string) string {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
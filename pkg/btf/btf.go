@@ -0,0 +1,248 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package btf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+)
+
+// KernelBTFPath - Path to the BTF type information of the running kernel
+const KernelBTFPath = "/sys/kernel/btf/vmlinux"
+
+const (
+	btfMagic = 0xeB9F
+
+	kindInt       = 1
+	kindPtr       = 2
+	kindArray     = 3
+	kindStruct    = 4
+	kindUnion     = 5
+	kindEnum      = 6
+	kindFwd       = 7
+	kindTypedef   = 8
+	kindVolatile  = 9
+	kindConst     = 10
+	kindRestrict  = 11
+	kindFunc      = 12
+	kindFuncProto = 13
+	kindVar       = 14
+	kindDatasec   = 15
+	kindFloat     = 16
+	kindDeclTag   = 17
+	kindTypeTag   = 18
+	kindEnum64    = 19
+)
+
+// ErrNotFound - Returned when a type or a member couldn't be found in the BTF type information
+var ErrNotFound = errors.New("not found")
+
+// header - BTF header, see include/uapi/linux/btf.h
+type header struct {
+	Magic   uint16
+	Version uint8
+	Flags   uint8
+	HdrLen  uint32
+	TypeOff uint32
+	TypeLen uint32
+	StrOff  uint32
+	StrLen  uint32
+}
+
+// Member - Member of a struct or an union
+type Member struct {
+	Name      string
+	Type      uint32
+	BitOffset uint32
+}
+
+// Type - BTF type. Only the data needed to compute the offsets of struct members is kept.
+type Type struct {
+	Name    string
+	Kind    uint8
+	Type    uint32
+	Members []Member
+}
+
+// Spec - BTF type information
+type Spec struct {
+	types  []*Type
+	byName map[string][]uint32
+}
+
+// LoadKernelSpec - Loads the BTF type information of the running kernel
+func LoadKernelSpec() (*Spec, error) {
+	data, err := ioutil.ReadFile(KernelBTFPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't read %s", KernelBTFPath)
+	}
+	return LoadSpec(data)
+}
+
+// LoadSpec - Parses raw BTF type information
+func LoadSpec(data []byte) (*Spec, error) {
+	var hdr header
+	var byteOrder binary.ByteOrder = binary.LittleEndian
+	if err := binary.Read(bytes.NewReader(data), byteOrder, &hdr); err != nil {
+		return nil, errors.Wrap(err, "couldn't read BTF header")
+	}
+	if hdr.Magic != btfMagic {
+		byteOrder = binary.BigEndian
+		if err := binary.Read(bytes.NewReader(data), byteOrder, &hdr); err != nil || hdr.Magic != btfMagic {
+			return nil, errors.New("invalid BTF magic")
+		}
+	}
+	typeStart := uint64(hdr.HdrLen) + uint64(hdr.TypeOff)
+	strStart := uint64(hdr.HdrLen) + uint64(hdr.StrOff)
+	if typeStart+uint64(hdr.TypeLen) > uint64(len(data)) || strStart+uint64(hdr.StrLen) > uint64(len(data)) {
+		return nil, errors.New("truncated BTF data")
+	}
+	strs := data[strStart : strStart+uint64(hdr.StrLen)]
+	spec := &Spec{
+		// Type ID 0 is reserved for void
+		types:  []*Type{{}},
+		byName: make(map[string][]uint32),
+	}
+	raw := data[typeStart : typeStart+uint64(hdr.TypeLen)]
+	for len(raw) > 0 {
+		if len(raw) < 12 {
+			return nil, errors.New("truncated BTF type")
+		}
+		nameOff := byteOrder.Uint32(raw[0:4])
+		info := byteOrder.Uint32(raw[4:8])
+		t := &Type{
+			Name: btfString(strs, nameOff),
+			Kind: uint8((info >> 24) & 0x1f),
+			Type: byteOrder.Uint32(raw[8:12]),
+		}
+		vlen := int(info & 0xffff)
+		kindFlag := info>>31 == 1
+		raw = raw[12:]
+
+		var extra int
+		switch t.Kind {
+		case kindInt, kindVar, kindDeclTag:
+			extra = 4
+		case kindArray:
+			extra = 12
+		case kindStruct, kindUnion:
+			extra = vlen * 12
+			if len(raw) < extra {
+				return nil, errors.New("truncated BTF members")
+			}
+			for i := 0; i < vlen; i++ {
+				m := raw[i*12 : (i+1)*12]
+				offset := byteOrder.Uint32(m[8:12])
+				if kindFlag {
+					// The upper 8 bits hold the size of the bitfield
+					offset &= 0xffffff
+				}
+				t.Members = append(t.Members, Member{
+					Name:      btfString(strs, byteOrder.Uint32(m[0:4])),
+					Type:      byteOrder.Uint32(m[4:8]),
+					BitOffset: offset,
+				})
+			}
+		case kindEnum, kindFuncProto:
+			extra = vlen * 8
+		case kindDatasec, kindEnum64:
+			extra = vlen * 12
+		case kindPtr, kindFwd, kindTypedef, kindVolatile, kindConst, kindRestrict, kindFunc, kindFloat, kindTypeTag:
+			extra = 0
+		default:
+			return nil, errors.Errorf("unknown BTF kind %d", t.Kind)
+		}
+		if len(raw) < extra {
+			return nil, errors.New("truncated BTF type")
+		}
+		raw = raw[extra:]
+
+		if len(t.Name) > 0 {
+			spec.byName[t.Name] = append(spec.byName[t.Name], uint32(len(spec.types)))
+		}
+		spec.types = append(spec.types, t)
+	}
+	return spec, nil
+}
+
+// btfString - Returns the string at the provided offset of the string section
+func btfString(strs []byte, offset uint32) string {
+	if int(offset) >= len(strs) {
+		return ""
+	}
+	end := bytes.IndexByte(strs[offset:], 0)
+	if end < 0 {
+		return string(strs[offset:])
+	}
+	return string(strs[offset : int(offset)+end])
+}
+
+// MemberOffset - Returns the offset in bytes of the provided member of a struct. Members of anonymous structs and
+// unions are looked up as well.
+func (s *Spec) MemberOffset(structName string, member string) (uint64, error) {
+	for _, id := range s.byName[structName] {
+		t := s.types[id]
+		if t.Kind != kindStruct || len(t.Members) == 0 {
+			continue
+		}
+		if offset, ok := s.memberBitOffset(t, member); ok {
+			return offset / 8, nil
+		}
+		return 0, errors.Wrapf(ErrNotFound, "couldn't find member %s of struct %s", member, structName)
+	}
+	return 0, errors.Wrapf(ErrNotFound, "couldn't find struct %s", structName)
+}
+
+// memberBitOffset - Looks for a member recursively and returns its offset in bits
+func (s *Spec) memberBitOffset(t *Type, member string) (uint64, bool) {
+	for _, m := range t.Members {
+		if m.Name == member {
+			return uint64(m.BitOffset), true
+		}
+		if len(m.Name) > 0 {
+			continue
+		}
+		// Anonymous struct or union
+		inner := s.resolve(m.Type)
+		if inner == nil || (inner.Kind != kindStruct && inner.Kind != kindUnion) {
+			continue
+		}
+		if offset, ok := s.memberBitOffset(inner, member); ok {
+			return uint64(m.BitOffset) + offset, true
+		}
+	}
+	return 0, false
+}
+
+// resolve - Skips the typedefs and type qualifiers of the provided type
+func (s *Spec) resolve(id uint32) *Type {
+	for i := 0; i < len(s.types); i++ {
+		if int(id) >= len(s.types) {
+			return nil
+		}
+		t := s.types[id]
+		switch t.Kind {
+		case kindTypedef, kindVolatile, kindConst, kindRestrict, kindTypeTag:
+			id = t.Type
+		default:
+			return t
+		}
+	}
+	return nil
+}
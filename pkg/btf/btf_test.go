@@ -0,0 +1,227 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package btf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// btfBuilder - Builds raw BTF type information for the tests
+type btfBuilder struct {
+	order binary.ByteOrder
+	types bytes.Buffer
+	strs  []byte
+	count uint32
+}
+
+func newBTFBuilder(order binary.ByteOrder) *btfBuilder {
+	return &btfBuilder{
+		order: order,
+		strs:  []byte{0},
+	}
+}
+
+// str - Adds a string to the string section and returns its offset
+func (b *btfBuilder) str(s string) uint32 {
+	if len(s) == 0 {
+		return 0
+	}
+	offset := uint32(len(b.strs))
+	b.strs = append(append(b.strs, s...), 0)
+	return offset
+}
+
+// add - Adds a type and returns its ID. data holds the words that follow the common type header.
+func (b *btfBuilder) add(name string, kind uint8, vlen int, kindFlag bool, sizeOrType uint32, data ...uint32) uint32 {
+	info := uint32(kind)<<24 | uint32(vlen)
+	if kindFlag {
+		info |= 1 << 31
+	}
+	for _, word := range append([]uint32{b.str(name), info, sizeOrType}, data...) {
+		_ = binary.Write(&b.types, b.order, word)
+	}
+	b.count++
+	return b.count
+}
+
+// member - Returns the words of a struct member
+func (b *btfBuilder) member(name string, typeID uint32, offset uint32) []uint32 {
+	return []uint32{b.str(name), typeID, offset}
+}
+
+func (b *btfBuilder) bytes() []byte {
+	var buf bytes.Buffer
+	hdr := header{
+		Magic:   btfMagic,
+		Version: 1,
+		HdrLen:  24,
+		TypeOff: 0,
+		TypeLen: uint32(b.types.Len()),
+		StrOff:  uint32(b.types.Len()),
+		StrLen:  uint32(len(b.strs)),
+	}
+	_ = binary.Write(&buf, b.order, hdr)
+	buf.Write(b.types.Bytes())
+	buf.Write(b.strs)
+	return buf.Bytes()
+}
+
+// members - Concatenates the words of struct members
+func members(m ...[]uint32) []uint32 {
+	var words []uint32
+	for _, w := range m {
+		words = append(words, w...)
+	}
+	return words
+}
+
+// mountFixture - Returns a struct mount laid out like:
+//
+//	struct mount {
+//		struct list_head mnt_hash;        // 0
+//		struct mount *mnt_parent;         // 16
+//		struct vfsmount mnt;              // 24
+//		union {                           // 32
+//			struct list_head mnt_rcu;
+//			struct { int mnt_a; int mnt_b; };
+//		};
+//		const struct { int mnt_c; int mnt_d; }; // 48
+//		int mnt_id;                       // 56
+//		int mnt_flags:4;                  // 60
+//	};
+func mountFixture(order binary.ByteOrder) []byte {
+	b := newBTFBuilder(order)
+	intID := b.add("int", kindInt, 0, false, 4, 0x00000020)
+	ptrID := b.add("", kindPtr, 0, false, 0)
+	// Types that are only skipped by the parser
+	b.add("mount", kindFwd, 0, false, 0)
+	b.add("", kindArray, 0, false, 0, intID, intID, 4)
+	b.add("state", kindEnum, 2, false, 4, b.str("ON"), 1, b.str("OFF"), 0)
+	b.add("", kindFuncProto, 1, false, intID, b.str("arg"), intID)
+	b.add("counter", kindVar, 0, false, intID, 1)
+	b.add(".data", kindDatasec, 1, false, 0, 7, 0, 4)
+	listHeadID := b.add("list_head", kindStruct, 2, false, 16, members(
+		b.member("next", ptrID, 0),
+		b.member("prev", ptrID, 64),
+	)...)
+	vfsmountID := b.add("vfsmount", kindStruct, 1, false, 8, b.member("mnt_root", ptrID, 0)...)
+	anonStructID := b.add("", kindStruct, 2, false, 8, members(
+		b.member("mnt_a", intID, 0),
+		b.member("mnt_b", intID, 32),
+	)...)
+	anonUnionID := b.add("", kindUnion, 2, false, 16, members(
+		b.member("mnt_rcu", listHeadID, 0),
+		b.member("", anonStructID, 0),
+	)...)
+	innerID := b.add("", kindStruct, 2, false, 8, members(
+		b.member("mnt_c", intID, 0),
+		b.member("mnt_d", intID, 32),
+	)...)
+	constID := b.add("", kindConst, 0, false, innerID)
+	b.add("mount", kindStruct, 7, true, 64, members(
+		b.member("mnt_hash", listHeadID, 0),
+		b.member("mnt_parent", ptrID, 128),
+		b.member("mnt", vfsmountID, 192),
+		b.member("", anonUnionID, 256),
+		b.member("", constID, 384),
+		b.member("mnt_id", intID, 448),
+		b.member("mnt_flags", intID, 4<<24|480),
+	)...)
+	return b.bytes()
+}
+
+func TestMemberOffset(t *testing.T) {
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		spec, err := LoadSpec(mountFixture(order))
+		if err != nil {
+			t.Fatalf("%v: %v", order, err)
+		}
+		tests := []struct {
+			structName string
+			member     string
+			offset     uint64
+			notFound   bool
+		}{
+			{structName: "list_head", member: "prev", offset: 8},
+			{structName: "mount", member: "mnt_hash", offset: 0},
+			{structName: "mount", member: "mnt", offset: 24},
+			// Anonymous union
+			{structName: "mount", member: "mnt_rcu", offset: 32},
+			// Anonymous struct in an anonymous union
+			{structName: "mount", member: "mnt_b", offset: 36},
+			// Anonymous struct behind a type qualifier
+			{structName: "mount", member: "mnt_d", offset: 52},
+			{structName: "mount", member: "mnt_id", offset: 56},
+			// Bitfield of a struct with the kind flag set
+			{structName: "mount", member: "mnt_flags", offset: 60},
+			{structName: "mount", member: "mnt_instance", notFound: true},
+			{structName: "super_block", member: "s_mounts", notFound: true},
+			// Members of anonymous types aren't members of the type itself
+			{structName: "vfsmount", member: "mnt_a", notFound: true},
+		}
+		for _, tt := range tests {
+			offset, err := spec.MemberOffset(tt.structName, tt.member)
+			if tt.notFound {
+				if errors.Cause(err) != ErrNotFound {
+					t.Errorf("%v: %s.%s: expected ErrNotFound, got %d, %v", order, tt.structName, tt.member, offset, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Errorf("%v: %s.%s: %v", order, tt.structName, tt.member, err)
+				continue
+			}
+			if offset != tt.offset {
+				t.Errorf("%v: %s.%s: expected offset %d, got %d", order, tt.structName, tt.member, tt.offset, offset)
+			}
+		}
+	}
+}
+
+func TestLoadSpecErrors(t *testing.T) {
+	data := mountFixture(binary.LittleEndian)
+	tests := map[string][]byte{
+		"empty":            {},
+		"truncated header": data[:10],
+		"truncated types":  data[:40],
+		"invalid magic":    append([]byte{0x12, 0x34}, data[2:]...),
+	}
+	for name, raw := range tests {
+		if _, err := LoadSpec(raw); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	b := newBTFBuilder(binary.LittleEndian)
+	b.add("unknown", 31, 0, false, 0)
+	if _, err := LoadSpec(b.bytes()); err == nil {
+		t.Error("unknown kind: expected an error")
+	}
+}
+
+func TestLoadKernelSpec(t *testing.T) {
+	spec, err := LoadKernelSpec()
+	if err != nil {
+		t.Skipf("kernel BTF unavailable: %v", err)
+	}
+	if _, err := spec.MemberOffset("mount", "mnt_id"); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package client is a Go client of the gRPC API of `fsprobe serve`
+package client
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/Gui774ume/fsprobe/pkg/api"
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// DefaultAddress - Default address of the gRPC API of fsprobe serve
+const DefaultAddress = "unix:///run/fsprobe-grpc.sock"
+
+// Client - Client of the gRPC API of fsprobe serve
+type Client struct {
+	conn *grpc.ClientConn
+	api  api.FSProbeClient
+}
+
+// Dial - Connects to the gRPC API at the provided address, either unix:///path/to/socket or tcp://host:port
+func Dial(address string, opts ...grpc.DialOption) (*Client, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid address %s", address)
+	}
+	var target string
+	switch u.Scheme {
+	case "unix":
+		target = "unix://" + u.Path
+	case "tcp":
+		target = u.Host
+	default:
+		return nil, errors.Errorf("unknown scheme: %s", u.Scheme)
+	}
+	opts = append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)
+	conn, err := grpc.Dial(target, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't connect to %s", address)
+	}
+	return &Client{
+		conn: conn,
+		api:  api.NewFSProbeClient(conn),
+	}, nil
+}
+
+// Close - Closes the connection to the server
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Watch - Watches the provided paths until they are unwatched, even after the client disconnects
+func (c *Client) Watch(ctx context.Context, paths ...string) error {
+	_, err := c.api.Watch(ctx, &api.WatchRequest{Paths: paths})
+	return err
+}
+
+// Unwatch - Releases the paths watched with Watch
+func (c *Client) Unwatch(ctx context.Context, paths ...string) error {
+	_, err := c.api.Unwatch(ctx, &api.UnwatchRequest{Paths: paths})
+	return err
+}
+
+// ListWatches - Returns the watched paths, along with their number of references
+func (c *Client) ListWatches(ctx context.Context) (map[string]uint32, error) {
+	resp, err := c.api.ListWatches(ctx, &api.ListWatchesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	watches := make(map[string]uint32)
+	for _, w := range resp.GetWatches() {
+		watches[w.GetPath()] = w.GetReferences()
+	}
+	return watches, nil
+}
+
+// GetStats - Returns the self-monitoring statistics of the server
+func (c *Client) GetStats(ctx context.Context) (model.Stats, error) {
+	resp, err := c.api.GetStats(ctx, &api.GetStatsRequest{})
+	if err != nil {
+		return model.Stats{}, err
+	}
+	return resp.ToModel(), nil
+}
+
+// StreamOptions - Options of an event stream
+type StreamOptions struct {
+	// Paths - Paths watched for the lifetime of the stream. Only the events on these paths are streamed. When empty,
+	// the events of all the watched paths are streamed.
+	Paths []string
+	// Events - Event types to stream, all the event types are streamed if empty
+	Events []model.EventName
+	// Filter - Filter expression, only the matching events are streamed
+	Filter string
+	// BufferSize - Number of events buffered by the server for the stream
+	BufferSize uint32
+	// Backpressure - Behavior of the server buffer when it is full, either model.BackpressureDropNewest (default) or
+	// model.BackpressureDropOldest
+	Backpressure model.BackpressurePolicy
+}
+
+// EventStream - Stream of events received from the server
+type EventStream struct {
+	stream api.FSProbe_StreamEventsClient
+}
+
+// StreamEvents - Opens a stream of the events matching the provided options. The stream is closed when the context
+// is cancelled.
+func (c *Client) StreamEvents(ctx context.Context, options StreamOptions) (*EventStream, error) {
+	req := &api.StreamEventsRequest{
+		Paths:      options.Paths,
+		Filter:     options.Filter,
+		BufferSize: options.BufferSize,
+	}
+	for _, evt := range options.Events {
+		req.Events = append(req.Events, string(evt))
+	}
+	if options.Backpressure != model.BackpressureBlock {
+		req.Backpressure = options.Backpressure.String()
+	}
+	stream, err := c.api.StreamEvents(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &EventStream{stream: stream}, nil
+}
+
+// Recv - Returns the next message of the stream: either an event, or a notification of the events lost since the
+// previous notification. io.EOF is returned once the server closed the stream.
+func (es *EventStream) Recv() (*model.FSEvent, *model.LostEvt, error) {
+	resp, err := es.stream.Recv()
+	if err != nil {
+		return nil, nil, err
+	}
+	if evt := resp.GetEvent(); evt != nil {
+		return evt.ToModel(), nil, nil
+	}
+	if lost := resp.GetLost(); lost != nil {
+		return nil, lost.ToModel(), nil
+	}
+	return nil, nil, errors.New("empty message")
+}
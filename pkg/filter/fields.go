@@ -0,0 +1,178 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package filter
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// fieldKind - Type of the values of a field
+type fieldKind int
+
+const (
+	// numberField - Field holding an integer
+	numberField fieldKind = iota
+	// stringField - Field holding a string
+	stringField
+	// eventField - Field holding an event type
+	eventField
+	// flagsField - Field holding open or setattr flags
+	flagsField
+)
+
+// String - Returns a human readable representation of the field kind
+func (fk fieldKind) String() string {
+	switch fk {
+	case numberField:
+		return "number"
+	case stringField:
+		return "string"
+	case eventField:
+		return "event type"
+	case flagsField:
+		return "flags"
+	default:
+		return "unknown"
+	}
+}
+
+// field - Filterable FSEvent field
+type field struct {
+	kind      fieldKind
+	getNumber func(evt *model.FSEvent) int64
+	getString func(evt *model.FSEvent) string
+}
+
+// fields - Filterable FSEvent fields, indexed by name. Names match the JSON output of FSEvent, cmdline
+// is the space separated argv.
+var fields = map[string]field{
+	"pid":                   {kind: numberField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.Pid) }},
+	"tid":                   {kind: numberField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.Tid) }},
+	"ppid":                  {kind: numberField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.Ppid) }},
+	"login_uid":             {kind: numberField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.LoginUID) }},
+	"ns_pid":                {kind: numberField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.NsPid) }},
+	"ns_tid":                {kind: numberField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.NsTid) }},
+	"mnt_ns":                {kind: numberField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.MntNs) }},
+	"pid_ns":                {kind: numberField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.PidNs) }},
+	"cgroup_id":             {kind: numberField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.CgroupID) }},
+	"uid":                   {kind: numberField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.UID) }},
+	"gid":                   {kind: numberField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.GID) }},
+	"mode":                  {kind: numberField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.Mode) }},
+	"retval":                {kind: numberField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.Retval) }},
+	"src_inode":             {kind: numberField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.SrcInode) }},
+	"src_mount_id":          {kind: numberField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.SrcMountID) }},
+	"target_inode":          {kind: numberField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.TargetInode) }},
+	"target_mount_id":       {kind: numberField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.TargetMountID) }},
+	"flags":                 {kind: flagsField, getNumber: func(evt *model.FSEvent) int64 { return int64(evt.Flags) }},
+	"comm":                  {kind: stringField, getString: func(evt *model.FSEvent) string { return evt.Comm }},
+	"exe":                   {kind: stringField, getString: func(evt *model.FSEvent) string { return evt.Exe }},
+	"cmdline":               {kind: stringField, getString: func(evt *model.FSEvent) string { return strings.Join(evt.Argv, " ") }},
+	"cwd":                   {kind: stringField, getString: func(evt *model.FSEvent) string { return evt.Cwd }},
+	"cgroup_path":           {kind: stringField, getString: func(evt *model.FSEvent) string { return evt.CgroupPath }},
+	"container_id":          {kind: stringField, getString: func(evt *model.FSEvent) string { return evt.ContainerID }},
+	"src_filename":          {kind: stringField, getString: func(evt *model.FSEvent) string { return evt.SrcFilename }},
+	"target_filename":       {kind: stringField, getString: func(evt *model.FSEvent) string { return evt.TargetFilename }},
+	"src_container_path":    {kind: stringField, getString: func(evt *model.FSEvent) string { return evt.SrcContainerPath }},
+	"src_host_path":         {kind: stringField, getString: func(evt *model.FSEvent) string { return evt.SrcHostPath }},
+	"target_container_path": {kind: stringField, getString: func(evt *model.FSEvent) string { return evt.TargetContainerPath }},
+	"target_host_path":      {kind: stringField, getString: func(evt *model.FSEvent) string { return evt.TargetHostPath }},
+	"mount_point":           {kind: stringField, getString: func(evt *model.FSEvent) string { return evt.MountPoint }},
+	"fs_type":               {kind: stringField, getString: func(evt *model.FSEvent) string { return evt.FSType }},
+	"device":                {kind: stringField, getString: func(evt *model.FSEvent) string { return evt.Device }},
+	"event":                 {kind: eventField, getString: func(evt *model.FSEvent) string { return string(evt.EventType) }},
+}
+
+// fieldAliases - Shorter names of some fields
+var fieldAliases = map[string]string{
+	"inode":      "src_inode",
+	"mount_id":   "src_mount_id",
+	"path":       "src_filename",
+	"filename":   "src_filename",
+	"target":     "target_filename",
+	"event_type": "event",
+	"auid":       "login_uid",
+	"container":  "container_id",
+}
+
+// lookupField - Returns the field with the provided name or alias
+func lookupField(name string) (field, bool) {
+	if alias, ok := fieldAliases[name]; ok {
+		name = alias
+	}
+	f, ok := fields[name]
+	return f, ok
+}
+
+// FieldNames - Returns the sorted list of the fields that can be used in a filter expression
+func FieldNames() []string {
+	names := make([]string, 0, len(fields)+len(fieldAliases))
+	for name := range fields {
+		names = append(names, name)
+	}
+	for name := range fieldAliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isEventName - Returns true if the provided name is a known event type
+func isEventName(name string) bool {
+	for i := uint32(0); ; i++ {
+		evt := model.GetEventType(i)
+		if evt == model.Unknown {
+			return false
+		}
+		if string(evt) == name {
+			return true
+		}
+	}
+}
+
+// flagValue - Open or setattr flag. Open access modes (ORDONLY, OWRONLY, ORDWR) are not bits and need to be
+// compared with the access mode of the event.
+type flagValue struct {
+	event      model.EventName
+	value      int64
+	accessMode bool
+}
+
+// lookupFlag - Returns the flag with the provided name
+func lookupFlag(name string) (flagValue, bool) {
+	if flag, ok := model.OpenFlagsByName[name]; ok {
+		accessMode := flag == model.ORDONLY || flag == model.OWRONLY || flag == model.ORDWR
+		return flagValue{event: model.Open, value: int64(flag), accessMode: accessMode}, true
+	}
+	if flag, ok := model.SetAttrFlagsByName[name]; ok {
+		return flagValue{event: model.SetAttr, value: int64(flag)}, true
+	}
+	return flagValue{}, false
+}
+
+// match - Returns true if the flags of the event contain the flag
+func (fv flagValue) match(evt *model.FSEvent) bool {
+	if evt.EventType != fv.event {
+		return false
+	}
+	flags := int64(evt.Flags)
+	if fv.accessMode {
+		return flags&int64(model.OACCMODE) == fv.value
+	}
+	return flags&fv.value == fv.value
+}
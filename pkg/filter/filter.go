@@ -0,0 +1,380 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package filter implements a small expression language used to filter FSEvents in user space.
+//
+// Grammar:
+//
+//	expression := or
+//	or         := and ( ( "||" | "or" ) and )*
+//	and        := unary ( ( "&&" | "and" ) unary )*
+//	unary      := ( "!" | "not" ) unary | primary
+//	primary    := "(" expression ")" | comparison
+//	comparison := field ( "==" | "!=" | "<" | "<=" | ">" | ">=" | "contains" ) value
+//	            | field "in" "(" value ( "," value )* ")"
+//	value      := number | string | identifier
+//
+// Example: uid != 0 && comm != "dpkg" && event in (open, rename) && flags contains OWRONLY
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// ParseError - Error returned when a filter expression is invalid
+type ParseError struct {
+	// Expression - Invalid expression
+	Expression string
+	// Column - 1-based column of the error in the expression
+	Column int
+	// Message - Description of the error
+	Message string
+}
+
+// newParseError - Returns a new ParseError at the provided 0-based position
+func newParseError(expr string, pos int, msg string) *ParseError {
+	return &ParseError{
+		Expression: expr,
+		Column:     pos + 1,
+		Message:    msg,
+	}
+}
+
+// Error - Returns the error message, followed by the expression and a caret pointing at the invalid column
+func (pe *ParseError) Error() string {
+	return fmt.Sprintf("invalid filter at column %d: %s\n  %s\n  %s^", pe.Column, pe.Message, pe.Expression, strings.Repeat(" ", pe.Column-1))
+}
+
+// Filter - Compiled filter expression
+type Filter struct {
+	expression string
+	root       node
+}
+
+// Parse - Compiles the provided filter expression
+func Parse(expr string) (*Filter, error) {
+	tokens, err := lex(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := parser{expr: expr, tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, p.errorf(tok, "unexpected %s", tok)
+	}
+	return &Filter{
+		expression: expr,
+		root:       root,
+	}, nil
+}
+
+// Match - Returns true if the event matches the filter. A nil filter matches all events.
+func (f *Filter) Match(evt *model.FSEvent) bool {
+	if f == nil || evt == nil {
+		return f == nil
+	}
+	return f.root.eval(evt)
+}
+
+// String - Returns the expression of the filter
+func (f *Filter) String() string {
+	return f.expression
+}
+
+// node - Node of the syntax tree of a filter expression
+type node interface {
+	eval(evt *model.FSEvent) bool
+}
+
+type andNode struct {
+	left, right node
+}
+
+func (n andNode) eval(evt *model.FSEvent) bool {
+	return n.left.eval(evt) && n.right.eval(evt)
+}
+
+type orNode struct {
+	left, right node
+}
+
+func (n orNode) eval(evt *model.FSEvent) bool {
+	return n.left.eval(evt) || n.right.eval(evt)
+}
+
+type notNode struct {
+	operand node
+}
+
+func (n notNode) eval(evt *model.FSEvent) bool {
+	return !n.operand.eval(evt)
+}
+
+// comparisonNode - Compares a field of the event with one or more values
+type comparisonNode struct {
+	field   field
+	op      tokenKind
+	numbers []int64
+	strings []string
+	flag    *flagValue
+}
+
+func (n comparisonNode) eval(evt *model.FSEvent) bool {
+	if n.flag != nil {
+		// flags contains <flag name>
+		return n.flag.match(evt)
+	}
+	switch n.field.kind {
+	case numberField, flagsField:
+		value := n.field.getNumber(evt)
+		switch n.op {
+		case tokenEq:
+			return value == n.numbers[0]
+		case tokenNeq:
+			return value != n.numbers[0]
+		case tokenLt:
+			return value < n.numbers[0]
+		case tokenLte:
+			return value <= n.numbers[0]
+		case tokenGt:
+			return value > n.numbers[0]
+		case tokenGte:
+			return value >= n.numbers[0]
+		case tokenContains:
+			return value&n.numbers[0] == n.numbers[0]
+		case tokenIn:
+			for _, number := range n.numbers {
+				if value == number {
+					return true
+				}
+			}
+		}
+	default:
+		value := n.field.getString(evt)
+		switch n.op {
+		case tokenEq:
+			return value == n.strings[0]
+		case tokenNeq:
+			return value != n.strings[0]
+		case tokenContains:
+			return strings.Contains(value, n.strings[0])
+		case tokenIn:
+			for _, str := range n.strings {
+				if value == str {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// parser - Recursive descent parser of filter expressions
+type parser struct {
+	expr   string
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) errorf(tok token, format string, args ...interface{}) *ParseError {
+	return newParseError(p.expr, tok.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) expect(kind tokenKind) (token, error) {
+	tok := p.next()
+	if tok.kind != kind {
+		return tok, p.errorf(tok, "expected %q, got %s", kind.String(), tok)
+	}
+	return tok, nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenAnd {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.peek().kind == tokenNot {
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case tokenLParen:
+		p.next()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRParen); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	case tokenIdent:
+		return p.parseComparison()
+	default:
+		return nil, p.errorf(tok, "expected a field name or \"(\", got %s", tok)
+	}
+}
+
+func (p *parser) parseComparison() (node, error) {
+	fieldTok := p.next()
+	f, ok := lookupField(fieldTok.value)
+	if !ok {
+		return nil, p.errorf(fieldTok, "unknown field %q, available fields: %s", fieldTok.value, strings.Join(FieldNames(), ", "))
+	}
+	opTok := p.next()
+	if err := p.checkOperator(f, fieldTok, opTok); err != nil {
+		return nil, err
+	}
+	n := comparisonNode{field: f, op: opTok.kind}
+
+	var values []token
+	if opTok.kind == tokenIn {
+		if _, err := p.expect(tokenLParen); err != nil {
+			return nil, err
+		}
+		for {
+			values = append(values, p.next())
+			sep := p.next()
+			if sep.kind == tokenRParen {
+				break
+			}
+			if sep.kind != tokenComma {
+				return nil, p.errorf(sep, "expected \",\" or \")\", got %s", sep)
+			}
+		}
+	} else {
+		values = append(values, p.next())
+	}
+
+	for _, value := range values {
+		if err := p.addValue(&n, fieldTok, opTok, value); err != nil {
+			return nil, err
+		}
+	}
+	return n, nil
+}
+
+// checkOperator - Checks that the operator can be applied to the field
+func (p *parser) checkOperator(f field, fieldTok token, opTok token) error {
+	switch opTok.kind {
+	case tokenEq, tokenNeq, tokenIn:
+		return nil
+	case tokenLt, tokenLte, tokenGt, tokenGte:
+		if f.kind == numberField {
+			return nil
+		}
+	case tokenContains:
+		if f.kind == stringField || f.kind == flagsField {
+			return nil
+		}
+	default:
+		return p.errorf(opTok, "expected an operator after field %q, got %s", fieldTok.value, opTok)
+	}
+	return p.errorf(opTok, "operator %q can't be applied to %s field %q", opTok.kind.String(), f.kind, fieldTok.value)
+}
+
+// addValue - Checks the value against the type of the field and adds it to the comparison
+func (p *parser) addValue(n *comparisonNode, fieldTok token, opTok token, value token) error {
+	switch n.field.kind {
+	case numberField, flagsField:
+		if value.kind == tokenIdent && n.field.kind == flagsField && opTok.kind == tokenContains {
+			flag, ok := lookupFlag(value.value)
+			if !ok {
+				return p.errorf(value, "unknown flag %q", value.value)
+			}
+			n.flag = &flag
+			return nil
+		}
+		if value.kind != tokenNumber {
+			return p.errorf(value, "expected a number for field %q, got %s", fieldTok.value, value)
+		}
+		number, err := strconv.ParseInt(value.value, 0, 64)
+		if err != nil {
+			return p.errorf(value, "invalid number %q", value.value)
+		}
+		n.numbers = append(n.numbers, number)
+	case eventField:
+		if value.kind != tokenIdent && value.kind != tokenString {
+			return p.errorf(value, "expected an event type, got %s", value)
+		}
+		if !isEventName(value.value) {
+			return p.errorf(value, "unknown event type %q", value.value)
+		}
+		n.strings = append(n.strings, value.value)
+	default:
+		if value.kind != tokenString && value.kind != tokenIdent && value.kind != tokenNumber {
+			return p.errorf(value, "expected a string for field %q, got %s", fieldTok.value, value)
+		}
+		n.strings = append(n.strings, value.value)
+	}
+	return nil
+}
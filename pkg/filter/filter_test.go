@@ -0,0 +1,229 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package filter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+func TestMatch(t *testing.T) {
+	write := &model.FSEvent{
+		EventType:   model.Open,
+		Pid:         1234,
+		UID:         1000,
+		GID:         1000,
+		Comm:        "vim",
+		Exe:         "/usr/bin/vim",
+		Argv:        []string{"vim", "/etc/hosts"},
+		Flags:       uint32(model.OWRONLY | model.OCREAT | model.OTRUNC),
+		Retval:      3,
+		SrcFilename: "/etc/hosts",
+		ContainerID: "3f4e5a",
+	}
+	dpkg := &model.FSEvent{
+		EventType:      model.Rename,
+		Pid:            42,
+		UID:            1000,
+		Comm:           "dpkg",
+		Retval:         -2,
+		SrcFilename:    "/etc/passwd.dpkg-new",
+		TargetFilename: "/etc/passwd",
+	}
+	chmod := &model.FSEvent{
+		EventType:   model.SetAttr,
+		UID:         0,
+		Comm:        "chmod",
+		Flags:       uint32(model.AttrMode | model.AttrCtime),
+		SrcFilename: "/etc/shadow",
+	}
+	tests := []struct {
+		expr     string
+		evt      *model.FSEvent
+		expected bool
+	}{
+		// Example of the documentation
+		{`uid != 0 && comm != "dpkg" && event in (open, rename) && flags contains OWRONLY`, write, true},
+		{`uid != 0 && comm != "dpkg" && event in (open, rename) && flags contains OWRONLY`, dpkg, false},
+		{`uid != 0 && comm != "dpkg" && event in (open, rename) && flags contains OWRONLY`, chmod, false},
+
+		// Precedence: && binds tighter than ||, ! binds tighter than &&
+		{`comm == "dpkg" || comm == "vim" && uid == 0`, dpkg, true},
+		{`comm == "dpkg" || comm == "vim" && uid == 0`, write, false},
+		{`(comm == "dpkg" || comm == "vim") && uid == 0`, dpkg, false},
+		{`!comm == "dpkg" && uid == 1000`, write, true},
+		{`!(comm == "dpkg" && uid == 1000)`, dpkg, false},
+		{`not comm == "vim" or pid == 1234`, write, true},
+		{`comm == "vim" AND NOT pid == 1`, write, true},
+		{`!!(pid == 1234)`, write, true},
+
+		// Number fields
+		{`pid == 1234`, write, true},
+		{`pid != 1234`, write, false},
+		{`pid < 1235 && pid <= 1234 && pid > 1233 && pid >= 1234`, write, true},
+		{`pid < 1234`, write, false},
+		{`pid > 1234`, write, false},
+		{`pid in (1, 42, 1234)`, write, true},
+		{`pid in (1, 42)`, write, false},
+		{`retval < 0`, dpkg, true},
+		{`retval == -2`, dpkg, true},
+		{`pid == 0x4d2`, write, true},
+		{`auid == 0`, write, true},
+
+		// String fields
+		{`comm == vim`, write, true},
+		{`comm == 'vim'`, write, true},
+		{`exe contains "bin/"`, write, true},
+		{`cmdline == "vim /etc/hosts"`, write, true},
+		{`path contains passwd`, dpkg, true},
+		{`target == "/etc/passwd"`, dpkg, true},
+		{`comm in ("bash", "vim")`, write, true},
+		{`comm in (bash, sh)`, write, false},
+		{`container != ""`, write, true},
+		{`container_id == ""`, dpkg, true},
+		{`src_filename == "/etc/\"hosts\""`, write, false},
+
+		// Event fields
+		{`event == open`, write, true},
+		{`event == "rename"`, dpkg, true},
+		{`event_type != open`, dpkg, true},
+		{`event in (mkdir, setattr)`, chmod, true},
+
+		// Flags fields
+		{`flags contains OWRONLY`, write, true},
+		{`flags contains ORDWR`, write, false},
+		{`flags contains ORDONLY`, write, false},
+		{`flags contains OCREAT`, write, true},
+		{`flags contains OCREAT && flags contains OTRUNC`, write, true},
+		{`flags contains OEXCL`, write, false},
+		{`flags contains AttrMode`, chmod, true},
+		{`flags contains AttrUID`, chmod, false},
+		// Open flags never match setattr events and the other way around
+		{`flags contains AttrMode`, write, false},
+		{`flags contains ORDONLY`, chmod, false},
+		{`flags == 0`, dpkg, true},
+		{`flags contains 0x40`, write, true},
+	}
+	for _, tt := range tests {
+		f, err := Parse(tt.expr)
+		if err != nil {
+			t.Errorf("%s: %v", tt.expr, err)
+			continue
+		}
+		if match := f.Match(tt.evt); match != tt.expected {
+			t.Errorf("%s on %s event of %s: expected %v, got %v", tt.expr, tt.evt.EventType, tt.evt.Comm, tt.expected, match)
+		}
+	}
+}
+
+func TestMatchNil(t *testing.T) {
+	var f *Filter
+	if !f.Match(&model.FSEvent{}) {
+		t.Error("a nil filter should match all events")
+	}
+	f, err := Parse("pid == 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Match(nil) {
+		t.Error("a filter shouldn't match a nil event")
+	}
+	if f.String() != "pid == 1" {
+		t.Errorf("unexpected expression: %s", f.String())
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		expr    string
+		column  int
+		message string
+	}{
+		{``, 1, `expected a field name or "(", got end of expression`},
+		{`uid`, 4, `expected an operator after field "uid", got end of expression`},
+		{`uid == `, 8, `expected a number for field "uid", got end of expression`},
+		{`uid = 0`, 5, `did you mean '==' ?`},
+		{`uid == 0 & pid == 1`, 10, `did you mean '&&' ?`},
+		{`uid == 0 | pid == 1`, 10, `did you mean '||' ?`},
+		{`uid == 0 # comment`, 10, `unexpected character '#'`},
+		{`comm == "vim`, 9, `unterminated string`},
+		{`comm == "vim\`, 13, `unterminated escape sequence`},
+		{`user == root`, 1, `unknown field "user"`},
+		{`uid == root`, 8, `expected a number for field "uid", got identifier root`},
+		{`uid == 12abc`, 8, `invalid number "12abc"`},
+		{`comm < "vim"`, 6, `operator "<" can't be applied to string field "comm"`},
+		{`uid contains 1`, 5, `operator "contains" can't be applied to number field "uid"`},
+		{`event contains open`, 7, `operator "contains" can't be applied to event type field "event"`},
+		{`event == write`, 10, `unknown event type "write"`},
+		{`event == 1`, 10, `expected an event type, got number 1`},
+		{`flags contains OWRITE`, 16, `unknown flag "OWRITE"`},
+		{`flags > OWRONLY`, 7, `operator ">" can't be applied to flags field "flags"`},
+		{`comm == (`, 9, `expected a string for field "comm", got "("`},
+		{`event in open`, 10, `expected "(", got identifier open`},
+		{`event in (open rename)`, 16, `expected "," or ")", got identifier rename`},
+		{`event in (open,`, 16, `expected "," or ")", got end of expression`},
+		{`(uid == 0`, 10, `expected ")", got end of expression`},
+		{`uid == 0)`, 9, `unexpected ")"`},
+		{`uid == 0 pid == 1`, 10, `unexpected identifier pid`},
+		{`uid == 0 &&`, 12, `expected a field name or "(", got end of expression`},
+		{`uid == 0 && || pid == 1`, 13, `expected a field name or "(", got "||"`},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.expr)
+		if err == nil {
+			t.Errorf("%s: expected an error", tt.expr)
+			continue
+		}
+		pe, ok := err.(*ParseError)
+		if !ok {
+			t.Errorf("%s: expected a ParseError, got %T: %v", tt.expr, err, err)
+			continue
+		}
+		if pe.Column != tt.column {
+			t.Errorf("%s: expected column %d, got %d: %s", tt.expr, tt.column, pe.Column, pe.Message)
+		}
+		if !strings.Contains(pe.Message, tt.message) {
+			t.Errorf("%s: expected message %q, got %q", tt.expr, tt.message, pe.Message)
+		}
+	}
+}
+
+func TestParseErrorMessage(t *testing.T) {
+	_, err := Parse(`uid == 0 && comm ~ "vim"`)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	expected := "invalid filter at column 18: unexpected character '~'\n  uid == 0 && comm ~ \"vim\"\n                   ^"
+	if err.Error() != expected {
+		t.Errorf("unexpected error message:\n%s\nexpected:\n%s", err.Error(), expected)
+	}
+}
+
+func TestFieldNames(t *testing.T) {
+	names := FieldNames()
+	for _, name := range names {
+		if _, ok := lookupField(name); !ok {
+			t.Errorf("field %s can't be looked up", name)
+		}
+	}
+	for i := 1; i < len(names); i++ {
+		if names[i-1] >= names[i] {
+			t.Errorf("field names aren't sorted: %s >= %s", names[i-1], names[i])
+		}
+	}
+}
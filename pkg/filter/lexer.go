@@ -0,0 +1,246 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package filter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// tokenKind - Kind of a lexical token
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenEq
+	tokenNeq
+	tokenLt
+	tokenLte
+	tokenGt
+	tokenGte
+	tokenIn
+	tokenContains
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+// String - Returns a human readable representation of the token kind
+func (tk tokenKind) String() string {
+	switch tk {
+	case tokenEOF:
+		return "end of expression"
+	case tokenIdent:
+		return "identifier"
+	case tokenString:
+		return "string"
+	case tokenNumber:
+		return "number"
+	case tokenAnd:
+		return "&&"
+	case tokenOr:
+		return "||"
+	case tokenNot:
+		return "!"
+	case tokenEq:
+		return "=="
+	case tokenNeq:
+		return "!="
+	case tokenLt:
+		return "<"
+	case tokenLte:
+		return "<="
+	case tokenGt:
+		return ">"
+	case tokenGte:
+		return ">="
+	case tokenIn:
+		return "in"
+	case tokenContains:
+		return "contains"
+	case tokenLParen:
+		return "("
+	case tokenRParen:
+		return ")"
+	case tokenComma:
+		return ","
+	default:
+		return "unknown token"
+	}
+}
+
+// token - Lexical token. Pos is the 0-based byte offset of the token in the expression.
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+// String - Returns a human readable representation of the token
+func (t token) String() string {
+	switch t.kind {
+	case tokenIdent, tokenNumber:
+		return fmt.Sprintf("%s %s", t.kind, t.value)
+	case tokenString:
+		return fmt.Sprintf("string %q", t.value)
+	case tokenEOF:
+		return t.kind.String()
+	default:
+		return fmt.Sprintf("%q", t.kind.String())
+	}
+}
+
+// lex - Splits an expression into tokens
+func lex(expr string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(expr) {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, pos: i})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{kind: tokenComma, pos: i})
+			i++
+		case c == '&':
+			if i+1 >= len(expr) || expr[i+1] != '&' {
+				return nil, newParseError(expr, i, "unexpected character '&', did you mean '&&' ?")
+			}
+			tokens = append(tokens, token{kind: tokenAnd, pos: i})
+			i += 2
+		case c == '|':
+			if i+1 >= len(expr) || expr[i+1] != '|' {
+				return nil, newParseError(expr, i, "unexpected character '|', did you mean '||' ?")
+			}
+			tokens = append(tokens, token{kind: tokenOr, pos: i})
+			i += 2
+		case c == '=':
+			if i+1 >= len(expr) || expr[i+1] != '=' {
+				return nil, newParseError(expr, i, "unexpected character '=', did you mean '==' ?")
+			}
+			tokens = append(tokens, token{kind: tokenEq, pos: i})
+			i += 2
+		case c == '!':
+			if i+1 < len(expr) && expr[i+1] == '=' {
+				tokens = append(tokens, token{kind: tokenNeq, pos: i})
+				i += 2
+			} else {
+				tokens = append(tokens, token{kind: tokenNot, pos: i})
+				i++
+			}
+		case c == '<':
+			if i+1 < len(expr) && expr[i+1] == '=' {
+				tokens = append(tokens, token{kind: tokenLte, pos: i})
+				i += 2
+			} else {
+				tokens = append(tokens, token{kind: tokenLt, pos: i})
+				i++
+			}
+		case c == '>':
+			if i+1 < len(expr) && expr[i+1] == '=' {
+				tokens = append(tokens, token{kind: tokenGte, pos: i})
+				i += 2
+			} else {
+				tokens = append(tokens, token{kind: tokenGt, pos: i})
+				i++
+			}
+		case c == '"' || c == '\'':
+			value, end, err := lexString(expr, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, value: value, pos: i})
+			i = end
+		case c == '-' || isDigit(c):
+			start := i
+			i++
+			for i < len(expr) && isIdentChar(expr[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, value: expr[start:i], pos: start})
+		case isIdentStart(c):
+			start := i
+			for i < len(expr) && isIdentChar(expr[i]) {
+				i++
+			}
+			word := expr[start:i]
+			switch strings.ToLower(word) {
+			case "and":
+				tokens = append(tokens, token{kind: tokenAnd, pos: start})
+			case "or":
+				tokens = append(tokens, token{kind: tokenOr, pos: start})
+			case "not":
+				tokens = append(tokens, token{kind: tokenNot, pos: start})
+			case "in":
+				tokens = append(tokens, token{kind: tokenIn, pos: start})
+			case "contains":
+				tokens = append(tokens, token{kind: tokenContains, pos: start})
+			default:
+				tokens = append(tokens, token{kind: tokenIdent, value: word, pos: start})
+			}
+		default:
+			return nil, newParseError(expr, i, fmt.Sprintf("unexpected character %q", c))
+		}
+	}
+	tokens = append(tokens, token{kind: tokenEOF, pos: len(expr)})
+	return tokens, nil
+}
+
+// lexString - Reads a quoted string starting at the provided position. Returns the unquoted value and the position
+// right after the closing quote.
+func lexString(expr string, start int) (string, int, error) {
+	quote := expr[start]
+	var b strings.Builder
+	for i := start + 1; i < len(expr); i++ {
+		switch expr[i] {
+		case '\\':
+			if i+1 >= len(expr) {
+				return "", 0, newParseError(expr, i, "unterminated escape sequence")
+			}
+			i++
+			b.WriteByte(expr[i])
+		case quote:
+			return b.String(), i + 1, nil
+		default:
+			b.WriteByte(expr[i])
+		}
+	}
+	return "", 0, newParseError(expr, start, "unterminated string")
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || isDigit(c) || c == '.' || c == '/' || c == '-'
+}
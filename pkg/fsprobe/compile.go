@@ -0,0 +1,241 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Gui774ume/fsprobe/pkg/assets/sources"
+)
+
+const (
+	// defaultRuntimeCompilationCacheDir - Default directory used to cache the eBPF programs compiled at runtime
+	defaultRuntimeCompilationCacheDir = "/var/cache/fsprobe"
+	// ebpfMainSource - Entry point of the eBPF programs
+	ebpfMainSource = "main.c"
+)
+
+// compileEBPFProgram - Compile the eBPF programs of FSProbe using clang & llvm. The compiled object is cached on disk,
+// and reused as long as the kernel version and the eBPF sources don't change. The cache is ignored when it could have
+// been written by another user.
+func (fsp *FSProbe) compileEBPFProgram() ([]byte, error) {
+	release, err := getKernelRelease()
+	if err != nil {
+		return nil, err
+	}
+	// Check the cache first
+	cacheDir := fsp.options.RuntimeCompilationCacheDir
+	if cacheDir == "" {
+		cacheDir = defaultRuntimeCompilationCacheDir
+	}
+	hash, err := hashEBPFSources()
+	if err != nil {
+		return nil, err
+	}
+	objectPath := filepath.Join(cacheDir, fmt.Sprintf("probe-%s-%s.o", release, hash))
+	useCache := true
+	if err := checkCachePermissions(cacheDir, true); err != nil && !os.IsNotExist(errors.Cause(err)) {
+		logrus.Warnf("ignoring the eBPF program cache: %v", err)
+		useCache = false
+	}
+	if useCache {
+		if err := checkCachePermissions(objectPath, false); err == nil {
+			if buf, err := ioutil.ReadFile(objectPath); err == nil {
+				logrus.Debugf("using cached eBPF program %s", objectPath)
+				return buf, nil
+			}
+		} else if !os.IsNotExist(errors.Cause(err)) {
+			logrus.Warnf("ignoring the cached eBPF program: %v", err)
+		}
+	}
+
+	// Check that the compilation toolchain and the kernel headers are available
+	clang, err := exec.LookPath("clang")
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't find clang")
+	}
+	llc, err := exec.LookPath("llc")
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't find llc")
+	}
+	headers := fsp.options.KernelHeadersPath
+	if headers == "" {
+		headers = filepath.Join("/lib/modules", release, "build")
+	}
+	if _, err := os.Stat(headers); err != nil {
+		return nil, errors.Wrap(err, "couldn't find kernel headers")
+	}
+
+	// Extract the eBPF sources
+	srcDir, err := ioutil.TempDir("", "fsprobe-src-")
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't create sources directory")
+	}
+	defer os.RemoveAll(srcDir)
+	for _, name := range sources.AssetNames() {
+		if err := sources.RestoreAsset(srcDir, name); err != nil {
+			return nil, errors.Wrapf(err, "couldn't extract %s", name)
+		}
+	}
+
+	// Compile
+	buf, err := compileEBPFSources(clang, llc, headers, filepath.Join(srcDir, ebpfMainSource))
+	if err != nil {
+		return nil, err
+	}
+
+	// Cache the compiled object
+	if useCache {
+		if err := writeFileAtomic(objectPath, buf); err != nil {
+			logrus.Warnf("couldn't cache the compiled eBPF program: %v", err)
+		}
+	}
+	return buf, nil
+}
+
+// compileEBPFSources - Runs clang and llc on the provided eBPF source
+func compileEBPFSources(clang string, llc string, headers string, source string) ([]byte, error) {
+	arch := kernelArch()
+	clangCmd := exec.Command(clang,
+		"-D__KERNEL__",
+		"-D__ASM_SYSREG_H",
+		"-Wno-unused-value",
+		"-Wno-pointer-sign",
+		"-Wno-compare-distinct-pointer-types",
+		"-Wunused",
+		"-Wall",
+		"-Werror",
+		"-I"+filepath.Join(headers, "include"),
+		"-I"+filepath.Join(headers, "include/uapi"),
+		"-I"+filepath.Join(headers, "include/generated/uapi"),
+		"-I"+filepath.Join(headers, "arch", arch, "include"),
+		"-I"+filepath.Join(headers, "arch", arch, "include/uapi"),
+		"-I"+filepath.Join(headers, "arch", arch, "include/generated"),
+		"-O2", "-emit-llvm",
+		source,
+		"-c", "-o", "-",
+	)
+	var clangErr, llcErr, object bytes.Buffer
+	clangCmd.Stderr = &clangErr
+	llcCmd := exec.Command(llc, "-march=bpf", "-filetype=obj", "-o", "-")
+	llcCmd.Stdout = &object
+	llcCmd.Stderr = &llcErr
+	pipe, err := clangCmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	llcCmd.Stdin = pipe
+	if err := llcCmd.Start(); err != nil {
+		return nil, errors.Wrap(err, "couldn't start llc")
+	}
+	if err := clangCmd.Run(); err != nil {
+		_ = llcCmd.Wait()
+		return nil, errors.Wrapf(err, "clang failed: %s", clangErr.String())
+	}
+	if err := llcCmd.Wait(); err != nil {
+		return nil, errors.Wrapf(err, "llc failed: %s", llcErr.String())
+	}
+	return object.Bytes(), nil
+}
+
+// hashEBPFSources - Returns a hash of the embedded eBPF sources
+func hashEBPFSources() (string, error) {
+	names := sources.AssetNames()
+	sort.Strings(names)
+	h := sha256.New()
+	for _, name := range names {
+		data, err := sources.Asset(name)
+		if err != nil {
+			return "", errors.Wrapf(err, "couldn't read %s", name)
+		}
+		h.Write([]byte(name))
+		h.Write(data)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16], nil
+}
+
+// kernelArch - Returns the name of the kernel architecture directory of the current platform
+func kernelArch() string {
+	switch runtime.GOARCH {
+	case "amd64", "386":
+		return "x86"
+	case "arm64":
+		return "arm64"
+	default:
+		return runtime.GOARCH
+	}
+}
+
+// checkCachePermissions - Returns an error if the provided cache directory (or cached file) isn't owned by the user
+// running fsprobe (root), or if it is writable by its group or by other users: anyone who can write in the cache can get
+// fsprobe to load an arbitrary eBPF program.
+func checkCachePermissions(p string, dir bool) error {
+	info, err := os.Lstat(p)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't stat %s", p)
+	}
+	if dir && !info.IsDir() {
+		return errors.Errorf("%s isn't a directory", p)
+	}
+	if !dir && !info.Mode().IsRegular() {
+		return errors.Errorf("%s isn't a regular file", p)
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return errors.Errorf("couldn't read the owner of %s", p)
+	}
+	if int(stat.Uid) != os.Geteuid() {
+		return errors.Errorf("%s is owned by uid %d", p, stat.Uid)
+	}
+	if info.Mode().Perm()&0022 != 0 {
+		return errors.Errorf("%s is writable by its group or by other users", p)
+	}
+	return nil
+}
+
+// writeFileAtomic - Writes the provided data to a temporary file and renames it
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
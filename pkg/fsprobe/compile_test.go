@@ -0,0 +1,86 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestCheckCachePermissions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fsprobe-cache-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	object := filepath.Join(dir, "probe.o")
+	if err := ioutil.WriteFile(object, []byte("object"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	link := filepath.Join(dir, "link.o")
+	if err := os.Symlink(object, link); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := checkCachePermissions(dir, true); err != nil {
+		t.Errorf("unexpected error for the cache directory: %v", err)
+	}
+	if err := checkCachePermissions(object, false); err != nil {
+		t.Errorf("unexpected error for the cached object: %v", err)
+	}
+	if err := checkCachePermissions(link, false); err == nil {
+		t.Error("expected an error for a symlink")
+	}
+	if err := checkCachePermissions(object, true); err == nil {
+		t.Error("expected an error for a file used as cache directory")
+	}
+	if err := checkCachePermissions(filepath.Join(dir, "missing.o"), false); !os.IsNotExist(errors.Cause(err)) {
+		t.Errorf("expected a not exist error, got %v", err)
+	}
+
+	if err := os.Chmod(object, 0666); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkCachePermissions(object, false); err == nil {
+		t.Error("expected an error for a world-writable object")
+	}
+	if err := os.Chmod(dir, 0775); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkCachePermissions(dir, true); err == nil {
+		t.Error("expected an error for a group-writable directory")
+	}
+
+	if os.Geteuid() != 0 {
+		return
+	}
+	if err := os.Chmod(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chown(dir, 65534, 65534); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkCachePermissions(dir, true); err == nil {
+		t.Error("expected an error for a directory owned by another user")
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Gui774ume/fsprobe/pkg/filter"
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// SubscribeOptions - Options of a subscriber
+type SubscribeOptions struct {
+	// BufferSize - Size of the buffer of the subscriber. Defaults to the UserSpaceChanSize option of FSProbe.
+	BufferSize int
+	// Events - Event types sent to the subscriber. All the event types are sent if empty.
+	Events []model.EventName
+	// PathPrefixes - When set, only the events with a source or target path under one of the prefixes are sent to
+	// the subscriber
+	PathPrefixes []string
+	// Filter - When set, only the events matching the filter are sent to the subscriber
+	Filter *filter.Filter
+	// Backpressure - Behavior of the subscriber when its buffer is full. The BackpressureSpill policy uses the
+	// SpillDir and SpillMaxSize options of FSProbe.
+	Backpressure model.BackpressurePolicy
+}
+
+// dispatcher - Sends the events captured by the monitors to the subscribers
+type dispatcher struct {
+	sync.RWMutex
+	streams []*Stream
+}
+
+// add - Adds a subscriber
+func (d *dispatcher) add(s *Stream) {
+	d.Lock()
+	defer d.Unlock()
+	d.streams = append(d.streams, s)
+}
+
+// remove - Removes a subscriber and closes its stream
+func (d *dispatcher) remove(s *Stream) {
+	// Unblock the pending sends before waiting for the lock
+	s.stop()
+	d.Lock()
+	defer d.Unlock()
+	for i, elem := range d.streams {
+		if elem == s {
+			d.streams = append(d.streams[:i], d.streams[i+1:]...)
+			break
+		}
+	}
+	s.close()
+}
+
+// stop - Stops waiting for the subscribers to read their events, so that the perf map listeners can flush the
+// pending events and exit
+func (d *dispatcher) stop() {
+	d.RLock()
+	defer d.RUnlock()
+	for _, s := range d.streams {
+		s.stop()
+	}
+}
+
+// close - Closes the streams of all the subscribers. The perf map listeners must be stopped.
+func (d *dispatcher) close() {
+	d.Lock()
+	defer d.Unlock()
+	for _, s := range d.streams {
+		s.close()
+	}
+	d.streams = nil
+}
+
+// channelStats - Returns the fill levels of the streams of the subscribers
+func (d *dispatcher) channelStats() []model.ChannelStats {
+	d.RLock()
+	defer d.RUnlock()
+	var stats []model.ChannelStats
+	for i, s := range d.streams {
+		stats = append(stats, model.ChannelStats{
+			Name:    fmt.Sprintf("subscriber_%d", i),
+			Len:     len(s.queue.events),
+			Cap:     cap(s.queue.events),
+			Dropped: s.Dropped(),
+		})
+	}
+	return stats
+}
+
+// dispatchEvent - Sends an event to the subscribers that match it
+func (d *dispatcher) dispatchEvent(evt *model.FSEvent) {
+	d.RLock()
+	defer d.RUnlock()
+	for _, s := range d.streams {
+		if s.match(evt) {
+			s.send(evt)
+		}
+	}
+}
+
+// dispatchLost - Notifies the subscribers that events were lost
+func (d *dispatcher) dispatchLost(lost *model.LostEvt) {
+	d.RLock()
+	defer d.RUnlock()
+	for _, s := range d.streams {
+		atomic.AddUint64(&s.lost, lost.Count)
+	}
+}
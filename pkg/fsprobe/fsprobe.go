@@ -20,15 +20,14 @@ import (
 	"fmt"
 	"io/ioutil"
 	"math"
-	"math/rand"
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
 
-	"github.com/DataDog/gopsutil/host"
 	"github.com/Gui774ume/ebpf"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -47,11 +46,55 @@ type FSProbe struct {
 	wg             *sync.WaitGroup
 	collection     *ebpf.Collection
 	collectionSpec *ebpf.CollectionSpec
-	monitors       []*model.Monitor
+	monitors       []eventMonitor
 	bootTime       time.Time
 	hostPidns      uint64
-	running        bool
-	runningMutex   sync.RWMutex
+	offsets        *kernelOffsets
+	excludes       *model.Excludes
+	// state - Lifecycle state of FSProbe, read atomically so that State doesn't wait for pending transitions
+	state int32
+	// lock - Serializes the lifecycle transitions and the updates of the watched paths and filters
+	lock sync.Mutex
+	// newMonitors - Returns the monitors to start, overridden by tests
+	newMonitors func() []eventMonitor
+	// loadEBPF - Loads the eBPF programs, overridden by tests
+	loadEBPF func() error
+	// dispatcher - Sends the captured events to the subscribers
+	dispatcher dispatcher
+	// eventQueue - Applies the backpressure policy of the options to EventChan
+	eventQueue *eventQueue
+	// stats - Self-monitoring counters
+	stats *model.StatsCollector
+	// perfBufferLost, userSpaceDrops and unreportedDrops - Lost event counters, updated atomically
+	perfBufferLost  uint64
+	userSpaceDrops  uint64
+	unreportedDrops uint64
+}
+
+// eventMonitor - Interface implemented by the monitors of FSProbe
+type eventMonitor interface {
+	GetName() string
+	GetProbes() map[model.EventName][]*model.Probe
+	GetResolutionModeMaps() map[model.DentryResolutionMode][]string
+	GetDentryResolver() model.DentryResolver
+	Init(fs model.FSProbe) error
+	InitReplay(fs model.FSProbe, header *model.CaptureHeader) error
+	ReplayRecord(record *model.CaptureRecord)
+	Start() error
+	Stop() error
+	AddInodeFilter(mountID uint32, inode uint64, path string) error
+	RemoveInodeFilter(mountID uint32, inode uint64, path string) error
+	AddProcessFilter(action model.ProcessFilterAction, filter model.ProcessFilter) error
+	RemoveProcessFilter(filter model.ProcessFilter) error
+}
+
+// registerMonitors - Returns new instances of the monitors of FSProbe
+func registerMonitors() []eventMonitor {
+	var monitors []eventMonitor
+	for _, m := range monitor.RegisterMonitors() {
+		monitors = append(monitors, m)
+	}
+	return monitors
 }
 
 // NewFSProbeWithOptions - Creates a new FSProbe instance with the provided options
@@ -64,11 +107,15 @@ func NewFSProbeWithOptions(options model.FSProbeOptions) *FSProbe {
 	if err != nil {
 		logrus.Errorln("WARNING: Failed to adjust RLIMIT_MEMLOCK limit, loading eBPF maps might fail")
 	}
-	return &FSProbe{
-		options: &options,
-		paths:   []string{},
-		wg:      &sync.WaitGroup{},
+	fsp := &FSProbe{
+		options:     &options,
+		paths:       []string{},
+		wg:          &sync.WaitGroup{},
+		newMonitors: registerMonitors,
+		stats:       model.NewStatsCollector(),
 	}
+	fsp.loadEBPF = fsp.loadEBPFProgram
+	return fsp
 }
 
 // GetWaitGroup - Returns the wait group of fsprobe
@@ -91,100 +138,46 @@ func (fsp *FSProbe) GetBootTime() time.Time {
 	return fsp.bootTime
 }
 
+// GetStatsCollector - Returns the self-monitoring counters of fsprobe
+func (fsp *FSProbe) GetStatsCollector() *model.StatsCollector {
+	return fsp.stats
+}
+
 // GetHostPidns - Returns the host pidns of fsprobe
 func (fsp *FSProbe) GetHostPidns() uint64 {
 	return fsp.hostPidns
 }
 
-// Watch - start watching the provided paths. This function is thread safe and can be called multiple times. If
-// already running, the new paths will be added dynamically.
+// Watch - start watching the provided paths. This function is thread safe and can be called multiple times. FSProbe
+// is started if it isn't running yet, otherwise the new paths are added dynamically.
 func (fsp *FSProbe) Watch(paths ...string) error {
-	// 1) Check if FSProbe is already running
-	fsp.runningMutex.RLock()
-	if fsp.running {
-		fsp.runningMutex.RUnlock()
-	} else {
-		// 1.1) setup FSProbe for the first time
-		fsp.runningMutex.RUnlock()
-		fsp.runningMutex.Lock()
-		if err := fsp.start(); err != nil {
-			return err
-		}
-		fsp.running = true
-		fsp.runningMutex.Unlock()
-	}
-	// 2) Add watches for the provided paths
-	if err := fsp.addWatch(paths...); err != nil {
-		return err
-	}
-	return nil
-}
-
-// setup - runs the setup steps to start fsprobe
-func (fsp *FSProbe) start() error {
-	// 1) Initialize FSProbe
-	if err := fsp.init(); err != nil {
-		return err
-	}
-	// 2) Load eBPF programs
-	if err := fsp.loadEBPFProgram(); err != nil {
-		return err
-	}
-	// 3) Start monitors
-	if err := fsp.startMonitors(); err != nil {
-		return err
-	}
-	return nil
-}
-
-// init - Initializes the NetworkSecurityProbe
-func (fsp *FSProbe) init() error {
-	// Set a unique seed to prepare the generation of IDs
-	rand.Seed(time.Now().UnixNano())
-	// Get boot time
-	bt, err := host.BootTime()
-	if err != nil {
-		return err
+	fsp.lock.Lock()
+	defer fsp.lock.Unlock()
+	// 1) Add paths to the list of watched paths
+	fsp.paths = append(fsp.paths, paths...)
+	// 2) Start FSProbe if needed, all the watched paths are added on startup
+	if fsp.State() != StateRunning {
+		return fsp.start()
 	}
-	fsp.bootTime = time.Unix(int64(bt), 0)
-	// Get host netns
-	fsp.hostPidns = utils.GetPidnsFromPid(1)
-	// Register monitors
-	fsp.monitors = monitor.RegisterMonitors()
-	return nil
+	// 3) Add watches for the provided paths
+	return fsp.walkWatch(fsp.watchInode, paths...)
 }
 
-// Stop - Stop the file system probe
-func (fsp *FSProbe) Stop() error {
-	// 1) Stop monitors
-	for _, p := range fsp.monitors {
-		if err := p.Stop(); err != nil {
-			logrus.Errorf("couldn't stop monitor (Ctrl+C to abort): %v", p.GetName(), err)
+// loadEBPFProgram - Loads the compiled eBPF programs
+func (fsp *FSProbe) loadEBPFProgram() error {
+	// Recover the eBPF programs, either compiled at runtime or from the embedded asset
+	var buf []byte
+	var err error
+	if fsp.options.RuntimeCompilation {
+		if buf, err = fsp.compileEBPFProgram(); err != nil {
+			logrus.Warnf("runtime compilation failed, falling back to the embedded eBPF programs: %v", err)
 		}
 	}
-	// 2) Close eBPF programs
-	if fsp.collection != nil {
-		if errs := fsp.collection.Close(); len(errs) > 0 {
-			logrus.Errorf("couldn't close collection gracefully: %v", errs)
+	if buf == nil {
+		if buf, err = assets.Asset("/probe.o"); err != nil {
+			return errors.Wrap(err, "couldn't find asset")
 		}
 	}
-	// 3) Wait for all goroutine to stop
-	fsp.wg.Wait()
-	return nil
-}
-
-// compileEBPFProgram - Compile the eBPF programs of FSProbe using clang & llvm
-func (fsp *FSProbe) compileEBPFProgram() error {
-	return nil
-}
-
-// loadEBPFProgram - Loads the compiled eBPF programs
-func (fsp *FSProbe) loadEBPFProgram() error {
-	// Recover asset
-	buf, err := assets.Asset("/probe.o")
-	if err != nil {
-		return errors.Wrap(err, "couldn't find asset")
-	}
 	reader := bytes.NewReader(buf)
 	// Load elf CollectionSpec
 	fsp.collectionSpec, err = ebpf.LoadCollectionSpecFromReader(reader)
@@ -193,6 +186,14 @@ func (fsp *FSProbe) loadEBPFProgram() error {
 	}
 	// Remove unused maps based on the selected dentry resolution method
 	fsp.removeUnusedMaps()
+	// Make sure that the eBPF programs were built from the sources of this version of fsprobe
+	if err := fsp.checkCollectionSpec(fsp.collectionSpec); err != nil {
+		return errors.Wrap(err, "the eBPF programs don't match this version of fsprobe, rebuild the embedded asset with `make build-ebpf` or enable runtime compilation")
+	}
+	// Compute the offsets of the kernel structures read by the eBPF programs
+	if fsp.offsets, err = resolveKernelOffsets(); err != nil {
+		return errors.Wrap(err, "couldn't resolve kernel structure offsets")
+	}
 	// Edit runtime eBPF constants
 	if err := fsp.EditEBPFConstants(fsp.collectionSpec); err != nil {
 		return errors.Wrap(err, "couldn't edit runtime eBPF constants")
@@ -205,6 +206,38 @@ func (fsp *FSProbe) loadEBPFProgram() error {
 	return nil
 }
 
+// pathKeyMaps - Maps keyed on a path_key_t structure
+var pathKeyMaps = []string{
+	model.InodesFilterMap,
+	model.CachedInodesMap,
+	model.SingleFragmentsMap,
+}
+
+// checkCollectionSpec - Checks that the provided collection declares the maps of the selected dentry resolution mode
+// and the sections of the probes, with the key sizes expected by the monitors
+func (fsp *FSProbe) checkCollectionSpec(spec *ebpf.CollectionSpec) error {
+	for _, m := range fsp.monitors {
+		for _, name := range m.GetResolutionModeMaps()[fsp.options.DentryResolutionMode] {
+			if _, ok := spec.Maps[name]; !ok {
+				return fmt.Errorf("couldn't find %s map", name)
+			}
+		}
+		for _, probes := range m.GetProbes() {
+			for _, p := range probes {
+				if _, ok := spec.Programs[p.SectionName]; !ok {
+					return fmt.Errorf("couldn't find section %s", p.SectionName)
+				}
+			}
+		}
+	}
+	for _, name := range pathKeyMaps {
+		if mapSpec, ok := spec.Maps[name]; ok && mapSpec.KeySize != model.PathKeySize {
+			return fmt.Errorf("%s map has %d bytes keys, expected %d", name, mapSpec.KeySize, model.PathKeySize)
+		}
+	}
+	return nil
+}
+
 // startMonitors - Loads and attaches the eBPF program in the kernel
 func (fsp *FSProbe) startMonitors() error {
 	// Init monitors
@@ -224,22 +257,97 @@ func (fsp *FSProbe) startMonitors() error {
 	return nil
 }
 
-// addWatch - Updates the eBPF hashmaps to look for the provided paths
-func (fsp *FSProbe) addWatch(paths ...string) error {
-	// Add paths to the list of watched paths
-	fsp.runningMutex.Lock()
-	fsp.paths = append(fsp.paths, paths...)
-	fsp.runningMutex.Unlock()
+// Unwatch - stop watching the provided paths. This function is thread safe and can be called while FSProbe is running,
+// the inodes of the provided paths will be removed dynamically from the in-kernel filter.
+func (fsp *FSProbe) Unwatch(paths ...string) error {
+	fsp.lock.Lock()
+	defer fsp.lock.Unlock()
+	// 1) Remove paths from the list of watched paths
+	remaining := []string{}
+	for _, p := range fsp.paths {
+		if !containsPath(paths, p) {
+			remaining = append(remaining, p)
+		}
+	}
+	fsp.paths = remaining
+	if fsp.State() != StateRunning {
+		return nil
+	}
+	// 2) Remove the inode filters of the provided paths
+	return fsp.walkWatch(func(mountID uint32, inode uint64, path string) {
+		// Inodes that are still covered by another watched path should stay in the filter
+		if fsp.isWatched(path) {
+			return
+		}
+		fsp.unwatchInode(mountID, inode, path)
+	}, paths...)
+}
+
+// AllowProcesses - Only sends the events of the provided processes to user space. This function is thread safe and
+// can be called while FSProbe is running, the in-kernel allow lists are updated dynamically.
+func (fsp *FSProbe) AllowProcesses(filter model.ProcessFilter) error {
+	return fsp.addProcessFilter(model.ProcessFilterAllow, filter)
+}
+
+// DenyProcesses - Drops the events of the provided processes in kernel space. This function is thread safe and can be
+// called while FSProbe is running, the in-kernel deny lists are updated dynamically.
+func (fsp *FSProbe) DenyProcesses(filter model.ProcessFilter) error {
+	return fsp.addProcessFilter(model.ProcessFilterDeny, filter)
+}
+
+// addProcessFilter - Adds the provided process filter to the options, and pushes it in kernel space if FSProbe is
+// running
+func (fsp *FSProbe) addProcessFilter(action model.ProcessFilterAction, filter model.ProcessFilter) error {
+	fsp.lock.Lock()
+	defer fsp.lock.Unlock()
+	if fsp.State() != StateRunning {
+		if action == model.ProcessFilterAllow {
+			fsp.options.AllowProcesses.Merge(filter)
+		} else {
+			fsp.options.DenyProcesses.Merge(filter)
+		}
+		return nil
+	}
+	for _, m := range fsp.monitors {
+		if err := m.AddProcessFilter(action, filter); err != nil {
+			return errors.Wrapf(err, "couldn't %s processes", action)
+		}
+	}
+	return nil
+}
+
+// RemoveProcessFilters - Removes the provided processes from the in-kernel allow and deny lists
+func (fsp *FSProbe) RemoveProcessFilters(filter model.ProcessFilter) error {
+	fsp.lock.Lock()
+	defer fsp.lock.Unlock()
+	if fsp.State() != StateRunning {
+		fsp.options.AllowProcesses = fsp.options.AllowProcesses.Without(filter)
+		fsp.options.DenyProcesses = fsp.options.DenyProcesses.Without(filter)
+		return nil
+	}
+	for _, m := range fsp.monitors {
+		if err := m.RemoveProcessFilter(filter); err != nil {
+			return errors.Wrap(err, "couldn't remove process filters")
+		}
+	}
+	return nil
+}
+
+// inodeHandler - Callback called on each inode found while walking the watched paths
+type inodeHandler func(mountID uint32, inode uint64, path string)
+
+// walkWatch - Walks the provided paths according to the configured watch mode and calls handler on each inode
+func (fsp *FSProbe) walkWatch(handler inodeHandler, paths ...string) error {
 	if fsp.options.Recursive {
 		// Watch all directories provided in paths recursively
-		return fsp.addRecursiveWatch(paths...)
+		return fsp.walkRecursive(handler, paths...)
 	}
 	// On watch the top level directories
-	return fsp.addTopLevelWatch(paths...)
+	return fsp.walkTopLevel(handler, paths...)
 }
 
-// addTopLevelWatch - Adds watches by walking only the top level depth of directories
-func (fsp *FSProbe) addTopLevelWatch(paths ...string) error {
+// walkTopLevel - Walks only the top level depth of directories
+func (fsp *FSProbe) walkTopLevel(handler inodeHandler, paths ...string) error {
 	for _, p := range paths {
 		// Check if the path is a directory
 		pathInfo, err := os.Stat(p)
@@ -254,43 +362,50 @@ func (fsp *FSProbe) addTopLevelWatch(paths ...string) error {
 			}
 			for _, f := range files {
 				fullPath := path.Join(p, f.Name())
+				if fsp.excludes.Match(fullPath) {
+					continue
+				}
 				statTmp, ok := f.Sys().(*syscall.Stat_t)
 				if !ok && statTmp == nil {
 					continue
 				}
-				// Add inode in cache
-				fsp.watchInode(uint32(statTmp.Ino), fullPath)
+				handleInode(handler, statTmp.Ino, fullPath)
 			}
 		}
-		// Add the file (or directory itself) to the list of watched files
+		// Handle the file (or directory itself)
 		pathStat, ok := pathInfo.Sys().(*syscall.Stat_t)
 		if !ok && pathStat == nil {
 			continue
 		}
-		// Add file in cache
-		fsp.watchInode(uint32(pathStat.Ino), p)
+		handleInode(handler, pathStat.Ino, p)
 	}
 	return nil
 }
 
-// addRecursiveWatch - Adds watches by walking through all the provided paths recursively
-func (fsp *FSProbe) addRecursiveWatch(paths ...string) error {
+// walkRecursive - Walks through all the provided paths recursively
+func (fsp *FSProbe) walkRecursive(handler inodeHandler, paths ...string) error {
 	var err error
 	for _, path := range paths {
 		err = filepath.Walk(path, func(walkPath string, fi os.FileInfo, err error) error {
 			if err != nil {
-				logrus.Warnf("couldn't add watch for %s: %v", walkPath, err)
+				logrus.Warnf("couldn't walk %s: %v", walkPath, err)
+				return nil
+			}
+			// Skip excluded subtrees, the provided paths are always watched
+			if walkPath != path && fsp.excludes.Match(walkPath) {
+				if fi.IsDir() {
+					return filepath.SkipDir
+				}
 				return nil
 			}
 			if !fi.IsDir() {
-				return fsp.addTopLevelWatch([]string{walkPath}...)
+				return fsp.walkTopLevel(handler, []string{walkPath}...)
 			}
 			stat, ok := fi.Sys().(*syscall.Stat_t)
 			if !ok && stat == nil {
 				return nil
 			}
-			// Add inode in cache
-			fsp.watchInode(uint32(stat.Ino), walkPath)
+			handleInode(handler, stat.Ino, walkPath)
 			return nil
 		})
 		if err != nil {
@@ -300,22 +415,71 @@ func (fsp *FSProbe) addRecursiveWatch(paths ...string) error {
 	return nil
 }
 
+// handleInode - Resolves the mount ID of the provided path and calls handler
+func handleInode(handler inodeHandler, inode uint64, path string) {
+	mountID, err := utils.GetMountID(path)
+	if err != nil {
+		logrus.Warnf("couldn't resolve the mount ID of %s: %v", path, err)
+		return
+	}
+	handler(mountID, inode, path)
+}
+
 // watchInode - Adds an inode the in the resolver cache
-func (fsp *FSProbe) watchInode(inode uint32, path string) {
+func (fsp *FSProbe) watchInode(mountID uint32, inode uint64, path string) {
 	for _, m := range fsp.monitors {
 		// Add inode filter
-		if err := m.AddInodeFilter(inode, path); err != nil {
+		if err := m.AddInodeFilter(mountID, inode, path); err != nil {
 			logrus.Warnf("couldn't watch inode %v of path %s: %v", inode, path, err)
 			continue
 		}
 	}
 }
 
+// unwatchInode - Removes an inode from the in-kernel filter and from the resolver cache
+func (fsp *FSProbe) unwatchInode(mountID uint32, inode uint64, path string) {
+	for _, m := range fsp.monitors {
+		// Remove inode filter
+		if err := m.RemoveInodeFilter(mountID, inode, path); err != nil {
+			logrus.Warnf("couldn't unwatch inode %v of path %s: %v", inode, path, err)
+			continue
+		}
+	}
+}
+
+// isWatched - Returns true if the provided path is covered by one of the watched paths. The caller must hold the lock.
+func (fsp *FSProbe) isWatched(p string) bool {
+	for _, watched := range fsp.paths {
+		if p == watched {
+			return true
+		}
+		rel, err := filepath.Rel(watched, p)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+			continue
+		}
+		// Only the immediate children of a watched directory are covered in non recursive mode
+		if fsp.options.Recursive || !strings.Contains(rel, "/") {
+			return true
+		}
+	}
+	return false
+}
+
+// containsPath - Returns true if p is in the provided list of paths
+func containsPath(paths []string, p string) bool {
+	for _, elem := range paths {
+		if path.Clean(elem) == path.Clean(p) {
+			return true
+		}
+	}
+	return false
+}
+
 // EditEBPFConstants - Edit the runtime eBPF constants
 func (fsp *FSProbe) EditEBPFConstants(spec *ebpf.CollectionSpec) error {
 	// Edit the constants of all the probes declared in FSProbe
 	for _, mon := range fsp.monitors {
-		for _, probes := range mon.Probes {
+		for _, probes := range mon.GetProbes() {
 			for _, probe := range probes {
 				if len(probe.Constants) == 0 {
 					continue
@@ -343,6 +507,14 @@ func (fsp *FSProbe) EditEBPFConstants(spec *ebpf.CollectionSpec) error {
 						if fsp.options.Recursive {
 							value = 1
 						}
+					case model.VfsmountMntIDOffsetConst:
+						value = uint64(fsp.offsets.VfsmountMntID)
+					case model.SbMountsMntIDOffsetConst:
+						value = uint64(fsp.offsets.SbMountsMntID)
+					case model.SbMountsMntMountpointOffsetConst:
+						value = uint64(fsp.offsets.SbMountsMntMountpoint)
+					case model.MntNsInumOffsetConst:
+						value = uint64(fsp.offsets.MntNsInum)
 					default:
 						return fmt.Errorf("couldn't rewrite symbol %s in program %s: unknown symbol", constant, probe.SectionName)
 					}
@@ -366,7 +538,7 @@ func (fsp *FSProbe) removeUnusedMaps() {
 		used := false
 		// check if the map is used in all the monitors
 		for _, m := range fsp.monitors {
-			rmm, ok := m.ResolutionModeMaps[fsp.options.DentryResolutionMode]
+			rmm, ok := m.GetResolutionModeMaps()[fsp.options.DentryResolutionMode]
 			if !ok {
 				continue
 			}
@@ -0,0 +1,216 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Gui774ume/ebpf"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// isWatching - Returns true if the inode of the provided path is in the filter of the fake monitor
+func (fm *fakeMonitor) isWatching(path string) bool {
+	fm.Lock()
+	defer fm.Unlock()
+	for _, p := range fm.inodes {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+// newNestedTestTree - Creates a test tree with a "sub" and a "..cache" directory, each holding a file
+func newNestedTestTree(t *testing.T) string {
+	root := newTestTree(t)
+	for _, dir := range []string{"sub", "..cache"} {
+		if err := os.Mkdir(filepath.Join(root, dir), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(root, dir, "file"), nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestUnwatch(t *testing.T) {
+	fm := &fakeMonitor{}
+	fsp, _ := newTestProbe(t, fm, nil)
+	root := newNestedTestTree(t)
+	other := newTestTree(t)
+
+	if err := fsp.Watch(root, other); err != nil {
+		t.Fatal(err)
+	}
+	defer fsp.Stop()
+	if !fm.isWatching(filepath.Join(root, "sub", "file")) || !fm.isWatching(filepath.Join(other, "a")) {
+		t.Fatal("the watched trees weren't added to the filter")
+	}
+
+	// Unwatching a path removes its whole tree, trailing slashes are ignored
+	if err := fsp.Unwatch(root + "/"); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{root, filepath.Join(root, "a"), filepath.Join(root, "sub"), filepath.Join(root, "sub", "file"), filepath.Join(root, "..cache", "file")} {
+		if fm.isWatching(p) {
+			t.Errorf("%s is still watched", p)
+		}
+	}
+	if !fm.isWatching(filepath.Join(other, "a")) {
+		t.Error("the inodes of the other watched path were removed")
+	}
+	if len(fsp.paths) != 1 || fsp.paths[0] != other {
+		t.Errorf("expected the watched paths to be [%s], got %v", other, fsp.paths)
+	}
+
+	// Unwatching a path that isn't watched is a no-op
+	if err := fsp.Unwatch(root); err != nil {
+		t.Fatal(err)
+	}
+	if !fm.isWatching(filepath.Join(other, "a")) {
+		t.Error("the inodes of the other watched path were removed")
+	}
+}
+
+func TestUnwatchOverlappingPaths(t *testing.T) {
+	fm := &fakeMonitor{}
+	fsp, _ := newTestProbe(t, fm, nil)
+	root := newNestedTestTree(t)
+	sub := filepath.Join(root, "sub")
+	dotdot := filepath.Join(root, "..cache")
+
+	if err := fsp.Watch(root, sub, dotdot); err != nil {
+		t.Fatal(err)
+	}
+	defer fsp.Stop()
+
+	// The nested paths are still covered by the recursive watch of root
+	if err := fsp.Unwatch(sub, dotdot); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{sub, filepath.Join(sub, "file"), dotdot, filepath.Join(dotdot, "file")} {
+		if !fm.isWatching(p) {
+			t.Errorf("%s was removed from the filter while root is still watched", p)
+		}
+	}
+
+	// Unwatching root while a nested path is watched only keeps the nested tree
+	if err := fsp.Watch(sub); err != nil {
+		t.Fatal(err)
+	}
+	if err := fsp.Unwatch(root); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{sub, filepath.Join(sub, "file")} {
+		if !fm.isWatching(p) {
+			t.Errorf("%s was removed from the filter while it is still watched", p)
+		}
+	}
+	for _, p := range []string{root, filepath.Join(root, "a"), dotdot, filepath.Join(dotdot, "file")} {
+		if fm.isWatching(p) {
+			t.Errorf("%s is still watched", p)
+		}
+	}
+}
+
+func TestIsWatched(t *testing.T) {
+	fsp, _ := newTestProbe(t, &fakeMonitor{}, nil)
+	fsp.paths = []string{"/watched", "/other/file"}
+	tests := []struct {
+		path      string
+		recursive bool
+		nonRec    bool
+	}{
+		{"/watched", true, true},
+		{"/watched/file", true, true},
+		{"/watched/..cache", true, true},
+		{"/watched/..", false, false},
+		{"/watched/dir/file", true, false},
+		{"/watched2", false, false},
+		{"/other", false, false},
+		{"/other/file", true, true},
+		{"/", false, false},
+	}
+	for _, test := range tests {
+		fsp.options.Recursive = true
+		if got := fsp.isWatched(test.path); got != test.recursive {
+			t.Errorf("%s: expected %v in recursive mode, got %v", test.path, test.recursive, got)
+		}
+		fsp.options.Recursive = false
+		if got := fsp.isWatched(test.path); got != test.nonRec {
+			t.Errorf("%s: expected %v in non recursive mode, got %v", test.path, test.nonRec, got)
+		}
+	}
+}
+
+// newCollectionSpec - Returns a collection spec that declares the maps of the provided dentry resolution mode and the
+// sections of the probes of the monitors of FSProbe
+func newCollectionSpec(fsp *FSProbe, mode model.DentryResolutionMode) *ebpf.CollectionSpec {
+	spec := &ebpf.CollectionSpec{
+		Maps:     make(map[string]*ebpf.MapSpec),
+		Programs: make(map[string]*ebpf.ProgramSpec),
+	}
+	for _, m := range fsp.monitors {
+		for _, name := range m.GetResolutionModeMaps()[mode] {
+			spec.Maps[name] = &ebpf.MapSpec{Name: name, KeySize: 4}
+		}
+		for _, probes := range m.GetProbes() {
+			for _, p := range probes {
+				spec.Programs[p.SectionName] = &ebpf.ProgramSpec{}
+			}
+		}
+	}
+	for _, name := range pathKeyMaps {
+		if mapSpec, ok := spec.Maps[name]; ok {
+			mapSpec.KeySize = model.PathKeySize
+		}
+	}
+	return spec
+}
+
+func TestCheckCollectionSpec(t *testing.T) {
+	for _, mode := range []model.DentryResolutionMode{model.DentryResolutionFragments, model.DentryResolutionSingleFragment, model.DentryResolutionPerfBuffer} {
+		fsp := NewFSProbeWithOptions(model.FSProbeOptions{DentryResolutionMode: mode})
+		fsp.monitors = registerMonitors()
+		if err := fsp.checkCollectionSpec(newCollectionSpec(fsp, mode)); err != nil {
+			t.Errorf("mode %v: unexpected error: %v", mode, err)
+		}
+
+		// Collection built from older sources
+		spec := newCollectionSpec(fsp, mode)
+		spec.Maps[model.InodesFilterMap].KeySize = 4
+		if err := fsp.checkCollectionSpec(spec); err == nil || !strings.Contains(err.Error(), model.InodesFilterMap) {
+			t.Errorf("mode %v: expected an inodes_filter key size error, got %v", mode, err)
+		}
+		spec = newCollectionSpec(fsp, mode)
+		delete(spec.Maps, model.ProcessEventsMap)
+		if err := fsp.checkCollectionSpec(spec); err == nil || !strings.Contains(err.Error(), model.ProcessEventsMap) {
+			t.Errorf("mode %v: expected a missing process_events error, got %v", mode, err)
+		}
+		spec = newCollectionSpec(fsp, mode)
+		delete(spec.Programs, "kprobe/vfs_symlink")
+		if err := fsp.checkCollectionSpec(spec); err == nil || !strings.Contains(err.Error(), "kprobe/vfs_symlink") {
+			t.Errorf("mode %v: expected a missing section error, got %v", mode, err)
+		}
+	}
+}
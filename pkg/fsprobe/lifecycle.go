@@ -0,0 +1,196 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"fmt"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"github.com/DataDog/gopsutil/host"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+	"github.com/Gui774ume/fsprobe/pkg/utils"
+)
+
+// State - Lifecycle state of FSProbe
+type State int32
+
+const (
+	// StateCreated - FSProbe was created and was never started
+	StateCreated State = iota
+	// StateStarting - The eBPF programs are being loaded and the monitors are being started
+	StateStarting
+	// StateRunning - FSProbe is running
+	StateRunning
+	// StateStopping - The monitors are being stopped and the eBPF programs are being unloaded
+	StateStopping
+	// StateStopped - FSProbe was stopped, or couldn't start. It can be started again.
+	StateStopped
+)
+
+func (s State) String() string {
+	switch s {
+	case StateCreated:
+		return "created"
+	case StateStarting:
+		return "starting"
+	case StateRunning:
+		return "running"
+	case StateStopping:
+		return "stopping"
+	case StateStopped:
+		return "stopped"
+	default:
+		return fmt.Sprintf("State(%d)", s)
+	}
+}
+
+// State - Returns the current lifecycle state of FSProbe
+func (fsp *FSProbe) State() State {
+	return State(atomic.LoadInt32(&fsp.state))
+}
+
+// setState - Updates the lifecycle state of FSProbe. The caller must hold the lock.
+func (fsp *FSProbe) setState(state State) {
+	atomic.StoreInt32(&fsp.state, int32(state))
+}
+
+// Start - Starts FSProbe and watches the paths provided so far. This function is thread safe, it does nothing if
+// FSProbe is already running and restarts FSProbe if it was stopped.
+func (fsp *FSProbe) Start() error {
+	fsp.lock.Lock()
+	defer fsp.lock.Unlock()
+	if fsp.State() == StateRunning {
+		return nil
+	}
+	return fsp.start()
+}
+
+// Stop - Stop the file system probe. This function is thread safe, it does nothing if FSProbe isn't running.
+func (fsp *FSProbe) Stop() error {
+	fsp.lock.Lock()
+	defer fsp.lock.Unlock()
+	if fsp.State() != StateRunning {
+		return nil
+	}
+	fsp.setState(StateStopping)
+	fsp.teardown()
+	fsp.setState(StateStopped)
+	return nil
+}
+
+// start - runs the setup steps to start fsprobe and watches the paths provided so far. The caller must hold the lock.
+func (fsp *FSProbe) start() error {
+	fsp.setState(StateStarting)
+	if err := fsp.setup(); err != nil {
+		// Release what was loaded so far so that FSProbe can be started again
+		fsp.teardown()
+		fsp.setState(StateStopped)
+		return err
+	}
+	fsp.setState(StateRunning)
+	// Add watches for the paths provided so far
+	return fsp.walkWatch(fsp.watchInode, fsp.paths...)
+}
+
+// setup - Initializes FSProbe, loads the eBPF programs and starts the monitors
+func (fsp *FSProbe) setup() error {
+	// 1) Initialize FSProbe
+	if err := fsp.init(); err != nil {
+		return err
+	}
+	// 2) Load eBPF programs
+	if err := fsp.loadEBPF(); err != nil {
+		return err
+	}
+	// 3) Record what is needed to decode the samples that will follow
+	if fsp.options.Recorder != nil {
+		header, err := model.NewCaptureHeader(fsp.options, fsp.bootTime)
+		if err != nil {
+			return errors.Wrap(err, "couldn't prepare capture header")
+		}
+		fsp.options.Recorder.RecordHeader(header)
+	}
+	// 4) Start monitors
+	if err := fsp.startMonitors(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// init - Initializes FSProbe
+func (fsp *FSProbe) init() error {
+	// Set a unique seed to prepare the generation of IDs
+	rand.Seed(time.Now().UnixNano())
+	// Get boot time
+	bt, err := host.BootTime()
+	if err != nil {
+		return err
+	}
+	fsp.bootTime = time.Unix(int64(bt), 0)
+	// Get host netns
+	fsp.hostPidns = utils.GetPidnsFromPid(1)
+	// Prepare excludes
+	if fsp.excludes, err = model.NewExcludes(fsp.options.ExcludePaths, fsp.options.ExcludePatterns); err != nil {
+		return err
+	}
+	// Prepare the event channel
+	if fsp.options.EventChan != nil {
+		fsp.eventQueue = newEventQueue(fsp.options.EventChan, fsp.options.Backpressure, fsp.options)
+	}
+	// Register monitors
+	fsp.monitors = fsp.newMonitors()
+	return nil
+}
+
+// teardown - Stops the monitors, unloads the eBPF programs, waits for all the goroutines to stop and closes the
+// streams
+func (fsp *FSProbe) teardown() {
+	// 1) Stop monitors, the perf maps are flushed to the event channel and the streams without waiting for them to be
+	// read
+	if fsp.eventQueue != nil {
+		fsp.eventQueue.stop()
+	}
+	fsp.dispatcher.stop()
+	for _, m := range fsp.monitors {
+		if err := m.Stop(); err != nil {
+			logrus.Errorf("couldn't stop monitor %s: %v", m.GetName(), err)
+		}
+	}
+	// 2) Close eBPF programs
+	if fsp.collection != nil {
+		if errs := fsp.collection.Close(); len(errs) > 0 {
+			logrus.Errorf("couldn't close collection gracefully: %v", errs)
+		}
+	}
+	// 3) Wait for all goroutine to stop
+	fsp.wg.Wait()
+	if fsp.eventQueue != nil {
+		if dropped := fsp.eventQueue.shutdown(); dropped > 0 {
+			fsp.reportUserSpaceDrops(dropped)
+		}
+		fsp.eventQueue = nil
+	}
+	fsp.dispatcher.close()
+	// 4) Reset the runtime state so that FSProbe can be started again
+	fsp.monitors = nil
+	fsp.collection = nil
+	fsp.collectionSpec = nil
+}
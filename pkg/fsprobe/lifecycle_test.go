@@ -0,0 +1,325 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// fakeMonitor - Monitor that records the calls made by FSProbe instead of using eBPF
+type fakeMonitor struct {
+	sync.Mutex
+	inits   int32
+	starts  int32
+	stops   int32
+	started bool
+	inodes  map[model.PathKey]string
+}
+
+func (fm *fakeMonitor) GetName() string {
+	return "fake"
+}
+
+func (fm *fakeMonitor) GetProbes() map[model.EventName][]*model.Probe {
+	return nil
+}
+
+func (fm *fakeMonitor) GetResolutionModeMaps() map[model.DentryResolutionMode][]string {
+	return nil
+}
+
+func (fm *fakeMonitor) GetDentryResolver() model.DentryResolver {
+	return nil
+}
+
+func (fm *fakeMonitor) Init(fs model.FSProbe) error {
+	atomic.AddInt32(&fm.inits, 1)
+	return nil
+}
+
+func (fm *fakeMonitor) InitReplay(fs model.FSProbe, header *model.CaptureHeader) error {
+	return nil
+}
+
+func (fm *fakeMonitor) ReplayRecord(record *model.CaptureRecord) {}
+
+func (fm *fakeMonitor) Start() error {
+	fm.Lock()
+	defer fm.Unlock()
+	atomic.AddInt32(&fm.starts, 1)
+	fm.started = true
+	fm.inodes = make(map[model.PathKey]string)
+	return nil
+}
+
+func (fm *fakeMonitor) Stop() error {
+	fm.Lock()
+	defer fm.Unlock()
+	atomic.AddInt32(&fm.stops, 1)
+	fm.started = false
+	return nil
+}
+
+func (fm *fakeMonitor) AddInodeFilter(mountID uint32, inode uint64, path string) error {
+	fm.Lock()
+	defer fm.Unlock()
+	if !fm.started {
+		return errors.New("monitor isn't started")
+	}
+	fm.inodes[model.NewPathKey(mountID, inode)] = path
+	return nil
+}
+
+func (fm *fakeMonitor) RemoveInodeFilter(mountID uint32, inode uint64, path string) error {
+	fm.Lock()
+	defer fm.Unlock()
+	if !fm.started {
+		return errors.New("monitor isn't started")
+	}
+	delete(fm.inodes, model.NewPathKey(mountID, inode))
+	return nil
+}
+
+func (fm *fakeMonitor) AddProcessFilter(action model.ProcessFilterAction, filter model.ProcessFilter) error {
+	return nil
+}
+
+func (fm *fakeMonitor) RemoveProcessFilter(filter model.ProcessFilter) error {
+	return nil
+}
+
+func (fm *fakeMonitor) watchedInodes() int {
+	fm.Lock()
+	defer fm.Unlock()
+	return len(fm.inodes)
+}
+
+// errLoad - Error returned by the fake eBPF loader
+var errLoad = errors.New("load failure")
+
+// newTestProbe - Returns an FSProbe instance that uses the provided fake monitor and a fake eBPF loader. The loader
+// fails while failLoad is set.
+func newTestProbe(t *testing.T, fm *fakeMonitor, failLoad *int32) (*FSProbe, *int32) {
+	var loads int32
+	fsp := NewFSProbeWithOptions(model.FSProbeOptions{Recursive: true})
+	fsp.newMonitors = func() []eventMonitor {
+		return []eventMonitor{fm}
+	}
+	fsp.loadEBPF = func() error {
+		atomic.AddInt32(&loads, 1)
+		// Widen the window during which concurrent calls could race
+		time.Sleep(10 * time.Millisecond)
+		if failLoad != nil && atomic.LoadInt32(failLoad) == 1 {
+			return errLoad
+		}
+		return nil
+	}
+	return fsp, &loads
+}
+
+// newTestTree - Creates a directory with a few files to watch
+func newTestTree(t *testing.T) string {
+	root, err := ioutil.TempDir("", "fsprobe-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.RemoveAll(root)
+	})
+	for _, name := range []string{"a", "b", "c"} {
+		if err := ioutil.WriteFile(filepath.Join(root, name), nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestConcurrentWatchStartsOnce(t *testing.T) {
+	fm := &fakeMonitor{}
+	fsp, loads := newTestProbe(t, fm, nil)
+	root := newTestTree(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fsp.Watch(root); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(loads); got != 1 {
+		t.Errorf("eBPF programs loaded %d times, expected 1", got)
+	}
+	if got := atomic.LoadInt32(&fm.starts); got != 1 {
+		t.Errorf("monitor started %d times, expected 1", got)
+	}
+	if fsp.State() != StateRunning {
+		t.Errorf("state is %s, expected %s", fsp.State(), StateRunning)
+	}
+	if fm.watchedInodes() == 0 {
+		t.Error("no inode was watched")
+	}
+	if err := fsp.Stop(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStartFailureReleasesLock(t *testing.T) {
+	fm := &fakeMonitor{}
+	failLoad := int32(1)
+	fsp, _ := newTestProbe(t, fm, &failLoad)
+	root := newTestTree(t)
+
+	if err := fsp.Watch(root); err != errLoad {
+		t.Fatalf("expected Watch to fail with %v, got %v", errLoad, err)
+	}
+	if fsp.State() != StateStopped {
+		t.Fatalf("state is %s, expected %s", fsp.State(), StateStopped)
+	}
+
+	// The lock must have been released and FSProbe must be able to start once the error is gone
+	done := make(chan error)
+	go func() {
+		atomic.StoreInt32(&failLoad, 0)
+		done <- fsp.Start()
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Start is blocked after a failed start")
+	}
+	if fsp.State() != StateRunning {
+		t.Fatalf("state is %s, expected %s", fsp.State(), StateRunning)
+	}
+	// The paths provided to the failed Watch call are watched on restart
+	if fm.watchedInodes() == 0 {
+		t.Error("no inode was watched")
+	}
+	if err := fsp.Stop(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRestart(t *testing.T) {
+	fm := &fakeMonitor{}
+	fsp, loads := newTestProbe(t, fm, nil)
+	root := newTestTree(t)
+
+	if fsp.State() != StateCreated {
+		t.Fatalf("state is %s, expected %s", fsp.State(), StateCreated)
+	}
+	for i := 1; i <= 3; i++ {
+		if err := fsp.Watch(root); err != nil {
+			t.Fatal(err)
+		}
+		if fsp.State() != StateRunning {
+			t.Fatalf("state is %s, expected %s", fsp.State(), StateRunning)
+		}
+		if fm.watchedInodes() == 0 {
+			t.Fatalf("no inode was watched after start #%d", i)
+		}
+		if err := fsp.Stop(); err != nil {
+			t.Fatal(err)
+		}
+		if fsp.State() != StateStopped {
+			t.Fatalf("state is %s, expected %s", fsp.State(), StateStopped)
+		}
+		// Stopping a stopped probe is a no-op
+		if err := fsp.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := atomic.LoadInt32(loads); got != 3 {
+		t.Errorf("eBPF programs loaded %d times, expected 3", got)
+	}
+	if starts, stops := atomic.LoadInt32(&fm.starts), atomic.LoadInt32(&fm.stops); starts != 3 || stops != 3 {
+		t.Errorf("monitor started %d times and stopped %d times, expected 3 and 3", starts, stops)
+	}
+}
+
+func TestConcurrentLifecycle(t *testing.T) {
+	fm := &fakeMonitor{}
+	fsp, _ := newTestProbe(t, fm, nil)
+	root := newTestTree(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(4)
+		go func() {
+			defer wg.Done()
+			if err := fsp.Watch(root); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if err := fsp.Start(); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if err := fsp.Stop(); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			_ = fsp.State()
+			if err := fsp.Unwatch(filepath.Join(root, "a")); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Every successful start must have been matched by a stop, except for the current run
+	starts, stops := atomic.LoadInt32(&fm.starts), atomic.LoadInt32(&fm.stops)
+	switch fsp.State() {
+	case StateRunning:
+		if starts != stops+1 {
+			t.Errorf("running with %d starts and %d stops", starts, stops)
+		}
+	case StateStopped, StateCreated:
+		if starts != stops {
+			t.Errorf("stopped with %d starts and %d stops", starts, stops)
+		}
+	default:
+		t.Errorf("unexpected state %s", fsp.State())
+	}
+	if err := fsp.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if fsp.State() == StateRunning {
+		t.Error("FSProbe is still running")
+	}
+}
@@ -0,0 +1,295 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Gui774ume/ebpf"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// metricsContentType - Content type of the Prometheus text exposition format
+const metricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// metricsMaps - eBPF maps for which the fill ratio is exported
+var metricsMaps = []string{
+	model.DentryCacheMap,
+	model.InodesFilterMap,
+	model.PathFragmentsMap,
+	model.SingleFragmentsMap,
+	model.CachedInodesMap,
+}
+
+// mapMetrics - Fill level of an eBPF map
+type mapMetrics struct {
+	name       string
+	entries    int
+	maxEntries uint32
+}
+
+// runtimeMetrics - Metrics that can only be collected while FSProbe is running
+type runtimeMetrics struct {
+	running        bool
+	maps           []mapMetrics
+	resolverCaches map[string]int
+}
+
+// collectRuntimeMetrics - Reads the fill levels of the eBPF maps and of the resolver caches
+func (fsp *FSProbe) collectRuntimeMetrics() runtimeMetrics {
+	rm := runtimeMetrics{
+		resolverCaches: make(map[string]int),
+	}
+	fsp.lock.Lock()
+	if fsp.State() != StateRunning {
+		fsp.lock.Unlock()
+		return rm
+	}
+	rm.running = true
+	// Walking the biggest maps takes a while: the maps are cloned so that their entries can be counted without
+	// blocking Watch, Unwatch and Stop.
+	var clones []*ebpf.Map
+	if fsp.collection != nil {
+		for _, name := range metricsMaps {
+			m, ok := fsp.collection.Maps[name]
+			if !ok || m == nil {
+				continue
+			}
+			clone, err := m.Clone()
+			if err != nil {
+				logrus.Debugf("couldn't clone %s: %v", name, err)
+				continue
+			}
+			rm.maps = append(rm.maps, mapMetrics{
+				name:       name,
+				maxEntries: m.ABI().MaxEntries,
+			})
+			clones = append(clones, clone)
+		}
+	}
+	// The caches of the fragments methods are the path_fragments and single_fragments maps, which are already
+	// exported above. Only the user space cache of the perf buffer method is reported.
+	if fsp.options.DentryResolutionMode == model.DentryResolutionPerfBuffer {
+		for _, m := range fsp.monitors {
+			resolver := m.GetDentryResolver()
+			if resolver == nil {
+				continue
+			}
+			size, err := resolver.CacheLen()
+			if err != nil {
+				logrus.Debugf("couldn't compute the size of the resolver cache of %s: %v", m.GetName(), err)
+			}
+			rm.resolverCaches[m.GetName()] += size
+		}
+	}
+	fsp.lock.Unlock()
+
+	for i, clone := range clones {
+		entries, err := model.CountMapEntries(clone)
+		if err != nil {
+			logrus.Debugf("couldn't count the entries of %s: %v", rm.maps[i].name, err)
+		}
+		rm.maps[i].entries = entries
+		_ = clone.Close()
+	}
+	return rm
+}
+
+// WriteMetrics - Writes the metrics of FSProbe in the Prometheus text exposition format. This function is thread
+// safe.
+func (fsp *FSProbe) WriteMetrics(w io.Writer) error {
+	stats := fsp.Stats()
+	rm := fsp.collectRuntimeMetrics()
+	mw := &metricsWriter{w: bufio.NewWriter(w)}
+
+	mw.header("fsprobe_running", "gauge", "1 if FSProbe is running, 0 otherwise")
+	mw.sample("fsprobe_running", nil, boolToFloat(rm.running))
+
+	mw.header("fsprobe_events_total", "counter", "Number of events received from the kernel")
+	counters := fsp.stats.EventCounters()
+	labelSets := make([]model.EventLabels, 0, len(counters))
+	for labels := range counters {
+		labelSets = append(labelSets, labels)
+	}
+	sort.Slice(labelSets, func(i, j int) bool {
+		if labelSets[i].Event != labelSets[j].Event {
+			return labelSets[i].Event < labelSets[j].Event
+		}
+		if labelSets[i].Comm != labelSets[j].Comm {
+			return labelSets[i].Comm < labelSets[j].Comm
+		}
+		return labelSets[i].Retval < labelSets[j].Retval
+	})
+	for _, labels := range labelSets {
+		mw.sample("fsprobe_events_total", []string{
+			"event", string(labels.Event),
+			"comm", labels.Comm,
+			"retval", strconv.Itoa(int(labels.Retval)),
+		}, float64(counters[labels]))
+	}
+
+	mw.header("fsprobe_parse_failures_total", "counter", "Number of events that couldn't be parsed")
+	mw.sample("fsprobe_parse_failures_total", nil, float64(stats.ParseFailures))
+	mw.header("fsprobe_resolution_failures_total", "counter", "Number of events with a path that couldn't be resolved")
+	mw.sample("fsprobe_resolution_failures_total", nil, float64(stats.ResolutionFailures))
+
+	mw.header("fsprobe_lost_events_total", "counter", "Number of lost events, by perf map and reason")
+	for _, name := range sortedStrings(stats.LostSamples) {
+		mw.sample("fsprobe_lost_events_total", []string{"map", name, "reason", string(model.LostReasonPerfBuffer)}, float64(stats.LostSamples[name]))
+	}
+	mw.sample("fsprobe_lost_events_total", []string{"map", "", "reason", string(model.LostReasonUserSpace)}, float64(stats.UserSpaceDrops))
+
+	mw.header("fsprobe_resolver_cache_entries", "gauge", "Number of entries in the user space dentry resolver cache, by monitor")
+	for _, name := range sortedStrings(rm.resolverCaches) {
+		mw.sample("fsprobe_resolver_cache_entries", []string{"monitor", name}, float64(rm.resolverCaches[name]))
+	}
+	mw.header("fsprobe_resolver_cache_hits_total", "counter", "Number of hits of the user space dentry resolver cache")
+	mw.sample("fsprobe_resolver_cache_hits_total", nil, float64(stats.ResolverCache.Hits))
+	mw.header("fsprobe_resolver_cache_misses_total", "counter", "Number of misses of the user space dentry resolver cache")
+	mw.sample("fsprobe_resolver_cache_misses_total", nil, float64(stats.ResolverCache.Misses))
+	mw.header("fsprobe_resolver_cache_evictions_total", "counter", "Number of evictions of the user space dentry resolver cache")
+	mw.sample("fsprobe_resolver_cache_evictions_total", nil, float64(stats.ResolverCache.Evictions))
+
+	mw.header("fsprobe_ebpf_map_entries", "gauge", "Number of entries of an eBPF map")
+	for _, m := range rm.maps {
+		mw.sample("fsprobe_ebpf_map_entries", []string{"map", m.name}, float64(m.entries))
+	}
+	mw.header("fsprobe_ebpf_map_max_entries", "gauge", "Maximum number of entries of an eBPF map")
+	for _, m := range rm.maps {
+		mw.sample("fsprobe_ebpf_map_max_entries", []string{"map", m.name}, float64(m.maxEntries))
+	}
+	mw.header("fsprobe_ebpf_map_fill_ratio", "gauge", "Ratio of used entries of an eBPF map")
+	for _, m := range rm.maps {
+		var ratio float64
+		if m.maxEntries > 0 {
+			ratio = float64(m.entries) / float64(m.maxEntries)
+		}
+		mw.sample("fsprobe_ebpf_map_fill_ratio", []string{"map", m.name}, ratio)
+	}
+
+	mw.header("fsprobe_watched_inodes", "gauge", "Number of entries in the in-kernel inode filter")
+	for _, m := range rm.maps {
+		if m.name == model.InodesFilterMap {
+			mw.sample("fsprobe_watched_inodes", nil, float64(m.entries))
+		}
+	}
+
+	mw.header("fsprobe_channel_length", "gauge", "Number of events buffered in a user space channel")
+	for _, c := range stats.Channels {
+		mw.sample("fsprobe_channel_length", []string{"channel", c.Name}, float64(c.Len))
+	}
+	mw.header("fsprobe_channel_capacity", "gauge", "Size of the buffer of a user space channel")
+	for _, c := range stats.Channels {
+		mw.sample("fsprobe_channel_capacity", []string{"channel", c.Name}, float64(c.Cap))
+	}
+	mw.header("fsprobe_channel_dropped_total", "counter", "Number of events dropped by the backpressure policy of a user space channel")
+	for _, c := range stats.Channels {
+		mw.sample("fsprobe_channel_dropped_total", []string{"channel", c.Name}, float64(c.Dropped))
+	}
+	return mw.flush()
+}
+
+// MetricsHandler - Returns an HTTP handler that serves the metrics of FSProbe in the Prometheus text exposition format
+func (fsp *FSProbe) MetricsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", metricsContentType)
+		if err := fsp.WriteMetrics(w); err != nil {
+			logrus.Debugf("couldn't write metrics: %v", err)
+		}
+	})
+}
+
+// metricsWriter - Writes metrics in the Prometheus text exposition format
+type metricsWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+// header - Writes the HELP and TYPE lines of a metric
+func (mw *metricsWriter) header(name string, metricType string, help string) {
+	mw.printf("# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+// sample - Writes a sample. labels is a list of label names and values.
+func (mw *metricsWriter) sample(name string, labels []string, value float64) {
+	var b strings.Builder
+	b.WriteString(name)
+	if len(labels) > 0 {
+		b.WriteByte('{')
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			fmt.Fprintf(&b, "%s=\"%s\"", labels[i], escapeLabelValue(labels[i+1]))
+		}
+		b.WriteByte('}')
+	}
+	mw.printf("%s %s\n", b.String(), strconv.FormatFloat(value, 'g', -1, 64))
+}
+
+func (mw *metricsWriter) printf(format string, args ...interface{}) {
+	if mw.err != nil {
+		return
+	}
+	_, mw.err = fmt.Fprintf(mw.w, format, args...)
+}
+
+func (mw *metricsWriter) flush() error {
+	if mw.err != nil {
+		return mw.err
+	}
+	return mw.w.Flush()
+}
+
+// labelValueEscaper - Escapes the backslashes, double quotes and line feeds of label values
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// escapeLabelValue - Returns a valid label value, process names aren't always valid UTF-8
+func escapeLabelValue(value string) string {
+	return labelValueEscaper.Replace(strings.ToValidUTF8(value, "?"))
+}
+
+// sortedStrings - Returns the sorted keys of a map
+func sortedStrings(m interface{}) []string {
+	var keys []string
+	switch counters := m.(type) {
+	case map[string]uint64:
+		for key := range counters {
+			keys = append(keys, key)
+		}
+	case map[string]int:
+		for key := range counters {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,61 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+func TestMetricsHandler(t *testing.T) {
+	fsp, _ := newTestProbe(t, &fakeMonitor{}, nil)
+	fsp.DispatchEvent(&model.FSEvent{EventType: model.Open, Comm: "cat", Retval: 0})
+	fsp.DispatchEvent(&model.FSEvent{EventType: model.Open, Comm: "cat", Retval: 0})
+	fsp.DispatchEvent(&model.FSEvent{EventType: model.Unlink, Comm: `a"b`, Retval: -2})
+	fsp.DispatchLost(&model.LostEvt{Count: 4, Map: model.FSEventsMap, Reason: model.LostReasonPerfBuffer})
+
+	server := httptest.NewServer(fsp.MetricsHandler())
+	defer server.Close()
+	resp, err := http.Get(server.URL + "/metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if contentType := resp.Header.Get("Content-Type"); !strings.HasPrefix(contentType, "text/plain") {
+		t.Errorf("unexpected content type %q", contentType)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"# TYPE fsprobe_events_total counter",
+		`fsprobe_events_total{event="open",comm="cat",retval="0"} 2`,
+		`fsprobe_events_total{event="unlink",comm="a\"b",retval="-2"} 1`,
+		`fsprobe_lost_events_total{map="fs_events",reason="perf_buffer"} 4`,
+		`fsprobe_lost_events_total{map="",reason="user_space"} 0`,
+		"fsprobe_running 0",
+	} {
+		if !strings.Contains(string(body), expected+"\n") {
+			t.Errorf("metric %s not found in:\n%s", expected, body)
+		}
+	}
+}
@@ -22,9 +22,9 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-var (
-	// Monitor - eBPF FIM event monitor
-	Monitor = &model.Monitor{
+// NewMonitor - Returns a new eBPF FIM event monitor
+func NewMonitor() *model.Monitor {
+	return &model.Monitor{
 		Name:               "FileSystem",
 		InodeFilterSection: model.InodesFilterMap,
 		ResolutionModeMaps: map[model.DentryResolutionMode][]string{
@@ -33,7 +33,17 @@ var (
 				model.FSEventsMap,
 				model.DentryCacheMap,
 				model.DentryCacheBuilderMap,
+				model.SymlinkBuilderMap,
 				model.InodesFilterMap,
+				model.ExcludedNamesMap,
+				model.PidFilterMap,
+				model.CommFilterMap,
+				model.UIDFilterMap,
+				model.GIDFilterMap,
+				model.CgroupFilterMap,
+				model.ProcessFiltersAllowCountMap,
+				model.ProcessEventsMap,
+				model.ExecEventBuilderMap,
 			},
 			model.DentryResolutionSingleFragment: []string{
 				model.SingleFragmentsMap,
@@ -42,7 +52,17 @@ var (
 				model.DentryCacheMap,
 				model.DentryCacheBuilderMap,
 				model.PathsBuilderMap,
+				model.SymlinkBuilderMap,
 				model.InodesFilterMap,
+				model.ExcludedNamesMap,
+				model.PidFilterMap,
+				model.CommFilterMap,
+				model.UIDFilterMap,
+				model.GIDFilterMap,
+				model.CgroupFilterMap,
+				model.ProcessFiltersAllowCountMap,
+				model.ProcessEventsMap,
+				model.ExecEventBuilderMap,
 			},
 			model.DentryResolutionPerfBuffer: []string{
 				model.CachedInodesMap,
@@ -51,6 +71,15 @@ var (
 				model.DentryCacheBuilderMap,
 				model.PathsBuilderMap,
 				model.InodesFilterMap,
+				model.ExcludedNamesMap,
+				model.PidFilterMap,
+				model.CommFilterMap,
+				model.UIDFilterMap,
+				model.GIDFilterMap,
+				model.CgroupFilterMap,
+				model.ProcessFiltersAllowCountMap,
+				model.ProcessEventsMap,
+				model.ExecEventBuilderMap,
 			},
 		},
 		Probes: map[model.EventName][]*model.Probe{
@@ -62,6 +91,8 @@ var (
 					Type:        ebpf.Kprobe,
 					Constants: []string{
 						model.InodeFilteringModeConst,
+						model.MntNsInumOffsetConst,
+						model.VfsmountMntIDOffsetConst,
 					},
 				},
 				&model.Probe{
@@ -82,6 +113,8 @@ var (
 					Type:        ebpf.Kprobe,
 					Constants: []string{
 						model.InodeFilteringModeConst,
+						model.MntNsInumOffsetConst,
+						model.SbMountsMntIDOffsetConst,
 					},
 				},
 				&model.Probe{
@@ -103,6 +136,8 @@ var (
 					Type:        ebpf.Kprobe,
 					Constants: []string{
 						model.InodeFilteringModeConst,
+						model.MntNsInumOffsetConst,
+						model.SbMountsMntIDOffsetConst,
 					},
 				},
 				&model.Probe{
@@ -123,6 +158,8 @@ var (
 					Type:        ebpf.Kprobe,
 					Constants: []string{
 						model.InodeFilteringModeConst,
+						model.MntNsInumOffsetConst,
+						model.SbMountsMntIDOffsetConst,
 					},
 				},
 				&model.Probe{
@@ -144,6 +181,8 @@ var (
 					Constants: []string{
 						model.DentryResolutionModeConst,
 						model.InodeFilteringModeConst,
+						model.MntNsInumOffsetConst,
+						model.SbMountsMntIDOffsetConst,
 					},
 				},
 				&model.Probe{
@@ -151,6 +190,29 @@ var (
 					SectionName: "kretprobe/vfs_link",
 					Enabled:     false,
 					Type:        ebpf.Kprobe,
+					Constants: []string{
+						model.DentryResolutionModeConst,
+						model.SbMountsMntIDOffsetConst,
+					},
+				},
+			},
+			model.Symlink: []*model.Probe{
+				&model.Probe{
+					Name:        "symlink",
+					SectionName: "kprobe/vfs_symlink",
+					Enabled:     false,
+					Type:        ebpf.Kprobe,
+					Constants: []string{
+						model.InodeFilteringModeConst,
+						model.MntNsInumOffsetConst,
+						model.SbMountsMntIDOffsetConst,
+					},
+				},
+				&model.Probe{
+					Name:        "symlink_ret",
+					SectionName: "kretprobe/vfs_symlink",
+					Enabled:     false,
+					Type:        ebpf.Kprobe,
 					Constants: []string{
 						model.DentryResolutionModeConst,
 					},
@@ -165,6 +227,8 @@ var (
 					Constants: []string{
 						model.DentryResolutionModeConst,
 						model.InodeFilteringModeConst,
+						model.MntNsInumOffsetConst,
+						model.SbMountsMntIDOffsetConst,
 					},
 				},
 				&model.Probe{
@@ -175,6 +239,7 @@ var (
 					Constants: []string{
 						model.DentryResolutionModeConst,
 						model.FollowModeConst,
+						model.SbMountsMntIDOffsetConst,
 					},
 				},
 			},
@@ -186,6 +251,8 @@ var (
 					Type:        ebpf.Kprobe,
 					Constants: []string{
 						model.InodeFilteringModeConst,
+						model.MntNsInumOffsetConst,
+						model.SbMountsMntIDOffsetConst,
 					},
 				},
 				&model.Probe{
@@ -206,6 +273,8 @@ var (
 					Type:        ebpf.Kprobe,
 					Constants: []string{
 						model.InodeFilteringModeConst,
+						model.MntNsInumOffsetConst,
+						model.SbMountsMntIDOffsetConst,
 					},
 				},
 				&model.Probe{
@@ -218,6 +287,31 @@ var (
 					},
 				},
 			},
+			model.ProcessExecProbes: []*model.Probe{
+				&model.Probe{
+					Name:        "sched_process_exec",
+					SectionName: "tracepoint/sched/sched_process_exec",
+					Enabled:     false,
+					Type:        ebpf.TracePoint,
+					Constants: []string{
+						model.MntNsInumOffsetConst,
+					},
+				},
+			},
+			model.ProcessTreeProbes: []*model.Probe{
+				&model.Probe{
+					Name:        "sched_process_fork",
+					SectionName: "tracepoint/sched/sched_process_fork",
+					Enabled:     false,
+					Type:        ebpf.TracePoint,
+				},
+				&model.Probe{
+					Name:        "sched_process_exit",
+					SectionName: "tracepoint/sched/sched_process_exit",
+					Enabled:     false,
+					Type:        ebpf.TracePoint,
+				},
+			},
 		},
 		PerfMaps: []*model.PerfMap{
 			&model.PerfMap{
@@ -226,19 +320,24 @@ var (
 				DataHandler:        HandleFSEvent,
 				LostHandler:        LostFSEvent,
 			},
+			&model.PerfMap{
+				UserSpaceBufferLen: 1000,
+				PerfOutputMapName:  "process_events",
+				DataHandler:        HandleExecEvent,
+				LostHandler:        LostExecEvent,
+			},
 		},
 	}
-)
+}
 
 // LostFSEvent - Handles a LostEvent
 func LostFSEvent(count uint64, mapName string, monitor *model.Monitor) {
 	// Dispatch event
-	if monitor.Options.LostChan != nil {
-		monitor.Options.LostChan <- &model.LostEvt{
-			Count: count,
-			Map:   mapName,
-		}
-	}
+	monitor.FSProbe.DispatchLost(&model.LostEvt{
+		Count:  count,
+		Map:    mapName,
+		Reason: model.LostReasonPerfBuffer,
+	})
 }
 
 // HandleFSEvent - Handles a file system event
@@ -246,6 +345,7 @@ func HandleFSEvent(data []byte, monitor *model.Monitor) {
 	// Prepare event
 	event, err := model.ParseFSEvent(data, monitor)
 	if err != nil {
+		monitor.Stats.CountParseFailure()
 		logrus.Warnf("couldn't parse FSEvent: %v", err)
 		return
 	}
@@ -255,11 +355,11 @@ func HandleFSEvent(data []byte, monitor *model.Monitor) {
 	case model.Unlink:
 		switch monitor.Options.DentryResolutionMode {
 		case model.DentryResolutionSingleFragment:
-			if err := monitor.DentryResolver.RemoveEntry(event.SrcPathnameKey); err != nil {
+			if err := monitor.DentryResolver.RemoveEntry(event.SrcMountID, event.SrcPathnameKey); err != nil {
 				logrus.Warnf("couldn't clear cache: %v", err)
 			}
 		case model.DentryResolutionPerfBuffer:
-			if err := monitor.DentryResolver.RemoveEntry(uint32(event.SrcInode)); err != nil {
+			if err := monitor.DentryResolver.RemoveEntry(event.SrcMountID, event.SrcInode); err != nil {
 				logrus.Warnf("couldn't clear cache: %v", err)
 			}
 		case model.DentryResolutionFragments:
@@ -267,10 +367,35 @@ func HandleFSEvent(data []byte, monitor *model.Monitor) {
 				logrus.Warnf("couldn't clear cache: %v", err)
 			}
 		}
+	case model.Mkdir:
+		// In recursive mode, the kernel watches new directories automatically. Only plain directory names can be
+		// excluded in kernel space, check the other excludes now that the path is resolved.
+		if monitor.Options.Recursive && event.Retval == 0 && monitor.Excludes.Match(event.SrcFilename) {
+			if err := monitor.RemoveInodeFilter(event.SrcMountID, event.SrcInode, event.SrcFilename); err != nil {
+				logrus.Debugf("couldn't unwatch excluded directory %s: %v", event.SrcFilename, err)
+			}
+		}
 	}
 
 	// Dispatch event
-	if monitor.Options.EventChan != nil {
-		monitor.Options.EventChan <- event
+	monitor.FSProbe.DispatchEvent(event)
+}
+
+// LostExecEvent - Handles the lost process execution events, they are only counted: the processes are resolved from
+// /proc instead
+func LostExecEvent(count uint64, mapName string, monitor *model.Monitor) {
+	monitor.Stats.CountLostSamples(mapName, count)
+}
+
+// HandleExecEvent - Handles a process execution event
+func HandleExecEvent(data []byte, monitor *model.Monitor) {
+	var event model.ExecEvent
+	if _, err := event.UnmarshalBinary(data); err != nil {
+		monitor.Stats.CountParseFailure()
+		logrus.Warnf("couldn't parse ExecEvent: %v", err)
+		return
+	}
+	if monitor.ProcessCache != nil {
+		monitor.ProcessCache.AddExec(&event)
 	}
 }
@@ -20,9 +20,9 @@ import (
 	"github.com/Gui774ume/fsprobe/pkg/model"
 )
 
-// RegisterMonitors - Register monitors
+// RegisterMonitors - Returns new instances of the monitors
 func RegisterMonitors() []*model.Monitor {
 	return []*model.Monitor{
-		fs.Monitor,
+		fs.NewMonitor(),
 	}
 }
@@ -0,0 +1,139 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+
+	"github.com/Gui774ume/fsprobe/pkg/btf"
+)
+
+// kernelOffsets - Offsets of the kernel structure fields read by the eBPF programs
+type kernelOffsets struct {
+	// VfsmountMntID - Offset of mount->mnt_id relative to mount->mnt
+	VfsmountMntID int64
+	// SbMountsMntID - Offset of mount->mnt_id relative to the mount pointed by super_block->s_mounts
+	SbMountsMntID int64
+	// SbMountsMntMountpoint - Offset of mount->mnt_mountpoint relative to the mount pointed by super_block->s_mounts
+	SbMountsMntMountpoint int64
+	// MntNsInum - Offset of mnt_namespace->ns.inum
+	MntNsInum int64
+}
+
+// kernelOffsetsTable - Known offsets, used when the kernel doesn't expose its BTF type information. Keys are the
+// "major.minor" kernel versions.
+var kernelOffsetsTable = map[string]kernelOffsets{
+	"5.3": {
+		VfsmountMntID:         252,
+		SbMountsMntID:         172,
+		SbMountsMntMountpoint: -88,
+		MntNsInum:             24,
+	},
+}
+
+// resolveKernelOffsets - Computes the kernel structure offsets using the kernel BTF type information, or falls back
+// to the offsets table.
+func resolveKernelOffsets() (*kernelOffsets, error) {
+	spec, err := btf.LoadKernelSpec()
+	if err == nil {
+		offsets, err := computeKernelOffsets(spec)
+		if err == nil {
+			return offsets, nil
+		}
+		logrus.Warnf("couldn't compute kernel offsets from BTF, falling back to the offsets table: %v", err)
+	}
+	release, err := getKernelRelease()
+	if err != nil {
+		return nil, err
+	}
+	version := kernelMajorMinor(release)
+	offsets, ok := kernelOffsetsTable[version]
+	if !ok {
+		return nil, fmt.Errorf("kernel BTF is not available at %s and no offset table matches kernel %s", btf.KernelBTFPath, release)
+	}
+	return &offsets, nil
+}
+
+// memberOffsetResolver - Resolves the offsets of struct members, implemented by btf.Spec
+type memberOffsetResolver interface {
+	MemberOffset(structName string, member string) (uint64, error)
+}
+
+// computeKernelOffsets - Computes the kernel structure offsets from the provided BTF type information
+func computeKernelOffsets(spec memberOffsetResolver) (*kernelOffsets, error) {
+	mnt, err := spec.MemberOffset("mount", "mnt")
+	if err != nil {
+		return nil, err
+	}
+	mntID, err := spec.MemberOffset("mount", "mnt_id")
+	if err != nil {
+		return nil, err
+	}
+	mntMountpoint, err := spec.MemberOffset("mount", "mnt_mountpoint")
+	if err != nil {
+		return nil, err
+	}
+	// super_block->s_mounts points either to mount->mnt_instance (list_head), or directly to the first mount of the
+	// super block on kernels where mounts are chained with mnt_next_for_sb.
+	sbMounts, err := spec.MemberOffset("mount", "mnt_instance")
+	if err != nil {
+		if _, errNext := spec.MemberOffset("mount", "mnt_next_for_sb"); errNext != nil {
+			return nil, err
+		}
+		sbMounts = 0
+	}
+	mntNs, err := spec.MemberOffset("mnt_namespace", "ns")
+	if err != nil {
+		return nil, err
+	}
+	nsInum, err := spec.MemberOffset("ns_common", "inum")
+	if err != nil {
+		return nil, err
+	}
+	return &kernelOffsets{
+		VfsmountMntID:         int64(mntID) - int64(mnt),
+		SbMountsMntID:         int64(mntID) - int64(sbMounts),
+		SbMountsMntMountpoint: int64(mntMountpoint) - int64(sbMounts),
+		MntNsInum:             int64(mntNs) + int64(nsInum),
+	}, nil
+}
+
+// getKernelRelease - Returns the release of the running kernel
+func getKernelRelease() (string, error) {
+	var uname unix.Utsname
+	if err := unix.Uname(&uname); err != nil {
+		return "", errors.Wrap(err, "couldn't get kernel release")
+	}
+	return unix.ByteSliceToString(uname.Release[:]), nil
+}
+
+// kernelMajorMinor - Returns the "major.minor" version of the provided kernel release
+func kernelMajorMinor(release string) string {
+	parts := strings.SplitN(release, ".", 3)
+	if len(parts) < 2 {
+		return release
+	}
+	minor := parts[1]
+	if i := strings.IndexFunc(minor, func(r rune) bool { return r < '0' || r > '9' }); i >= 0 {
+		minor = minor[:i]
+	}
+	return parts[0] + "." + minor
+}
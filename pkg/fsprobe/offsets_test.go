@@ -0,0 +1,134 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gui774ume/fsprobe/pkg/btf"
+)
+
+// fakeMemberOffsets - Struct member offsets keyed by "struct.member"
+type fakeMemberOffsets map[string]uint64
+
+func (f fakeMemberOffsets) MemberOffset(structName string, member string) (uint64, error) {
+	offset, ok := f[structName+"."+member]
+	if !ok {
+		return 0, errors.Wrapf(btf.ErrNotFound, "couldn't find member %s of struct %s", member, structName)
+	}
+	return offset, nil
+}
+
+func TestComputeKernelOffsets(t *testing.T) {
+	tests := []struct {
+		name     string
+		members  fakeMemberOffsets
+		expected *kernelOffsets
+	}{
+		{
+			name: "mnt_instance",
+			members: fakeMemberOffsets{
+				"mount.mnt":             32,
+				"mount.mnt_id":          284,
+				"mount.mnt_mountpoint":  24,
+				"mount.mnt_instance":    112,
+				"mnt_namespace.ns":      8,
+				"ns_common.inum":        16,
+				"mount.mnt_next_for_sb": 200,
+			},
+			expected: &kernelOffsets{
+				VfsmountMntID:         252,
+				SbMountsMntID:         172,
+				SbMountsMntMountpoint: -88,
+				MntNsInum:             24,
+			},
+		},
+		{
+			// super_block->s_mounts points to the first mount itself
+			name: "mnt_next_for_sb",
+			members: fakeMemberOffsets{
+				"mount.mnt":             32,
+				"mount.mnt_id":          284,
+				"mount.mnt_mountpoint":  24,
+				"mount.mnt_next_for_sb": 200,
+				"mnt_namespace.ns":      8,
+				"ns_common.inum":        16,
+			},
+			expected: &kernelOffsets{
+				VfsmountMntID:         252,
+				SbMountsMntID:         284,
+				SbMountsMntMountpoint: 24,
+				MntNsInum:             24,
+			},
+		},
+		{
+			name: "no s_mounts list",
+			members: fakeMemberOffsets{
+				"mount.mnt":            32,
+				"mount.mnt_id":         284,
+				"mount.mnt_mountpoint": 24,
+				"mnt_namespace.ns":     8,
+				"ns_common.inum":       16,
+			},
+		},
+		{
+			name: "missing mnt_id",
+			members: fakeMemberOffsets{
+				"mount.mnt":            32,
+				"mount.mnt_mountpoint": 24,
+				"mount.mnt_instance":   112,
+				"mnt_namespace.ns":     8,
+				"ns_common.inum":       16,
+			},
+		},
+	}
+	for _, tt := range tests {
+		offsets, err := computeKernelOffsets(tt.members)
+		if tt.expected == nil {
+			if errors.Cause(err) != btf.ErrNotFound {
+				t.Errorf("%s: expected ErrNotFound, got %+v, %v", tt.name, offsets, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if *offsets != *tt.expected {
+			t.Errorf("%s: expected %+v, got %+v", tt.name, *tt.expected, *offsets)
+		}
+	}
+}
+
+func TestKernelMajorMinor(t *testing.T) {
+	tests := map[string]string{
+		"5.3.0-26-generic":      "5.3",
+		"5.15.0":                "5.15",
+		"6.1":                   "6.1",
+		"4.19.0+":               "4.19",
+		"5.4rc1":                "5.4",
+		"5.10.0-1057-aws":       "5.10",
+		"invalid":               "invalid",
+		"5.14.0-362.el9.x86_64": "5.14",
+	}
+	for release, expected := range tests {
+		if version := kernelMajorMinor(release); version != expected {
+			t.Errorf("%s: expected %s, got %s", release, expected, version)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// eventQueue - Sends events to a buffered channel according to a backpressure policy
+type eventQueue struct {
+	events   chan *model.FSEvent
+	policy   model.BackpressurePolicy
+	spill    *spillQueue
+	done     chan struct{}
+	doneOnce sync.Once
+	dropped  uint64
+}
+
+// newEventQueue - Returns a new event queue for the provided channel
+func newEventQueue(events chan *model.FSEvent, policy model.BackpressurePolicy, options *model.FSProbeOptions) *eventQueue {
+	q := &eventQueue{
+		events: events,
+		policy: policy,
+		done:   make(chan struct{}),
+	}
+	if policy == model.BackpressureSpill {
+		q.spill = newSpillQueue(options.SpillDir, options.SpillMaxSize, events)
+	}
+	return q
+}
+
+// send - Sends an event to the channel and returns the number of events dropped to do so
+func (q *eventQueue) send(evt *model.FSEvent) uint64 {
+	var dropped uint64
+	switch q.policy {
+	case model.BackpressureDropNewest:
+		select {
+		case q.events <- evt:
+		default:
+			dropped++
+		}
+	case model.BackpressureDropOldest:
+		for {
+			select {
+			case q.events <- evt:
+				atomic.AddUint64(&q.dropped, dropped)
+				return dropped
+			default:
+			}
+			// Make room for the new event
+			select {
+			case <-q.events:
+				dropped++
+			default:
+			}
+		}
+	case model.BackpressureSpill:
+		if !q.spill.push(evt) {
+			dropped++
+		}
+	default:
+		select {
+		case q.events <- evt:
+		case <-q.done:
+			// The queue is closing, deliver what can be buffered without blocking
+			select {
+			case q.events <- evt:
+			default:
+				dropped++
+			}
+		}
+	}
+	atomic.AddUint64(&q.dropped, dropped)
+	return dropped
+}
+
+// stop - Stops waiting for the channel to have room, the channel can still buffer events
+func (q *eventQueue) stop() {
+	q.doneOnce.Do(func() {
+		close(q.done)
+	})
+}
+
+// shutdown - Stops the queue and moves the spilled events to the channel without blocking. Returns the number of
+// spilled events that were dropped. The caller must make sure that no event is being sent.
+func (q *eventQueue) shutdown() uint64 {
+	q.stop()
+	if q.spill == nil {
+		return 0
+	}
+	dropped := q.spill.close()
+	atomic.AddUint64(&q.dropped, dropped)
+	return dropped
+}
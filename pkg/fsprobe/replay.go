@@ -0,0 +1,84 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// Replay - Replays a capture recorded with the Recorder option: the recorded samples are decoded by the handlers of
+// the monitors, without loading eBPF, and the resulting events are sent to the event channel of the options and to
+// the subscribers. The options of the capture (dentry resolution mode, watch mode, excludes), its boot time and its
+// mount points are used instead of the options of FSProbe. Replay blocks until the end of the capture or until the
+// context is cancelled, and then closes the streams. FSProbe can't be running during a replay.
+func (fsp *FSProbe) Replay(ctx context.Context, reader *model.CaptureReader) error {
+	fsp.lock.Lock()
+	defer fsp.lock.Unlock()
+	if fsp.State() == StateRunning {
+		return errors.New("fsprobe is running")
+	}
+	fsp.setState(StateStarting)
+	var err error
+	if fsp.excludes, err = model.NewExcludes(fsp.options.ExcludePaths, fsp.options.ExcludePatterns); err == nil {
+		if fsp.options.EventChan != nil {
+			fsp.eventQueue = newEventQueue(fsp.options.EventChan, fsp.options.Backpressure, fsp.options)
+		}
+		fsp.monitors = fsp.newMonitors()
+		fsp.setState(StateRunning)
+		err = fsp.replay(ctx, reader)
+	}
+	fsp.setState(StateStopping)
+	fsp.teardown()
+	fsp.setState(StateStopped)
+	return err
+}
+
+// replay - Replays the records of a capture. The caller must hold the lock.
+func (fsp *FSProbe) replay(ctx context.Context, reader *model.CaptureReader) error {
+	started := false
+	for ctx.Err() == nil {
+		record, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if record.Type == model.CaptureRecordHeader {
+			// FSProbe was (re)started while recording
+			fsp.bootTime = record.Header.BootTime
+			for _, m := range fsp.monitors {
+				if err := m.InitReplay(fsp, record.Header); err != nil {
+					return errors.Wrapf(err, "couldn't init monitor %s", m.GetName())
+				}
+			}
+			started = true
+			continue
+		}
+		if !started {
+			return errors.New("the capture doesn't start with a header")
+		}
+		for _, m := range fsp.monitors {
+			m.ReplayRecord(record)
+		}
+	}
+	return ctx.Err()
+}
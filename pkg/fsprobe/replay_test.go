@@ -0,0 +1,249 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+	"github.com/Gui774ume/fsprobe/pkg/utils"
+)
+
+// testSample - Fields of a raw perf sample of the fs_events map
+type testSample struct {
+	timestamp     uint64
+	pid           uint32
+	ppid          uint32
+	comm          string
+	mntNs         uint32
+	pidNs         uint32
+	cgroupID      uint64
+	srcKey        uint64
+	targetKey     uint64
+	srcInode      uint64
+	srcMountID    uint32
+	targetInode   uint64
+	targetMountID uint32
+	retval        int32
+	eventType     uint32
+	srcPath       []byte
+	targetPath    []byte
+}
+
+// encode - Returns the sample as it is sent by the kernel, see FSEvent.UnmarshalBinary
+func (ts testSample) encode() []byte {
+	data := make([]byte, 128)
+	utils.ByteOrder.PutUint64(data[0:8], ts.timestamp)
+	utils.ByteOrder.PutUint32(data[8:12], ts.pid)
+	utils.ByteOrder.PutUint32(data[12:16], ts.pid)
+	copy(data[24:40], ts.comm)
+	utils.ByteOrder.PutUint32(data[40:44], ts.ppid)
+	utils.ByteOrder.PutUint32(data[44:48], ts.mntNs)
+	utils.ByteOrder.PutUint32(data[48:52], ts.pidNs)
+	utils.ByteOrder.PutUint64(data[56:64], ts.cgroupID)
+	utils.ByteOrder.PutUint64(data[72:80], ts.srcKey)
+	utils.ByteOrder.PutUint64(data[80:88], ts.targetKey)
+	utils.ByteOrder.PutUint64(data[88:96], ts.srcInode)
+	utils.ByteOrder.PutUint32(data[96:100], uint32(len(ts.srcPath)))
+	utils.ByteOrder.PutUint32(data[100:104], ts.srcMountID)
+	utils.ByteOrder.PutUint64(data[104:112], ts.targetInode)
+	utils.ByteOrder.PutUint32(data[112:116], uint32(len(ts.targetPath)))
+	utils.ByteOrder.PutUint32(data[116:120], ts.targetMountID)
+	utils.ByteOrder.PutUint32(data[120:124], uint32(ts.retval))
+	utils.ByteOrder.PutUint32(data[124:128], ts.eventType)
+	data = append(data, ts.srcPath...)
+	return append(data, ts.targetPath...)
+}
+
+// encodeExec - Returns a raw perf sample of the process_events map, see ExecEvent.UnmarshalBinary
+func encodeExec(pid uint32, ppid uint32, filename string, args string) []byte {
+	data := make([]byte, 72+model.ExecFilenameSize+model.ExecArgsSize)
+	utils.ByteOrder.PutUint32(data[8:12], pid)
+	utils.ByteOrder.PutUint32(data[12:16], pid)
+	utils.ByteOrder.PutUint32(data[40:44], ppid)
+	utils.ByteOrder.PutUint32(data[64:68], uint32(len(filename)+1))
+	utils.ByteOrder.PutUint32(data[68:72], uint32(len(args)))
+	copy(data[72:], filename)
+	copy(data[72+model.ExecFilenameSize:], args)
+	return data
+}
+
+func TestReplayPerfBuffer(t *testing.T) {
+	bootTime := time.Date(2020, 6, 7, 13, 0, 0, 0, time.UTC)
+	var buf bytes.Buffer
+	recorder, err := model.NewCaptureWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder.RecordHeader(&model.CaptureHeader{
+		BootTime:             bootTime,
+		DentryResolutionMode: model.DentryResolutionPerfBuffer,
+		Recursive:            true,
+		PathsFiltering:       true,
+		Mounts: []*model.MountInfo{
+			{MountID: 27, Root: "/", MountPoint: "/data", FSType: "ext4"},
+		},
+	})
+	// The watched directory is cached when it is watched
+	recorder.RecordCacheEntry(27, 100, "/data/dir")
+	recorder.RecordSample("process_events", encodeExec(42, 1, "/usr/bin/touch", "touch\x00file\x00"))
+	// The kernel sends the end of the path, up to the first cached parent
+	recorder.RecordSample("fs_events", testSample{
+		timestamp:  uint64(time.Second),
+		pid:        42,
+		ppid:       1,
+		comm:       "touch",
+		mntNs:      4026531841,
+		pidNs:      4026531836,
+		cgroupID:   1234,
+		srcKey:     100,
+		srcInode:   200,
+		srcMountID: 27,
+		eventType:  0,
+		srcPath:    []byte("file\x00"),
+	}.encode())
+	// Once cached, the path of the file is only referenced by its inode
+	recorder.RecordSample("fs_events", testSample{
+		timestamp:     uint64(2 * time.Second),
+		pid:           43,
+		comm:          "mv",
+		srcKey:        200,
+		srcInode:      200,
+		srcMountID:    27,
+		targetKey:     100,
+		targetInode:   300,
+		targetMountID: 27,
+		eventType:     3,
+		targetPath:    []byte("renamed\x00"),
+	}.encode())
+	// The link target of a symlink is sent after the path of the symlink
+	recorder.RecordSample("fs_events", testSample{
+		timestamp:  uint64(3 * time.Second),
+		pid:        44,
+		comm:       "ln",
+		srcKey:     100,
+		srcInode:   400,
+		srcMountID: 27,
+		eventType:  8,
+		srcPath:    []byte("link\x00"),
+		targetPath: []byte("../etc/passwd\x00"),
+	}.encode())
+	recorder.RecordLost("fs_events", 5)
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := model.NewCaptureReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsp := NewFSProbeWithOptions(model.FSProbeOptions{})
+	stream := fsp.Subscribe()
+	if err := fsp.Replay(context.Background(), reader); err != nil {
+		t.Fatal(err)
+	}
+
+	var events []*model.FSEvent
+	for {
+		evt, err := stream.Next(context.Background())
+		if err != nil {
+			break
+		}
+		events = append(events, evt)
+	}
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(events))
+	}
+	if evt := events[0]; evt.EventType != model.Open || evt.SrcFilename != "/data/dir/file" || evt.Comm != "touch" ||
+		!evt.Timestamp.Equal(bootTime.Add(time.Second)) || evt.MountPoint != "/data" || evt.FSType != "ext4" {
+		t.Errorf("unexpected open event: %+v", evt)
+	}
+	if evt := events[0]; evt.Ppid != 1 || evt.Exe != "/usr/bin/touch" || !reflect.DeepEqual(evt.Argv, []string{"touch", "file"}) {
+		t.Errorf("unexpected process context: %+v", evt)
+	}
+	if evt := events[0]; evt.MntNs != 4026531841 || evt.PidNs != 4026531836 || evt.CgroupID != 1234 {
+		t.Errorf("unexpected namespaces: %+v", evt)
+	}
+	if evt := events[1]; evt.EventType != model.Rename || evt.SrcFilename != "/data/dir/file" ||
+		evt.TargetFilename != "/data/dir/renamed" {
+		t.Errorf("unexpected rename event: %+v", evt)
+	}
+	if evt := events[2]; evt.EventType != model.Symlink || evt.SrcFilename != "/data/dir/link" ||
+		evt.TargetFilename != "../etc/passwd" {
+		t.Errorf("unexpected symlink event: %+v", evt)
+	}
+	if lost := stream.Lost(); lost != 5 {
+		t.Errorf("expected 5 lost events, got %d", lost)
+	}
+	if state := fsp.State(); state != StateStopped {
+		t.Errorf("expected FSProbe to be stopped, got %s", state)
+	}
+}
+
+func TestReplayTruncatedSamples(t *testing.T) {
+	var buf bytes.Buffer
+	recorder, err := model.NewCaptureWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder.RecordHeader(&model.CaptureHeader{
+		DentryResolutionMode: model.DentryResolutionPerfBuffer,
+	})
+	open := testSample{
+		pid:        42,
+		srcInode:   200,
+		srcMountID: 27,
+		eventType:  0,
+		srcPath:    []byte("file\x00dir\x00"),
+	}.encode()
+	rename := testSample{
+		pid:           43,
+		srcInode:      200,
+		srcMountID:    27,
+		targetInode:   300,
+		targetMountID: 27,
+		eventType:     3,
+		srcPath:       []byte("file\x00"),
+		targetPath:    []byte("renamed\x00"),
+	}.encode()
+	// The samples are cut in the middle of their src and target paths, and before their header ends
+	recorder.RecordSample("fs_events", open[:len(open)-3])
+	recorder.RecordSample("fs_events", rename[:len(rename)-3])
+	recorder.RecordSample("fs_events", rename[:64])
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := model.NewCaptureReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsp := NewFSProbeWithOptions(model.FSProbeOptions{})
+	stream := fsp.Subscribe()
+	if err := fsp.Replay(context.Background(), reader); err != nil {
+		t.Fatal(err)
+	}
+	if evt, err := stream.Next(context.Background()); err == nil {
+		t.Errorf("unexpected event: %+v", evt)
+	}
+	if failures := fsp.Stats().ParseFailures; failures != 3 {
+		t.Errorf("expected 3 parse failures, got %d", failures)
+	}
+}
@@ -0,0 +1,246 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"encoding/gob"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// defaultSpillMaxSize - Default maximum size of an on-disk queue
+const defaultSpillMaxSize = 64 << 20
+
+// spillQueue - Bounded on-disk FIFO queue of events. The events are written to disk while the output channel is full,
+// and a goroutine moves them back to the output channel in order.
+type spillQueue struct {
+	sync.Mutex
+	dir     string
+	maxSize int64
+	out     chan *model.FSEvent
+	// writer and reader are two handles on an unlinked file, so that nothing is left behind on a crash
+	writer  *os.File
+	reader  *os.File
+	encoder *gob.Encoder
+	decoder *gob.Decoder
+	size    int64
+	// pending - Number of events on disk, including head
+	pending int
+	// head - Event read from disk and being sent to the output channel
+	head    *model.FSEvent
+	wakeup  chan struct{}
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+// newSpillQueue - Returns a new spill queue for the provided output channel. The file of the queue is only created
+// when the first event is spilled.
+func newSpillQueue(dir string, maxSize int64, out chan *model.FSEvent) *spillQueue {
+	if len(dir) == 0 {
+		dir = os.TempDir()
+	}
+	if maxSize <= 0 {
+		maxSize = defaultSpillMaxSize
+	}
+	sq := &spillQueue{
+		dir:     dir,
+		maxSize: maxSize,
+		out:     out,
+		wakeup:  make(chan struct{}, 1),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	go sq.drain()
+	return sq
+}
+
+// push - Sends an event to the output channel, or spills it to disk if the channel is full or if older events are
+// still on disk. Returns false if the event was dropped.
+func (sq *spillQueue) push(evt *model.FSEvent) bool {
+	sq.Lock()
+	defer sq.Unlock()
+	if sq.pending == 0 {
+		select {
+		case sq.out <- evt:
+			return true
+		default:
+		}
+	}
+	if sq.size >= sq.maxSize {
+		return false
+	}
+	if err := sq.open(); err != nil {
+		logrus.Warnf("couldn't create spill queue: %v", err)
+		return false
+	}
+	if err := sq.encoder.Encode(evt); err != nil {
+		logrus.Warnf("couldn't spill event to disk: %v", err)
+		return false
+	}
+	sq.pending++
+	select {
+	case sq.wakeup <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// open - Creates the file of the queue if needed. The caller must hold the lock.
+func (sq *spillQueue) open() error {
+	if sq.writer != nil {
+		return nil
+	}
+	writer, err := ioutil.TempFile(sq.dir, "fsprobe-spill-")
+	if err != nil {
+		return err
+	}
+	reader, err := os.Open(writer.Name())
+	if err != nil {
+		writer.Close()
+		os.Remove(writer.Name())
+		return err
+	}
+	if err := os.Remove(writer.Name()); err != nil {
+		logrus.Debugf("couldn't unlink spill queue %s: %v", writer.Name(), err)
+	}
+	sq.writer = writer
+	sq.reader = reader
+	sq.reset()
+	return nil
+}
+
+// reset - Truncates the file of the queue. The caller must hold the lock.
+func (sq *spillQueue) reset() {
+	sq.pending = 0
+	sq.head = nil
+	sq.size = 0
+	if sq.writer == nil {
+		return
+	}
+	if err := sq.writer.Truncate(0); err != nil {
+		logrus.Debugf("couldn't truncate spill queue: %v", err)
+	}
+	_, _ = sq.writer.Seek(0, io.SeekStart)
+	_, _ = sq.reader.Seek(0, io.SeekStart)
+	sq.encoder = gob.NewEncoder(&countingWriter{w: sq.writer, n: &sq.size})
+	sq.decoder = gob.NewDecoder(sq.reader)
+}
+
+// next - Returns the oldest event on disk. The caller must hold the lock.
+func (sq *spillQueue) next() (*model.FSEvent, error) {
+	if sq.head != nil {
+		return sq.head, nil
+	}
+	evt := &model.FSEvent{}
+	if err := sq.decoder.Decode(evt); err != nil {
+		return nil, errors.Wrap(err, "couldn't read spilled event")
+	}
+	sq.head = evt
+	return evt, nil
+}
+
+// pop - Removes the oldest event from the queue. The caller must hold the lock.
+func (sq *spillQueue) pop() {
+	sq.head = nil
+	sq.pending--
+	if sq.pending == 0 {
+		// Reclaim disk space as soon as the queue is empty
+		sq.reset()
+	}
+}
+
+// drain - Moves the spilled events back to the output channel
+func (sq *spillQueue) drain() {
+	defer close(sq.stopped)
+	for {
+		sq.Lock()
+		if sq.pending == 0 {
+			sq.Unlock()
+			select {
+			case <-sq.wakeup:
+				continue
+			case <-sq.done:
+				return
+			}
+		}
+		evt, err := sq.next()
+		if err != nil {
+			logrus.Warnf("dropping %d spilled events: %v", sq.pending, err)
+			sq.reset()
+			sq.Unlock()
+			continue
+		}
+		sq.Unlock()
+
+		select {
+		case sq.out <- evt:
+			sq.Lock()
+			sq.pop()
+			sq.Unlock()
+		case <-sq.done:
+			return
+		}
+	}
+}
+
+// close - Stops the queue and sends the spilled events to the output channel without blocking. Returns the number of
+// events that didn't fit in the output channel.
+func (sq *spillQueue) close() uint64 {
+	close(sq.done)
+	<-sq.stopped
+	sq.Lock()
+	defer sq.Unlock()
+	var dropped uint64
+	for sq.pending > 0 {
+		evt, err := sq.next()
+		if err != nil {
+			dropped += uint64(sq.pending)
+			break
+		}
+		select {
+		case sq.out <- evt:
+		default:
+			dropped++
+		}
+		sq.pop()
+	}
+	if sq.writer != nil {
+		sq.writer.Close()
+		sq.reader.Close()
+		sq.writer = nil
+		sq.reader = nil
+	}
+	return dropped
+}
+
+// countingWriter - Writer that counts the number of bytes written
+type countingWriter struct {
+	w io.Writer
+	n *int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	*cw.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,46 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"sync/atomic"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// Stats - Returns the self-monitoring statistics of FSProbe. The counters are cumulative since FSProbe was created,
+// and the channel fill levels are sampled when Stats is called. This function is thread safe.
+func (fsp *FSProbe) Stats() model.Stats {
+	stats := fsp.stats.Snapshot()
+	stats.UserSpaceDrops = atomic.LoadUint64(&fsp.userSpaceDrops)
+	if fsp.options.EventChan != nil {
+		stats.Channels = append(stats.Channels, model.ChannelStats{
+			Name:    "event_chan",
+			Len:     len(fsp.options.EventChan),
+			Cap:     cap(fsp.options.EventChan),
+			Dropped: stats.UserSpaceDrops,
+		})
+	}
+	if fsp.options.LostChan != nil {
+		stats.Channels = append(stats.Channels, model.ChannelStats{
+			Name: "lost_chan",
+			Len:  len(fsp.options.LostChan),
+			Cap:  cap(fsp.options.LostChan),
+		})
+	}
+	stats.Channels = append(stats.Channels, fsp.dispatcher.channelStats()...)
+	return stats
+}
@@ -0,0 +1,53 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"testing"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+func TestStats(t *testing.T) {
+	fsp, _ := newTestProbe(t, &fakeMonitor{}, nil)
+	fsp.SubscribeWithOptions(SubscribeOptions{BufferSize: 1, Backpressure: model.BackpressureDropNewest})
+
+	fsp.DispatchEvent(&model.FSEvent{EventType: model.Open, SrcFilename: "/etc/passwd"})
+	fsp.DispatchEvent(&model.FSEvent{EventType: model.Open, SrcFilename: model.ResolutionErrorPrefix + "/passwd"})
+	fsp.DispatchEvent(&model.FSEvent{EventType: model.Rename, SrcFilename: "/a", TargetFilename: "/b"})
+	fsp.DispatchLost(&model.LostEvt{Count: 3, Map: "fs_events", Reason: model.LostReasonPerfBuffer})
+	fsp.GetStatsCollector().CountParseFailure()
+
+	stats := fsp.Stats()
+	if stats.Events[model.Open] != 2 || stats.Events[model.Rename] != 1 || stats.Events[model.Mkdir] != 0 {
+		t.Errorf("unexpected event counters: %v", stats.Events)
+	}
+	if stats.ResolutionFailures != 1 {
+		t.Errorf("%d resolution failures, expected 1", stats.ResolutionFailures)
+	}
+	if stats.ParseFailures != 1 {
+		t.Errorf("%d parse failures, expected 1", stats.ParseFailures)
+	}
+	if stats.LostSamples["fs_events"] != 3 {
+		t.Errorf("%d samples lost, expected 3", stats.LostSamples["fs_events"])
+	}
+	if len(stats.Channels) != 1 || stats.Channels[0].Len != 1 || stats.Channels[0].Cap != 1 || stats.Channels[0].Dropped != 2 {
+		t.Errorf("unexpected channel stats: %+v", stats.Channels)
+	}
+	if stats.String() == "" {
+		t.Error("empty summary")
+	}
+}
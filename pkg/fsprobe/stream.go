@@ -0,0 +1,211 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Gui774ume/fsprobe/pkg/filter"
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// ErrStreamClosed - Returned by Stream.Next once the stream is closed and all its pending events were read
+var ErrStreamClosed = errors.New("stream closed")
+
+// defaultStreamSize - Size of the buffer of a stream when neither BufferSize nor UserSpaceChanSize are set
+const defaultStreamSize = 1000
+
+// Stream - Stream of the events captured by FSProbe, returned to a subscriber
+type Stream struct {
+	fsp       *FSProbe
+	queue     *eventQueue
+	closeOnce sync.Once
+	mask      model.EventMask
+	prefixes  []string
+	filter    *filter.Filter
+	lost      uint64
+}
+
+// Next - Returns the next event of the stream. Next blocks until an event is available, the context is cancelled or
+// the stream is closed, in which case ErrStreamClosed is returned once the pending events were read.
+func (s *Stream) Next(ctx context.Context) (*model.FSEvent, error) {
+	select {
+	case evt, ok := <-s.queue.events:
+		if !ok {
+			return nil, ErrStreamClosed
+		}
+		return evt, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Lost - Returns the number of events lost by the perf ring buffers since the stream was created
+func (s *Stream) Lost() uint64 {
+	return atomic.LoadUint64(&s.lost)
+}
+
+// Dropped - Returns the number of events dropped because the buffer of the stream was full, either because of the
+// backpressure policy of the stream or because the stream was closing
+func (s *Stream) Dropped() uint64 {
+	return atomic.LoadUint64(&s.queue.dropped)
+}
+
+// Close - Unsubscribes the stream. Next returns ErrStreamClosed once the pending events were read.
+func (s *Stream) Close() error {
+	s.fsp.dispatcher.remove(s)
+	return nil
+}
+
+// match - Returns true if the event should be sent to the stream
+func (s *Stream) match(evt *model.FSEvent) bool {
+	if s.mask != 0 && !s.mask.Contains(evt.EventType) {
+		return false
+	}
+	if len(s.prefixes) > 0 && !s.matchPrefix(evt.SrcFilename) && !s.matchPrefix(evt.TargetFilename) {
+		return false
+	}
+	if s.filter != nil && !s.filter.Match(evt) {
+		return false
+	}
+	return true
+}
+
+// matchPrefix - Returns true if the provided path is under one of the path prefixes of the stream
+func (s *Stream) matchPrefix(p string) bool {
+	if len(p) == 0 {
+		return false
+	}
+	for _, prefix := range s.prefixes {
+		if prefix == "/" || p == prefix || strings.HasPrefix(p, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// send - Sends an event to the stream according to its backpressure policy
+func (s *Stream) send(evt *model.FSEvent) {
+	s.queue.send(evt)
+}
+
+// stop - Stops waiting for the stream to read its events, the stream can still buffer events
+func (s *Stream) stop() {
+	s.queue.stop()
+}
+
+// close - Closes the events channel of the stream. The caller must make sure that no event is being sent.
+func (s *Stream) close() {
+	s.closeOnce.Do(func() {
+		s.queue.shutdown()
+		close(s.queue.events)
+	})
+}
+
+// Subscribe - Returns a new stream of all the events captured by FSProbe, see SubscribeWithOptions
+func (fsp *FSProbe) Subscribe() *Stream {
+	return fsp.SubscribeWithOptions(SubscribeOptions{})
+}
+
+// SubscribeWithOptions - Returns a new stream of the events captured by FSProbe that match the provided options.
+// Each subscriber has its own buffer and backpressure policy, so that a slow subscriber doesn't delay the others
+// unless it uses the BackpressureBlock policy. Streams can be created before FSProbe is started, and are closed when
+// FSProbe stops.
+func (fsp *FSProbe) SubscribeWithOptions(options SubscribeOptions) *Stream {
+	size := options.BufferSize
+	if size <= 0 {
+		size = fsp.options.UserSpaceChanSize
+	}
+	if size <= 0 {
+		size = defaultStreamSize
+	}
+	s := &Stream{
+		fsp:    fsp,
+		queue:  newEventQueue(make(chan *model.FSEvent, size), options.Backpressure, fsp.options),
+		mask:   model.NewEventMask(options.Events...),
+		filter: options.Filter,
+	}
+	for _, prefix := range options.PathPrefixes {
+		s.prefixes = append(s.prefixes, path.Clean(prefix))
+	}
+	fsp.dispatcher.add(s)
+	return s
+}
+
+// DispatchEvent - Sends an event to the event channel of the options and to the subscribers
+func (fsp *FSProbe) DispatchEvent(evt *model.FSEvent) {
+	fsp.stats.CountEvent(evt)
+	if fsp.eventQueue != nil {
+		if dropped := fsp.eventQueue.send(evt); dropped > 0 {
+			fsp.reportUserSpaceDrops(dropped)
+		}
+	}
+	fsp.dispatcher.dispatchEvent(evt)
+}
+
+// DispatchLost - Sends a lost event notification to the lost channel of the options and to the subscribers
+func (fsp *FSProbe) DispatchLost(lost *model.LostEvt) {
+	atomic.AddUint64(&fsp.perfBufferLost, lost.Count)
+	fsp.stats.CountLostSamples(lost.Map, lost.Count)
+	if fsp.options.LostChan != nil {
+		fsp.options.LostChan <- lost
+	}
+	fsp.dispatcher.dispatchLost(lost)
+}
+
+// reportUserSpaceDrops - Counts the events dropped by the backpressure policy of the event channel and reports them
+// on the lost channel. The notification is delayed if the lost channel is full, so that the perf map listener is
+// never blocked by a drop.
+func (fsp *FSProbe) reportUserSpaceDrops(count uint64) {
+	atomic.AddUint64(&fsp.userSpaceDrops, count)
+	if fsp.options.LostChan == nil {
+		return
+	}
+	count = atomic.SwapUint64(&fsp.unreportedDrops, 0) + count
+	select {
+	case fsp.options.LostChan <- &model.LostEvt{Count: count, Reason: model.LostReasonUserSpace}:
+	default:
+		atomic.AddUint64(&fsp.unreportedDrops, count)
+	}
+}
+
+// LostCount - Returns the number of events lost for the provided reason since FSProbe was created. Events dropped by
+// the subscribers are counted by their streams.
+func (fsp *FSProbe) LostCount(reason model.LostReason) uint64 {
+	switch reason {
+	case model.LostReasonPerfBuffer:
+		return atomic.LoadUint64(&fsp.perfBufferLost)
+	case model.LostReasonUserSpace:
+		return atomic.LoadUint64(&fsp.userSpaceDrops)
+	default:
+		return 0
+	}
+}
+
+// Run - Starts FSProbe, watches the provided paths and blocks until the context is cancelled. On cancellation, the
+// probes are detached, the pending events are flushed to the streams and the streams are closed.
+func (fsp *FSProbe) Run(ctx context.Context, paths ...string) error {
+	if err := fsp.Watch(paths...); err != nil {
+		return err
+	}
+	<-ctx.Done()
+	return fsp.Stop()
+}
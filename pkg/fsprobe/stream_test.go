@@ -0,0 +1,179 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fsprobe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+func TestRunClosesStreams(t *testing.T) {
+	fm := &fakeMonitor{}
+	fsp, _ := newTestProbe(t, fm, nil)
+	root := newTestTree(t)
+	stream := fsp.Subscribe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- fsp.Run(ctx, root)
+	}()
+	for fsp.State() != StateRunning {
+		time.Sleep(time.Millisecond)
+	}
+
+	// Events are delivered to the stream
+	fsp.DispatchEvent(&model.FSEvent{EventType: model.Open})
+	fsp.DispatchLost(&model.LostEvt{Count: 2})
+	evt, err := stream.Next(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if evt.EventType != model.Open {
+		t.Errorf("got a %s event, expected %s", evt.EventType, model.Open)
+	}
+	if stream.Lost() != 2 {
+		t.Errorf("%d events lost, expected 2", stream.Lost())
+	}
+
+	// A pending event is still readable after the cancellation, then the stream is closed
+	fsp.DispatchEvent(&model.FSEvent{EventType: model.Mkdir})
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if fsp.State() != StateStopped {
+		t.Fatalf("state is %s, expected %s", fsp.State(), StateStopped)
+	}
+	if evt, err = stream.Next(context.Background()); err != nil || evt.EventType != model.Mkdir {
+		t.Fatalf("expected the pending mkdir event, got %v, %v", evt, err)
+	}
+	if _, err = stream.Next(context.Background()); err != ErrStreamClosed {
+		t.Fatalf("expected %v, got %v", ErrStreamClosed, err)
+	}
+}
+
+func TestStreamClose(t *testing.T) {
+	fsp, _ := newTestProbe(t, &fakeMonitor{}, nil)
+	stream := fsp.Subscribe()
+	other := fsp.Subscribe()
+	if err := stream.Close(); err != nil {
+		t.Fatal(err)
+	}
+	// Closed streams don't block the dispatch of events
+	fsp.DispatchEvent(&model.FSEvent{EventType: model.Open})
+	if _, err := stream.Next(context.Background()); err != ErrStreamClosed {
+		t.Fatalf("expected %v, got %v", ErrStreamClosed, err)
+	}
+	if _, err := other.Next(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// The context of Next is honored
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := other.Next(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestSubscriberFilters(t *testing.T) {
+	fsp, _ := newTestProbe(t, &fakeMonitor{}, nil)
+	all := fsp.Subscribe()
+	opens := fsp.SubscribeWithOptions(SubscribeOptions{Events: []model.EventName{model.Open}})
+	etc := fsp.SubscribeWithOptions(SubscribeOptions{PathPrefixes: []string{"/etc/"}})
+
+	fsp.DispatchEvent(&model.FSEvent{EventType: model.Open, SrcFilename: "/etc/passwd"})
+	fsp.DispatchEvent(&model.FSEvent{EventType: model.Mkdir, SrcFilename: "/etcd"})
+	fsp.DispatchEvent(&model.FSEvent{EventType: model.Rename, SrcFilename: "/tmp/a", TargetFilename: "/etc/b"})
+
+	for _, tc := range []struct {
+		name   string
+		stream *Stream
+		count  int
+	}{
+		{"all", all, 3},
+		{"opens", opens, 1},
+		{"etc", etc, 2},
+	} {
+		if got := len(tc.stream.queue.events); got != tc.count {
+			t.Errorf("subscriber %s received %d events, expected %d", tc.name, got, tc.count)
+		}
+	}
+}
+
+func TestBackpressurePolicies(t *testing.T) {
+	fsp, _ := newTestProbe(t, &fakeMonitor{}, nil)
+	newest := fsp.SubscribeWithOptions(SubscribeOptions{BufferSize: 2, Backpressure: model.BackpressureDropNewest})
+	oldest := fsp.SubscribeWithOptions(SubscribeOptions{BufferSize: 2, Backpressure: model.BackpressureDropOldest})
+
+	// Neither subscriber reads its events, the dispatch must not block
+	for i := int32(0); i < 5; i++ {
+		fsp.DispatchEvent(&model.FSEvent{Retval: i})
+	}
+
+	for _, tc := range []struct {
+		policy  model.BackpressurePolicy
+		stream  *Stream
+		retvals []int32
+	}{
+		{model.BackpressureDropNewest, newest, []int32{0, 1}},
+		{model.BackpressureDropOldest, oldest, []int32{3, 4}},
+	} {
+		if tc.stream.Dropped() != 3 {
+			t.Errorf("%s: %d events dropped, expected 3", tc.policy, tc.stream.Dropped())
+		}
+		for _, retval := range tc.retvals {
+			evt, err := tc.stream.Next(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if evt.Retval != retval {
+				t.Errorf("%s: got event %d, expected %d", tc.policy, evt.Retval, retval)
+			}
+		}
+	}
+}
+
+func TestSpillPolicy(t *testing.T) {
+	fsp, _ := newTestProbe(t, &fakeMonitor{}, nil)
+	fsp.options.SpillDir = t.TempDir()
+	stream := fsp.SubscribeWithOptions(SubscribeOptions{BufferSize: 2, Backpressure: model.BackpressureSpill})
+
+	// The events that don't fit in the buffer are spilled to disk and delivered in order
+	for i := int32(0); i < 10; i++ {
+		fsp.DispatchEvent(&model.FSEvent{Retval: i, SrcFilename: "/tmp/a"})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for i := int32(0); i < 10; i++ {
+		evt, err := stream.Next(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if evt.Retval != i || evt.SrcFilename != "/tmp/a" {
+			t.Fatalf("got event %d (%s), expected %d", evt.Retval, evt.SrcFilename, i)
+		}
+	}
+	if stream.Dropped() != 0 {
+		t.Errorf("%d events dropped, expected 0", stream.Dropped())
+	}
+	if err := stream.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
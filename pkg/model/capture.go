@@ -0,0 +1,373 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// CaptureMagic - Magic bytes at the beginning of a capture file
+	CaptureMagic = "FSPROBE\x00"
+	// CaptureVersion - Version of the capture file format, bumped when the layout of the kernel samples changes
+	CaptureVersion uint32 = 3
+	// maxCaptureRecordSize - Maximum size of a capture record, used to detect corrupted captures
+	maxCaptureRecordSize = 64 << 20
+)
+
+// CaptureRecordType - Type of a capture record
+type CaptureRecordType uint8
+
+const (
+	// CaptureRecordHeader - Metadata recorded each time FSProbe starts, see CaptureHeader
+	CaptureRecordHeader CaptureRecordType = iota + 1
+	// CaptureRecordSample - Raw perf sample received from the kernel
+	CaptureRecordSample
+	// CaptureRecordLost - Number of samples lost in a perf ring buffer
+	CaptureRecordLost
+	// CaptureRecordCacheEntry - Path of a watched inode added to the dentry resolver cache
+	CaptureRecordCacheEntry
+	// CaptureRecordCacheRemoval - Watched inode removed from the dentry resolver cache
+	CaptureRecordCacheRemoval
+)
+
+// CaptureHeader - Metadata recorded each time FSProbe starts. It holds what is needed to decode the samples that
+// follow it: the boot time, the options that change the content of the samples and the mount points.
+type CaptureHeader struct {
+	Hostname             string               `json:"hostname"`
+	StartTime            time.Time            `json:"start_time"`
+	BootTime             time.Time            `json:"boot_time"`
+	DentryResolutionMode DentryResolutionMode `json:"dentry_resolution_mode"`
+	Recursive            bool                 `json:"recursive"`
+	PathsFiltering       bool                 `json:"paths_filtering"`
+	FollowRenames        bool                 `json:"follow_renames"`
+	Events               []EventName          `json:"events,omitempty"`
+	ExcludePaths         []string             `json:"exclude_paths,omitempty"`
+	ExcludePatterns      []string             `json:"exclude_patterns,omitempty"`
+	Mounts               []*MountInfo         `json:"mounts,omitempty"`
+}
+
+// NewCaptureHeader - Returns the capture header of the provided options and boot time. The mount points of the current
+// mount namespace are included so that the paths are resolved the same way during a replay.
+func NewCaptureHeader(options *FSProbeOptions, bootTime time.Time) (*CaptureHeader, error) {
+	mounts, err := ReadMounts()
+	if err != nil {
+		return nil, err
+	}
+	return &CaptureHeader{
+		Hostname:             Hostname(),
+		StartTime:            time.Now(),
+		BootTime:             bootTime,
+		DentryResolutionMode: options.DentryResolutionMode,
+		Recursive:            options.Recursive,
+		PathsFiltering:       options.PathsFiltering,
+		FollowRenames:        options.FollowRenames,
+		Events:               options.Events,
+		ExcludePaths:         options.ExcludePaths,
+		ExcludePatterns:      options.ExcludePatterns,
+		Mounts:               mounts,
+	}, nil
+}
+
+// Apply - Overrides the provided options with the options of the capture
+func (ch *CaptureHeader) Apply(options *FSProbeOptions) {
+	options.DentryResolutionMode = ch.DentryResolutionMode
+	options.Recursive = ch.Recursive
+	options.PathsFiltering = ch.PathsFiltering
+	options.FollowRenames = ch.FollowRenames
+	options.Events = ch.Events
+	options.ExcludePaths = ch.ExcludePaths
+	options.ExcludePatterns = ch.ExcludePatterns
+}
+
+// CaptureRecord - Record of a capture. The fields that are set depend on the type of the record.
+type CaptureRecord struct {
+	Type CaptureRecordType
+	// Header - Set for CaptureRecordHeader
+	Header *CaptureHeader
+	// Map - Name of the perf map, set for CaptureRecordSample and CaptureRecordLost
+	Map string
+	// Data - Raw sample, set for CaptureRecordSample
+	Data []byte
+	// Count - Number of lost samples, set for CaptureRecordLost
+	Count uint64
+	// MountID, Inode and Path - Cache entry, set for CaptureRecordCacheEntry and CaptureRecordCacheRemoval (without
+	// the path)
+	MountID uint32
+	Inode   uint64
+	Path    string
+}
+
+// Recorder - Records the data received from the kernel, along with what is needed to decode it without eBPF
+type Recorder interface {
+	RecordHeader(header *CaptureHeader)
+	RecordSample(mapName string, data []byte)
+	RecordLost(mapName string, count uint64)
+	RecordCacheEntry(mountID uint32, inode uint64, path string)
+	RecordCacheRemoval(mountID uint32, inode uint64)
+}
+
+// CaptureWriter - Recorder that writes a capture file. A capture starts with CaptureMagic and CaptureVersion, followed
+// by records made of a type (1 byte), a length (4 bytes) and a payload. Integers are little endian, the samples are
+// stored as they were received from the kernel.
+type CaptureWriter struct {
+	lock   sync.Mutex
+	writer *bufio.Writer
+	closer io.Closer
+	buf    []byte
+	err    error
+}
+
+// NewCaptureWriter - Writes the beginning of a capture to the provided writer and returns a CaptureWriter. The writer
+// is closed by Close if it implements io.Closer.
+func NewCaptureWriter(w io.Writer) (*CaptureWriter, error) {
+	cw := &CaptureWriter{
+		writer: bufio.NewWriterSize(w, 1<<16),
+	}
+	if closer, ok := w.(io.Closer); ok {
+		cw.closer = closer
+	}
+	preamble := make([]byte, len(CaptureMagic)+4)
+	copy(preamble, CaptureMagic)
+	binary.LittleEndian.PutUint32(preamble[len(CaptureMagic):], CaptureVersion)
+	if _, err := cw.writer.Write(preamble); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+// RecordHeader - Records the metadata of a new FSProbe start
+func (cw *CaptureWriter) RecordHeader(header *CaptureHeader) {
+	data, err := json.Marshal(header)
+	cw.lock.Lock()
+	defer cw.lock.Unlock()
+	if err != nil {
+		cw.setErr(errors.Wrap(err, "couldn't encode capture header"))
+		return
+	}
+	cw.writeRecord(CaptureRecordHeader, data)
+}
+
+// RecordSample - Records a raw perf sample
+func (cw *CaptureWriter) RecordSample(mapName string, data []byte) {
+	cw.lock.Lock()
+	defer cw.lock.Unlock()
+	cw.buf = appendMapName(cw.buf[:0], mapName)
+	cw.buf = append(cw.buf, data...)
+	cw.writeRecord(CaptureRecordSample, cw.buf)
+}
+
+// RecordLost - Records a number of lost samples
+func (cw *CaptureWriter) RecordLost(mapName string, count uint64) {
+	cw.lock.Lock()
+	defer cw.lock.Unlock()
+	cw.buf = appendMapName(cw.buf[:0], mapName)
+	cw.buf = appendUint64(cw.buf, count)
+	cw.writeRecord(CaptureRecordLost, cw.buf)
+}
+
+// RecordCacheEntry - Records the path of a watched inode
+func (cw *CaptureWriter) RecordCacheEntry(mountID uint32, inode uint64, path string) {
+	cw.lock.Lock()
+	defer cw.lock.Unlock()
+	cw.buf = appendUint32(cw.buf[:0], mountID)
+	cw.buf = appendUint64(cw.buf, inode)
+	cw.buf = append(cw.buf, path...)
+	cw.writeRecord(CaptureRecordCacheEntry, cw.buf)
+}
+
+// RecordCacheRemoval - Records the removal of a watched inode
+func (cw *CaptureWriter) RecordCacheRemoval(mountID uint32, inode uint64) {
+	cw.lock.Lock()
+	defer cw.lock.Unlock()
+	cw.buf = appendUint32(cw.buf[:0], mountID)
+	cw.buf = appendUint64(cw.buf, inode)
+	cw.writeRecord(CaptureRecordCacheRemoval, cw.buf)
+}
+
+// Err - Returns the first error that occurred while writing the capture. The records that follow an error are
+// dropped.
+func (cw *CaptureWriter) Err() error {
+	cw.lock.Lock()
+	defer cw.lock.Unlock()
+	return cw.err
+}
+
+// Close - Flushes the capture and closes the underlying writer. The first error that occurred while writing the
+// capture is returned.
+func (cw *CaptureWriter) Close() error {
+	cw.lock.Lock()
+	defer cw.lock.Unlock()
+	if err := cw.writer.Flush(); err != nil {
+		cw.setErr(err)
+	}
+	if cw.closer != nil {
+		if err := cw.closer.Close(); err != nil {
+			cw.setErr(err)
+		}
+		cw.closer = nil
+	}
+	return cw.err
+}
+
+// writeRecord - Writes a record. The caller must hold the lock.
+func (cw *CaptureWriter) writeRecord(recordType CaptureRecordType, payload []byte) {
+	if cw.err != nil {
+		return
+	}
+	var prefix [5]byte
+	prefix[0] = byte(recordType)
+	binary.LittleEndian.PutUint32(prefix[1:], uint32(len(payload)))
+	if _, err := cw.writer.Write(prefix[:]); err != nil {
+		cw.setErr(err)
+		return
+	}
+	if _, err := cw.writer.Write(payload); err != nil {
+		cw.setErr(err)
+	}
+}
+
+// setErr - Saves the first error of the writer. The caller must hold the lock.
+func (cw *CaptureWriter) setErr(err error) {
+	if cw.err == nil {
+		cw.err = err
+	}
+}
+
+// CaptureReader - Reads the records of a capture file written by a CaptureWriter
+type CaptureReader struct {
+	reader *bufio.Reader
+}
+
+// NewCaptureReader - Checks the beginning of the capture and returns a CaptureReader
+func NewCaptureReader(r io.Reader) (*CaptureReader, error) {
+	cr := &CaptureReader{
+		reader: bufio.NewReaderSize(r, 1<<16),
+	}
+	preamble := make([]byte, len(CaptureMagic)+4)
+	if _, err := io.ReadFull(cr.reader, preamble); err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, errors.Wrap(err, "couldn't read capture preamble")
+	}
+	if string(preamble[:len(CaptureMagic)]) != CaptureMagic {
+		return nil, errors.New("not an fsprobe capture")
+	}
+	if version := binary.LittleEndian.Uint32(preamble[len(CaptureMagic):]); version != CaptureVersion {
+		return nil, errors.Errorf("unsupported capture version: %d", version)
+	}
+	return cr, nil
+}
+
+// Next - Returns the next record of the capture, or io.EOF at the end of the capture. Records of unknown types are
+// skipped.
+func (cr *CaptureReader) Next() (*CaptureRecord, error) {
+	for {
+		var prefix [5]byte
+		if _, err := io.ReadFull(cr.reader, prefix[:]); err != nil {
+			if err == io.ErrUnexpectedEOF {
+				return nil, errors.New("truncated capture record")
+			}
+			return nil, err
+		}
+		length := binary.LittleEndian.Uint32(prefix[1:])
+		if length > maxCaptureRecordSize {
+			return nil, errors.Errorf("capture record too large: %d bytes", length)
+		}
+		payload := make([]byte, length)
+		if _, err := io.ReadFull(cr.reader, payload); err != nil {
+			return nil, errors.New("truncated capture record")
+		}
+		record, err := decodeCaptureRecord(CaptureRecordType(prefix[0]), payload)
+		if err != nil {
+			return nil, err
+		}
+		if record != nil {
+			return record, nil
+		}
+	}
+}
+
+// decodeCaptureRecord - Decodes the payload of a record. A nil record is returned for unknown record types.
+func decodeCaptureRecord(recordType CaptureRecordType, payload []byte) (*CaptureRecord, error) {
+	record := &CaptureRecord{Type: recordType}
+	var err error
+	switch recordType {
+	case CaptureRecordHeader:
+		record.Header = &CaptureHeader{}
+		if err = json.Unmarshal(payload, record.Header); err != nil {
+			return nil, errors.Wrap(err, "invalid capture header")
+		}
+	case CaptureRecordSample:
+		if record.Map, payload, err = readMapName(payload); err != nil {
+			return nil, err
+		}
+		record.Data = payload
+	case CaptureRecordLost:
+		if record.Map, payload, err = readMapName(payload); err != nil {
+			return nil, err
+		}
+		if len(payload) != 8 {
+			return nil, errors.New("invalid lost record")
+		}
+		record.Count = binary.LittleEndian.Uint64(payload)
+	case CaptureRecordCacheEntry, CaptureRecordCacheRemoval:
+		if len(payload) < 12 {
+			return nil, errors.New("invalid cache record")
+		}
+		record.MountID = binary.LittleEndian.Uint32(payload[0:4])
+		record.Inode = binary.LittleEndian.Uint64(payload[4:12])
+		record.Path = string(payload[12:])
+	default:
+		return nil, nil
+	}
+	return record, nil
+}
+
+// appendMapName - Appends a perf map name prefixed with its length
+func appendMapName(buf []byte, name string) []byte {
+	if len(name) > 255 {
+		name = name[:255]
+	}
+	buf = append(buf, byte(len(name)))
+	return append(buf, name...)
+}
+
+// readMapName - Reads a perf map name written by appendMapName and returns the rest of the payload
+func readMapName(payload []byte) (string, []byte, error) {
+	if len(payload) == 0 || len(payload) < 1+int(payload[0]) {
+		return "", nil, errors.New("invalid map name")
+	}
+	end := 1 + int(payload[0])
+	return string(payload[1:end]), payload[end:], nil
+}
+
+func appendUint32(buf []byte, value uint32) []byte {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], value)
+	return append(buf, b[:]...)
+}
+
+func appendUint64(buf []byte, value uint64) []byte {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], value)
+	return append(buf, b[:]...)
+}
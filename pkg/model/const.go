@@ -26,6 +26,14 @@ var (
 	FollowModeConst = "follow_mode"
 	// RecursiveModeConst - In-kernel configuration constant
 	RecursiveModeConst = "recursive_mode"
+	// VfsmountMntIDOffsetConst - In-kernel offset of mount->mnt_id relative to mount->mnt
+	VfsmountMntIDOffsetConst = "vfsmount_mnt_id_offset"
+	// SbMountsMntIDOffsetConst - In-kernel offset of mount->mnt_id relative to super_block->s_mounts
+	SbMountsMntIDOffsetConst = "sb_mounts_mnt_id_offset"
+	// SbMountsMntMountpointOffsetConst - In-kernel offset of mount->mnt_mountpoint relative to super_block->s_mounts
+	SbMountsMntMountpointOffsetConst = "sb_mounts_mnt_mountpoint_offset"
+	// MntNsInumOffsetConst - In-kernel offset of mnt_namespace->ns.inum
+	MntNsInumOffsetConst = "mnt_ns_inum_offset"
 )
 
 // DentryResolutionMode - Mode of resolution of the kernel dentries
@@ -37,6 +45,47 @@ const (
 	DentryResolutionPerfBuffer     DentryResolutionMode = 2
 )
 
+// BackpressurePolicy - Behavior of a user space channel when it is full
+type BackpressurePolicy int
+
+const (
+	// BackpressureBlock - The dispatch of the events waits until the channel has room. A slow consumer delays the
+	// perf map listener, which leads to lost events in the perf ring buffers.
+	BackpressureBlock BackpressurePolicy = iota
+	// BackpressureDropNewest - The new events are dropped until the channel has room
+	BackpressureDropNewest
+	// BackpressureDropOldest - The oldest events of the channel are dropped to make room for the new events
+	BackpressureDropOldest
+	// BackpressureSpill - The events are spilled to a bounded on-disk queue until the channel has room. The new events
+	// are dropped when the queue is full.
+	BackpressureSpill
+)
+
+func (bp BackpressurePolicy) String() string {
+	switch bp {
+	case BackpressureBlock:
+		return "block"
+	case BackpressureDropNewest:
+		return "drop"
+	case BackpressureDropOldest:
+		return "drop_oldest"
+	case BackpressureSpill:
+		return "spill"
+	default:
+		return fmt.Sprintf("BackpressurePolicy(%d)", bp)
+	}
+}
+
+// ParseBackpressurePolicy - Returns the backpressure policy with the provided name
+func ParseBackpressurePolicy(name string) (BackpressurePolicy, error) {
+	for _, bp := range []BackpressurePolicy{BackpressureBlock, BackpressureDropNewest, BackpressureDropOldest, BackpressureSpill} {
+		if bp.String() == name {
+			return bp, nil
+		}
+	}
+	return BackpressureBlock, fmt.Errorf("unknown backpressure policy: %v", name)
+}
+
 // ErrValue - Return value
 type ErrValue int32
 
@@ -545,6 +594,27 @@ const (
 	AttrTouch SetAttrFlag = 1 << 17
 )
 
+// SetAttrFlagsByName - SetAttr flags indexed by their string representation
+var SetAttrFlagsByName = map[string]SetAttrFlag{
+	"AttrMode":     AttrMode,
+	"AttrUID":      AttrUID,
+	"AttrGID":      AttrGID,
+	"AttrSize":     AttrSize,
+	"AttrAtime":    AttrAtime,
+	"AttrMtime":    AttrMtime,
+	"AttrCtime":    AttrCtime,
+	"AttrAtimeSet": AttrAtimeSet,
+	"AttrMTimeSet": AttrMTimeSet,
+	"AttrForce":    AttrForce,
+	"AttrKillSUID": AttrKillSUID,
+	"AttrKillSGID": AttrKillSGID,
+	"AttrFile":     AttrFile,
+	"AttrKillPriv": AttrKillPriv,
+	"AttrOpen":     AttrOpen,
+	"AttrTimesSet": AttrTimesSet,
+	"AttrTouch":    AttrTouch,
+}
+
 // SetAttrFlagsToString - Returns the string list representation of SetAttr flags
 func SetAttrFlagsToString(input uint32) []string {
 	flag := SetAttrFlag(input)
@@ -627,6 +697,28 @@ const (
 	OCLOEXEC   OpenFlag = 524288 /* set close_on_exec */
 )
 
+// OpenFlagsByName - Open flags indexed by their string representation
+var OpenFlagsByName = map[string]OpenFlag{
+	"OACCMODE":   OACCMODE,
+	"ORDONLY":    ORDONLY,
+	"OWRONLY":    OWRONLY,
+	"ORDWR":      ORDWR,
+	"OCREAT":     OCREAT,
+	"OEXCL":      OEXCL,
+	"ONOCTTY":    ONOCTTY,
+	"OTRUNC":     OTRUNC,
+	"OAPPEND":    OAPPEND,
+	"ONONBLOCK":  ONONBLOCK,
+	"ODSYNC":     ODSYNC,
+	"FASYNC":     FASYNC,
+	"ODIRECT":    ODIRECT,
+	"OLARGEFILE": OLARGEFILE,
+	"ODIRECTORY": ODIRECTORY,
+	"ONOFOLLOW":  ONOFOLLOW,
+	"ONOATIME":   ONOATIME,
+	"OCLOEXEC":   OCLOEXEC,
+}
+
 // OpenFlagsToStrings - Returns the string list version of flags
 func OpenFlagsToStrings(input uint32) []string {
 	flags := OpenFlag(input)
@@ -0,0 +1,60 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"strings"
+)
+
+// containerIDLength - Length of the container IDs of docker, containerd, cri-o and podman
+const containerIDLength = 64
+
+// containerScopePrefixes - Prefixes of the systemd scopes created by the container runtimes for their containers
+var containerScopePrefixes = []string{"docker-", "libpod-", "cri-containerd-", "crio-", "containerd-"}
+
+// ParseContainerID - Returns the ID of the container of a cgroup path, or an empty string if the cgroup doesn't belong
+// to a container. Both the cgroupfs layout (/docker/<id>, /kubepods/besteffort/pod<uid>/<id>) and the systemd layout
+// (/system.slice/docker-<id>.scope, /machine.slice/libpod-<id>.scope, ...) are supported. The innermost container
+// wins when containers are nested.
+func ParseContainerID(cgroupPath string) string {
+	segments := strings.Split(cgroupPath, "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		segment := strings.TrimSuffix(segments[i], ".scope")
+		for _, prefix := range containerScopePrefixes {
+			if strings.HasPrefix(segment, prefix) {
+				segment = segment[len(prefix):]
+				break
+			}
+		}
+		if isContainerID(segment) {
+			return segment
+		}
+	}
+	return ""
+}
+
+// isContainerID - Returns true if the provided string is a 64 characters long hexadecimal ID
+func isContainerID(id string) bool {
+	if len(id) != containerIDLength {
+		return false
+	}
+	for _, c := range id {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"testing"
+)
+
+func TestParseContainerID(t *testing.T) {
+	id := "3f4c4a5b0d1e2f30415263748596a7b8c9dae0f1021324354657687980a1b2c3"
+	tests := map[string]string{
+		"/docker/" + id:                                    id,
+		"/system.slice/docker-" + id + ".scope":            id,
+		"/machine.slice/libpod-" + id + ".scope":           id,
+		"/machine.slice/libpod-" + id + ".scope/container": id,
+		"/machine.slice/libpod-conmon-" + id + ".scope":    "",
+		"/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod1234.slice/cri-containerd-" + id + ".scope": id,
+		"/kubepods.slice/kubepods-burstable.slice/crio-" + id + ".scope":                                              id,
+		"/kubepods/besteffort/pod1234/" + id:                                                                          id,
+		"/user.slice/user-1000.slice/session-2.scope":                                                                 "",
+		"/system.slice/containerd.service":                                                                            "",
+		"/":                                                                                                           "",
+		"/docker/" + id[:12]:                                                                                          "",
+	}
+	for path, expected := range tests {
+		if got := ParseContainerID(path); got != expected {
+			t.Errorf("ParseContainerID(%q) = %q, expected %q", path, got, expected)
+		}
+	}
+}
@@ -34,8 +34,10 @@ var (
 	Open EventName = "open"
 	// Mkdir - Mkdir event
 	Mkdir EventName = "mkdir"
-	// Link - Soft link event
+	// Link - Hard link event
 	Link EventName = "link"
+	// Symlink - Symbolic link event, the target filename is the link target
+	Symlink EventName = "symlink"
 	// Rename - Rename event
 	Rename EventName = "rename"
 	// SetAttr - Attribute update event
@@ -69,11 +71,69 @@ func GetEventType(evtType uint32) EventName {
 		return Modify
 	case 7:
 		return SetAttr
+	case 8:
+		return Symlink
 	default:
 		return Unknown
 	}
 }
 
+// ParseEventName - Returns the event type with the provided name
+func ParseEventName(name string) (EventName, error) {
+	for i := uint32(0); ; i++ {
+		evt := GetEventType(i)
+		if evt == Unknown {
+			return Unknown, fmt.Errorf("unknown event type: %v", name)
+		}
+		if string(evt) == name {
+			return evt, nil
+		}
+	}
+}
+
+// EventMask - Set of event types
+type EventMask uint64
+
+// NewEventMask - Returns the mask of the provided event types
+func NewEventMask(events ...EventName) EventMask {
+	var mask EventMask
+	for _, evt := range events {
+		mask |= evt.mask()
+	}
+	return mask
+}
+
+// Contains - Returns true if the provided event type is in the mask
+func (m EventMask) Contains(evt EventName) bool {
+	return m&evt.mask() != 0
+}
+
+// mask - Returns the bit of the event type in an EventMask
+func (e EventName) mask() EventMask {
+	switch e {
+	case Open:
+		return 1 << 0
+	case Mkdir:
+		return 1 << 1
+	case Link:
+		return 1 << 2
+	case Rename:
+		return 1 << 3
+	case Unlink:
+		return 1 << 4
+	case Rmdir:
+		return 1 << 5
+	case Modify:
+		return 1 << 6
+	case SetAttr:
+		return 1 << 7
+	case Symlink:
+		return 1 << 8
+	default:
+		return 1 << 63
+	}
+}
+
 // ParseFSEvent - Parses a new FSEvent using the data provided by the kernel
 func ParseFSEvent(data []byte, monitor *Monitor) (*FSEvent, error) {
 	evt := &FSEvent{}
@@ -85,9 +145,38 @@ func ParseFSEvent(data []byte, monitor *Monitor) (*FSEvent, error) {
 	if err := resolvePaths(data, evt, monitor, read); err != nil {
 		return nil, err
 	}
+	if evt.EventType == Symlink {
+		if evt.TargetFilename, err = decodeSymlinkTarget(data, evt, monitor, read); err != nil {
+			return nil, err
+		}
+	}
+	// Add process context
+	if monitor.ProcessCache != nil {
+		monitor.ProcessCache.Resolve(evt)
+	}
+	// Add container and host paths
+	if monitor.MountNamespaceResolver != nil {
+		monitor.MountNamespaceResolver.Resolve(evt)
+	}
+	// Add mount point context
+	if monitor.MountResolver != nil {
+		if mount, ok := monitor.MountResolver.GetMount(evt.SrcMountID); ok {
+			evt.MountPoint = mount.MountPoint
+			evt.FSType = mount.FSType
+			evt.Device = mount.Device
+		}
+	}
 	return evt, nil
 }
 
+// resolveMountPoint - Prefixes a path relative to the root of its filesystem with its mount point
+func resolveMountPoint(monitor *Monitor, mountID uint32, path string) string {
+	if monitor.MountResolver == nil {
+		return path
+	}
+	return monitor.MountResolver.ResolvePath(mountID, path)
+}
+
 // resolvePaths - Resolves the paths of the event according to the configured method
 func resolvePaths(data []byte, evt *FSEvent, monitor *Monitor, read int) error {
 	var err error
@@ -95,18 +184,20 @@ func resolvePaths(data []byte, evt *FSEvent, monitor *Monitor, read int) error {
 	case DentryResolutionFragments:
 		inode := evt.SrcInode
 		if evt.SrcPathnameKey != 0 {
-			inode = uint64(evt.SrcPathnameKey)
+			inode = evt.SrcPathnameKey
 		}
 		evt.SrcFilename, err = monitor.DentryResolver.ResolveInode(evt.SrcMountID, inode)
 		if err != nil {
 			return errors.Wrap(err, "failed to resolve src dentry path")
 		}
+		evt.SrcFilename = resolveMountPoint(monitor, evt.SrcMountID, evt.SrcFilename)
 		switch evt.EventType {
 		case Link, Rename:
 			evt.TargetFilename, err = monitor.DentryResolver.ResolveInode(evt.TargetMountID, evt.TargetInode)
 			if err != nil {
 				return errors.Wrap(err, "failed to resolve target dentry path")
 			}
+			evt.TargetFilename = resolveMountPoint(monitor, evt.TargetMountID, evt.TargetFilename)
 		}
 		if evt.EventType == Link {
 			// Remove cache entry for link events
@@ -115,21 +206,23 @@ func resolvePaths(data []byte, evt *FSEvent, monitor *Monitor, read int) error {
 		}
 		break
 	case DentryResolutionSingleFragment:
-		evt.SrcFilename, err = monitor.DentryResolver.ResolveKey(evt.SrcPathnameKey, evt.SrcPathnameLength)
+		evt.SrcFilename, err = monitor.DentryResolver.ResolveKey(evt.SrcMountID, evt.SrcPathnameKey, evt.SrcPathnameLength)
 		if err != nil {
 			return errors.Wrap(err, "failed to resolve src dentry path")
 		}
+		evt.SrcFilename = resolveMountPoint(monitor, evt.SrcMountID, evt.SrcFilename)
 		switch evt.EventType {
 		case Link, Rename:
-			evt.TargetFilename, err = monitor.DentryResolver.ResolveKey(evt.TargetPathnameKey, evt.TargetPathnameLength)
+			evt.TargetFilename, err = monitor.DentryResolver.ResolveKey(evt.TargetMountID, evt.TargetPathnameKey, evt.TargetPathnameLength)
 			if err != nil {
 				return errors.Wrap(err, "failed to resolve target dentry path")
 			}
+			evt.TargetFilename = resolveMountPoint(monitor, evt.TargetMountID, evt.TargetFilename)
 		}
 		if evt.EventType == Link {
 			// Remove cache entry for link events
-			_ = monitor.DentryResolver.RemoveEntry(evt.SrcPathnameKey)
-			_ = monitor.DentryResolver.RemoveEntry(evt.TargetPathnameKey)
+			_ = monitor.DentryResolver.RemoveEntry(evt.SrcMountID, evt.SrcPathnameKey)
+			_ = monitor.DentryResolver.RemoveEntry(evt.TargetMountID, evt.TargetPathnameKey)
 		}
 		break
 	case DentryResolutionPerfBuffer:
@@ -137,19 +230,24 @@ func resolvePaths(data []byte, evt *FSEvent, monitor *Monitor, read int) error {
 		srcEnd := read
 		if evt.SrcPathnameLength > 0 {
 			srcEnd += int(evt.SrcPathnameLength)
+			if srcEnd > len(data) {
+				return errors.Errorf("not enough data for the src path: %d", len(data))
+			}
 			evt.SrcFilename = decodePath(data[read:srcEnd])
 		}
-		// Resolve end of path from cache when needed
+		// Resolve end of path from cache when needed. Cached paths already contain their mount point.
 		if evt.SrcPathnameKey > 0 {
-			prefix, err := monitor.DentryResolver.ResolveKey(evt.SrcPathnameKey, 0)
+			prefix, err := monitor.DentryResolver.ResolveKey(evt.SrcMountID, evt.SrcPathnameKey, 0)
 			if err != nil {
 				return errors.Wrap(err, "failed to resolve src dentry path")
 			}
 			evt.SrcFilename = prefix + evt.SrcFilename
+		} else {
+			evt.SrcFilename = resolveMountPoint(monitor, evt.SrcMountID, evt.SrcFilename)
 		}
 		// Cache resolved path when needed
 		if evt.SrcPathnameLength > 0 && evt.EventType != Link {
-			err = monitor.DentryResolver.AddCacheEntry(uint32(evt.SrcInode), evt.SrcFilename)
+			err = monitor.DentryResolver.AddCacheEntry(evt.SrcMountID, evt.SrcInode, evt.SrcFilename)
 			if err != nil {
 				return errors.Wrap(err, "failed to add src cached inode")
 			}
@@ -159,19 +257,24 @@ func resolvePaths(data []byte, evt *FSEvent, monitor *Monitor, read int) error {
 			// Decode path from perf buffer when needed
 			if evt.TargetPathnameLength > 0 {
 				targetEnd := srcEnd + int(evt.TargetPathnameLength)
+				if targetEnd > len(data) {
+					return errors.Errorf("not enough data for the target path: %d", len(data))
+				}
 				evt.TargetFilename = decodePath(data[srcEnd:targetEnd])
 			}
 			// Resolve end of path from cache when needed
 			if evt.TargetPathnameKey > 0 {
-				prefix, err := monitor.DentryResolver.ResolveKey(evt.TargetPathnameKey, 0)
+				prefix, err := monitor.DentryResolver.ResolveKey(evt.TargetMountID, evt.TargetPathnameKey, 0)
 				if err != nil {
 					return errors.Wrap(err, "failed to resolve target dentry path")
 				}
 				evt.TargetFilename = prefix + evt.TargetFilename
+			} else {
+				evt.TargetFilename = resolveMountPoint(monitor, evt.TargetMountID, evt.TargetFilename)
 			}
 			// Cache resolved path when needed
 			if evt.TargetPathnameLength > 0 && evt.EventType != Link {
-				err = monitor.DentryResolver.AddCacheEntry(uint32(evt.TargetInode), evt.TargetFilename)
+				err = monitor.DentryResolver.AddCacheEntry(evt.TargetMountID, evt.TargetInode, evt.TargetFilename)
 				if err != nil {
 					return errors.Wrap(err, "failed to add target cached inode")
 				}
@@ -182,6 +285,20 @@ func resolvePaths(data []byte, evt *FSEvent, monitor *Monitor, read int) error {
 	return nil
 }
 
+// decodeSymlinkTarget - Decodes the link target of a symlink event. The kernel sends it after the paths of the event,
+// which are only sent with the perf buffer resolution mode.
+func decodeSymlinkTarget(data []byte, evt *FSEvent, monitor *Monitor, read int) (string, error) {
+	start := read
+	if monitor.Options.DentryResolutionMode == DentryResolutionPerfBuffer {
+		start += int(evt.SrcPathnameLength)
+	}
+	end := start + int(evt.TargetPathnameLength)
+	if end > len(data) {
+		return "", errors.Errorf("not enough data for the link target: %d", len(data))
+	}
+	return string(bytes.TrimRight(data[start:end], "\x00")), nil
+}
+
 // decodePath - Decode the raw path provided by the kernel
 func decodePath(raw []byte) string {
 	fragments := []string{}
@@ -218,7 +335,7 @@ func decodePath(raw []byte) string {
 	return path[:len(path)-1]
 }
 
-// FSEvent - Raw event definition
+// FSEvent - Raw event definition. See MarshalJSON for its JSON representation.
 type FSEvent struct {
 	Timestamp            time.Time `json:"-"`
 	Pid                  uint32    `json:"pid"`
@@ -226,24 +343,43 @@ type FSEvent struct {
 	UID                  uint32    `json:"uid"`
 	GID                  uint32    `json:"gid"`
 	Comm                 string    `json:"comm"`
+	Ppid                 uint32    `json:"ppid"`
+	Exe                  string    `json:"exe,omitempty"`
+	Argv                 []string  `json:"argv,omitempty"`
+	Cwd                  string    `json:"cwd,omitempty"`
+	LoginUID             uint32    `json:"login_uid"`
+	CgroupID             uint64    `json:"cgroup_id,omitempty"`
+	CgroupPath           string    `json:"cgroup_path,omitempty"`
+	ContainerID          string    `json:"container_id,omitempty"`
+	MntNs                uint32    `json:"mnt_ns,omitempty"`
+	PidNs                uint32    `json:"pid_ns,omitempty"`
+	NsPid                uint32    `json:"ns_pid,omitempty"`
+	NsTid                uint32    `json:"ns_tid,omitempty"`
 	Flags                uint32    `json:"flags,omitempty"`
 	Mode                 uint32    `json:"mode,omitempty"`
 	SrcInode             uint64    `json:"src_inode,omitempty"`
 	SrcPathnameLength    uint32    `json:"-"`
-	SrcPathnameKey       uint32    `json:"-"`
+	SrcPathnameKey       uint64    `json:"-"`
 	SrcFilename          string    `json:"src_filename,omitempty"`
+	SrcContainerPath     string    `json:"src_container_path,omitempty"`
+	SrcHostPath          string    `json:"src_host_path,omitempty"`
 	SrcMountID           uint32    `json:"src_mount_id,omitempty"`
 	TargetInode          uint64    `json:"target_inode,omitempty"`
 	TargetPathnameLength uint32    `json:"-"`
-	TargetPathnameKey    uint32    `json:"-"`
+	TargetPathnameKey    uint64    `json:"-"`
 	TargetFilename       string    `json:"target_filename,omitempty"`
+	TargetContainerPath  string    `json:"target_container_path,omitempty"`
+	TargetHostPath       string    `json:"target_host_path,omitempty"`
 	TargetMountID        uint32    `json:"target_mount_id,omitempty"`
+	MountPoint           string    `json:"mount_point,omitempty"`
+	FSType               string    `json:"fs_type,omitempty"`
+	Device               string    `json:"device,omitempty"`
 	Retval               int32     `json:"retval"`
 	EventType            EventName `json:"event_type"`
 }
 
 func (e *FSEvent) UnmarshalBinary(data []byte, bootTime time.Time) (int, error) {
-	if len(data) < 96 {
+	if len(data) < 128 {
 		return 0, errors.Errorf("not enough data: %d", len(data))
 	}
 	// Process context data
@@ -253,20 +389,25 @@ func (e *FSEvent) UnmarshalBinary(data []byte, bootTime time.Time) (int, error)
 	e.UID = utils.ByteOrder.Uint32(data[16:20])
 	e.GID = utils.ByteOrder.Uint32(data[20:24])
 	e.Comm = string(bytes.Trim(data[24:40], "\x00"))
+	e.Ppid = utils.ByteOrder.Uint32(data[40:44])
+	e.MntNs = utils.ByteOrder.Uint32(data[44:48])
+	e.PidNs = utils.ByteOrder.Uint32(data[48:52])
+	e.CgroupID = utils.ByteOrder.Uint64(data[56:64])
 	// File system event data
-	e.Flags = utils.ByteOrder.Uint32(data[40:44])
-	e.Mode = utils.ByteOrder.Uint32(data[44:48])
-	e.SrcPathnameKey = utils.ByteOrder.Uint32(data[48:52])
-	e.TargetPathnameKey = utils.ByteOrder.Uint32(data[52:56])
-	e.SrcInode = utils.ByteOrder.Uint64(data[56:64])
-	e.SrcPathnameLength = utils.ByteOrder.Uint32(data[64:68])
-	e.SrcMountID = utils.ByteOrder.Uint32(data[68:72])
-	e.TargetInode = utils.ByteOrder.Uint64(data[72:80])
-	e.TargetPathnameLength = utils.ByteOrder.Uint32(data[80:84])
-	e.TargetMountID = utils.ByteOrder.Uint32(data[84:88])
-	e.Retval = int32(utils.ByteOrder.Uint32(data[88:92]))
-	e.EventType = GetEventType(utils.ByteOrder.Uint32(data[92:96]))
-	return 96, nil
+	e.Flags = utils.ByteOrder.Uint32(data[64:68])
+	e.Mode = utils.ByteOrder.Uint32(data[68:72])
+	e.SrcPathnameKey = utils.ByteOrder.Uint64(data[72:80])
+	e.TargetPathnameKey = utils.ByteOrder.Uint64(data[80:88])
+	e.SrcInode = utils.ByteOrder.Uint64(data[88:96])
+	e.SrcPathnameLength = utils.ByteOrder.Uint32(data[96:100])
+	e.SrcMountID = utils.ByteOrder.Uint32(data[100:104])
+	e.TargetInode = utils.ByteOrder.Uint64(data[104:112])
+	e.TargetPathnameLength = utils.ByteOrder.Uint32(data[112:116])
+	e.TargetMountID = utils.ByteOrder.Uint32(data[116:120])
+	e.Retval = int32(utils.ByteOrder.Uint32(data[120:124]))
+	e.EventType = GetEventType(utils.ByteOrder.Uint32(data[124:128]))
+	e.LoginUID = LoginUIDUnset
+	return 128, nil
 }
 
 // PrintFilenames - Returns a string representation of the filenames of the event
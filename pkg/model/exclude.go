@@ -0,0 +1,103 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Excludes - Paths and glob patterns that shouldn't be watched
+type Excludes struct {
+	prefixes []string
+	patterns []string
+}
+
+// NewExcludes - Returns a new Excludes instance. Paths are excluded along with their subtrees. Patterns without a "/"
+// are matched against the base name of a path, the other patterns are matched against the entire path.
+func NewExcludes(paths []string, patterns []string) (*Excludes, error) {
+	e := &Excludes{}
+	for _, p := range paths {
+		abs, err := filepath.Abs(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid exclude path %s", p)
+		}
+		e.prefixes = append(e.prefixes, abs)
+	}
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, errors.Wrapf(err, "invalid exclude pattern %s", pattern)
+		}
+		e.patterns = append(e.patterns, pattern)
+	}
+	return e, nil
+}
+
+// IsEmpty - Returns true if nothing is excluded
+func (e *Excludes) IsEmpty() bool {
+	return e == nil || (len(e.prefixes) == 0 && len(e.patterns) == 0)
+}
+
+// Match - Returns true if the provided path is excluded. Relative paths are resolved against the working directory,
+// like the exclude paths.
+func (e *Excludes) Match(p string) bool {
+	if e.IsEmpty() || len(p) == 0 {
+		return false
+	}
+	if !path.IsAbs(p) {
+		abs, err := filepath.Abs(p)
+		if err != nil {
+			return false
+		}
+		p = abs
+	}
+	p = path.Clean(p)
+	for _, prefix := range e.prefixes {
+		if p == prefix || strings.HasPrefix(p, prefix+"/") || prefix == "/" {
+			return true
+		}
+	}
+	base := path.Base(p)
+	for _, pattern := range e.patterns {
+		target := base
+		if strings.Contains(pattern, "/") {
+			target = p
+		}
+		if ok, _ := path.Match(pattern, target); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// KernelNames - Returns the patterns that are plain directory names. Those names can be checked in kernel space
+// without a path resolution.
+func (e *Excludes) KernelNames() []string {
+	if e == nil {
+		return nil
+	}
+	var names []string
+	for _, pattern := range e.patterns {
+		if strings.ContainsAny(pattern, "/*?[\\") || len(pattern) >= ExcludedNameSize {
+			continue
+		}
+		names = append(names, pattern)
+	}
+	return names
+}
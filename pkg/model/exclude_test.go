@@ -0,0 +1,127 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExcludesMatch(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	excludes, err := NewExcludes(
+		[]string{"/var/log/", "/etc/ssl//certs", "./testdata/excluded"},
+		[]string{"node_modules", "*.swp", "/home/*/.cache", ".git"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		path     string
+		expected bool
+	}{
+		// Prefixes
+		{"/var/log", true},
+		{"/var/log/", true},
+		{"/var/log/syslog", true},
+		{"/var/log/apt/history.log", true},
+		{"/var/logs", false},
+		{"/var", false},
+		{"/etc/ssl/certs/ca.pem", true},
+		{"/etc/ssl/private", false},
+		{"/var/../var/log/syslog", true},
+		// Relative exclude paths are resolved against the working directory
+		{filepath.Join(wd, "testdata/excluded/file"), true},
+		{filepath.Join(wd, "testdata/other"), false},
+		// Relative watched paths as well
+		{"testdata/excluded", true},
+		{"./testdata/excluded/sub/file", true},
+		{"testdata", false},
+		// Base name patterns
+		{"/srv/app/node_modules", true},
+		{"/srv/app/node_modules/lodash/index.js", false},
+		{"/srv/app/.git", true},
+		{"/srv/app/.gitignore", false},
+		{"/tmp/.main.go.swp", true},
+		{"/tmp/main.go", false},
+		// Full path patterns
+		{"/home/alice/.cache", true},
+		{"/home/alice/bob/.cache", false},
+		{"/root/.cache", false},
+		// Empty paths are never excluded
+		{"", false},
+	}
+	for _, tt := range tests {
+		if match := excludes.Match(tt.path); match != tt.expected {
+			t.Errorf("%s: expected %v, got %v", tt.path, tt.expected, match)
+		}
+	}
+}
+
+func TestExcludesRoot(t *testing.T) {
+	excludes, err := NewExcludes([]string{"/"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{"/", "/etc", "relative"} {
+		if !excludes.Match(p) {
+			t.Errorf("%s should be excluded", p)
+		}
+	}
+}
+
+func TestExcludesEmpty(t *testing.T) {
+	var nilExcludes *Excludes
+	if !nilExcludes.IsEmpty() || nilExcludes.Match("/etc") || nilExcludes.KernelNames() != nil {
+		t.Error("a nil Excludes shouldn't exclude anything")
+	}
+	excludes, err := NewExcludes(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !excludes.IsEmpty() || excludes.Match("/etc") {
+		t.Error("an empty Excludes shouldn't exclude anything")
+	}
+}
+
+func TestNewExcludesInvalidPattern(t *testing.T) {
+	if _, err := NewExcludes(nil, []string{"[a-"}); err == nil {
+		t.Error("expected an error for an invalid pattern")
+	}
+}
+
+func TestExcludesKernelNames(t *testing.T) {
+	longName := strings.Repeat("a", ExcludedNameSize)
+	maxName := strings.Repeat("b", ExcludedNameSize-1)
+	excludes, err := NewExcludes(
+		[]string{"/var/log"},
+		[]string{"node_modules", ".git", "*.swp", "cache?", "[ab]", "/home/*/.cache", "build/out", `esc\aped`, longName, maxName},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Only plain names that fit in the excluded_names map keys (with their NULL character) are pushed in kernel space
+	expected := []string{"node_modules", ".git", maxName}
+	if names := excludes.KernelNames(); !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+}
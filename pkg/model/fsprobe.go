@@ -26,4 +26,7 @@ type FSProbe interface {
 	GetOptions() *FSProbeOptions
 	GetCollection() *ebpf.Collection
 	GetBootTime() time.Time
+	GetStatsCollector() *StatsCollector
+	DispatchEvent(evt *FSEvent)
+	DispatchLost(lost *LostEvt)
 }
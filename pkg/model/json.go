@@ -0,0 +1,202 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// JSONSchemaVersion - Version of the JSON representation of FSEvent. The version is bumped whenever a field is
+// removed, renamed or changes type. New fields can be added without a version bump.
+const JSONSchemaVersion = 1
+
+var (
+	hostname     string
+	hostnameOnce sync.Once
+)
+
+// Hostname - Returns the hostname added to the JSON representation of the events
+func Hostname() string {
+	hostnameOnce.Do(func() {
+		hostname, _ = os.Hostname()
+	})
+	return hostname
+}
+
+// jsonEvent - JSON representation of FSEvent
+type jsonEvent struct {
+	SchemaVersion       int       `json:"schema_version"`
+	Timestamp           string    `json:"timestamp"`
+	Hostname            string    `json:"hostname,omitempty"`
+	EventType           EventName `json:"event_type"`
+	Pid                 uint32    `json:"pid"`
+	Tid                 uint32    `json:"tid"`
+	UID                 uint32    `json:"uid"`
+	GID                 uint32    `json:"gid"`
+	Comm                string    `json:"comm"`
+	Ppid                uint32    `json:"ppid"`
+	Exe                 string    `json:"exe,omitempty"`
+	Argv                []string  `json:"argv,omitempty"`
+	Cwd                 string    `json:"cwd,omitempty"`
+	LoginUID            *uint32   `json:"login_uid,omitempty"`
+	NsPid               uint32    `json:"ns_pid,omitempty"`
+	NsTid               uint32    `json:"ns_tid,omitempty"`
+	MntNs               uint32    `json:"mnt_ns,omitempty"`
+	PidNs               uint32    `json:"pid_ns,omitempty"`
+	CgroupID            uint64    `json:"cgroup_id,omitempty"`
+	CgroupPath          string    `json:"cgroup_path,omitempty"`
+	ContainerID         string    `json:"container_id,omitempty"`
+	Flags               uint32    `json:"flags"`
+	DecodedFlags        []string  `json:"decoded_flags,omitempty"`
+	Mode                uint32    `json:"mode"`
+	Retval              int32     `json:"retval"`
+	Errno               string    `json:"errno,omitempty"`
+	SrcInode            uint64    `json:"src_inode,omitempty"`
+	SrcFilename         string    `json:"src_filename,omitempty"`
+	SrcContainerPath    string    `json:"src_container_path,omitempty"`
+	SrcHostPath         string    `json:"src_host_path,omitempty"`
+	SrcMountID          uint32    `json:"src_mount_id,omitempty"`
+	TargetInode         uint64    `json:"target_inode,omitempty"`
+	TargetFilename      string    `json:"target_filename,omitempty"`
+	TargetContainerPath string    `json:"target_container_path,omitempty"`
+	TargetHostPath      string    `json:"target_host_path,omitempty"`
+	TargetMountID       uint32    `json:"target_mount_id,omitempty"`
+	MountPoint          string    `json:"mount_point,omitempty"`
+	FSType              string    `json:"fs_type,omitempty"`
+	Device              string    `json:"device,omitempty"`
+}
+
+// MarshalJSON - Returns the JSON representation of the event, see JSONSchemaVersion. The timestamp is formatted with
+// RFC3339Nano in UTC, the open and setattr flags are decoded, the errno name is added when the syscall failed, and
+// login_uid is omitted when the login UID isn't set.
+func (e FSEvent) MarshalJSON() ([]byte, error) {
+	je := jsonEvent{
+		SchemaVersion:       JSONSchemaVersion,
+		Timestamp:           e.Timestamp.UTC().Format(time.RFC3339Nano),
+		Hostname:            Hostname(),
+		EventType:           e.EventType,
+		Pid:                 e.Pid,
+		Tid:                 e.Tid,
+		UID:                 e.UID,
+		GID:                 e.GID,
+		Comm:                e.Comm,
+		Ppid:                e.Ppid,
+		Exe:                 e.Exe,
+		Argv:                e.Argv,
+		Cwd:                 e.Cwd,
+		NsPid:               e.NsPid,
+		NsTid:               e.NsTid,
+		MntNs:               e.MntNs,
+		PidNs:               e.PidNs,
+		CgroupID:            e.CgroupID,
+		CgroupPath:          e.CgroupPath,
+		ContainerID:         e.ContainerID,
+		Flags:               e.Flags,
+		Mode:                e.Mode,
+		Retval:              e.Retval,
+		SrcInode:            e.SrcInode,
+		SrcFilename:         e.SrcFilename,
+		SrcContainerPath:    e.SrcContainerPath,
+		SrcHostPath:         e.SrcHostPath,
+		SrcMountID:          e.SrcMountID,
+		TargetInode:         e.TargetInode,
+		TargetFilename:      e.TargetFilename,
+		TargetContainerPath: e.TargetContainerPath,
+		TargetHostPath:      e.TargetHostPath,
+		TargetMountID:       e.TargetMountID,
+		MountPoint:          e.MountPoint,
+		FSType:              e.FSType,
+		Device:              e.Device,
+	}
+	switch e.EventType {
+	case Open:
+		je.DecodedFlags = OpenFlagsToStrings(e.Flags)
+	case SetAttr:
+		je.DecodedFlags = SetAttrFlagsToString(e.Flags)
+	}
+	if e.Retval < 0 {
+		je.Errno = ErrValueToString(e.Retval)
+	}
+	if e.LoginUID != LoginUIDUnset {
+		loginUID := e.LoginUID
+		je.LoginUID = &loginUID
+	}
+	return json.Marshal(je)
+}
+
+// UnmarshalJSON - Parses the JSON representation of an event. The decoded fields (decoded_flags, errno) and the
+// hostname are ignored.
+func (e *FSEvent) UnmarshalJSON(data []byte) error {
+	var je jsonEvent
+	if err := json.Unmarshal(data, &je); err != nil {
+		return err
+	}
+	if je.SchemaVersion > JSONSchemaVersion {
+		return errors.Errorf("unsupported schema version: %d", je.SchemaVersion)
+	}
+	*e = FSEvent{
+		Pid:                 je.Pid,
+		Tid:                 je.Tid,
+		UID:                 je.UID,
+		GID:                 je.GID,
+		Comm:                je.Comm,
+		Ppid:                je.Ppid,
+		Exe:                 je.Exe,
+		Argv:                je.Argv,
+		Cwd:                 je.Cwd,
+		LoginUID:            LoginUIDUnset,
+		NsPid:               je.NsPid,
+		NsTid:               je.NsTid,
+		MntNs:               je.MntNs,
+		PidNs:               je.PidNs,
+		CgroupID:            je.CgroupID,
+		CgroupPath:          je.CgroupPath,
+		ContainerID:         je.ContainerID,
+		Flags:               je.Flags,
+		Mode:                je.Mode,
+		SrcInode:            je.SrcInode,
+		SrcFilename:         je.SrcFilename,
+		SrcContainerPath:    je.SrcContainerPath,
+		SrcHostPath:         je.SrcHostPath,
+		SrcMountID:          je.SrcMountID,
+		TargetInode:         je.TargetInode,
+		TargetFilename:      je.TargetFilename,
+		TargetContainerPath: je.TargetContainerPath,
+		TargetHostPath:      je.TargetHostPath,
+		TargetMountID:       je.TargetMountID,
+		MountPoint:          je.MountPoint,
+		FSType:              je.FSType,
+		Device:              je.Device,
+		Retval:              je.Retval,
+		EventType:           je.EventType,
+	}
+	if je.LoginUID != nil {
+		e.LoginUID = *je.LoginUID
+	}
+	if je.Timestamp != "" {
+		ts, err := time.Parse(time.RFC3339Nano, je.Timestamp)
+		if err != nil {
+			return errors.Wrap(err, "invalid timestamp")
+		}
+		e.Timestamp = ts
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFSEventJSON(t *testing.T) {
+	evt := FSEvent{
+		Timestamp:   time.Date(2020, 6, 7, 13, 25, 41, 123456789, time.UTC),
+		Pid:         2134,
+		Tid:         2134,
+		UID:         1000,
+		GID:         1000,
+		Comm:        "vim",
+		Ppid:        2001,
+		Exe:         "/usr/bin/vim.basic",
+		Argv:        []string{"vim", "/etc/passwd"},
+		Cwd:         "/home/user",
+		LoginUID:    1000,
+		ContainerID: "3f4c4a5b0d1e2f30415263748596a7b8c9dae0f1021324354657687980a1b2c3",
+		CgroupID:    7520,
+		Flags:       uint32(OWRONLY | OCREAT),
+		Mode:        0100644,
+		SrcInode:    1839,
+		SrcFilename: "/etc/passwd",
+		SrcMountID:  27,
+		Retval:      -int32(EACCES),
+		EventType:   Open,
+	}
+	data, err := json.Marshal(&evt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"schema_version": float64(JSONSchemaVersion),
+		"timestamp":      "2020-06-07T13:25:41.123456789Z",
+		"event_type":     "open",
+		"errno":          "EACCES",
+		"src_filename":   "/etc/passwd",
+		"exe":            "/usr/bin/vim.basic",
+		"login_uid":      float64(1000),
+		"container_id":   "3f4c4a5b0d1e2f30415263748596a7b8c9dae0f1021324354657687980a1b2c3",
+		"cgroup_id":      float64(7520),
+	}
+	for key, value := range expected {
+		if fields[key] != value {
+			t.Errorf("%s: expected %v, got %v", key, value, fields[key])
+		}
+	}
+	if !reflect.DeepEqual(fields["decoded_flags"], []interface{}{"ORDONLY", "OWRONLY", "OCREAT"}) {
+		t.Errorf("unexpected decoded flags: %v", fields["decoded_flags"])
+	}
+
+	var decoded FSEvent
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Timestamp.Equal(evt.Timestamp) {
+		t.Errorf("expected timestamp %v, got %v", evt.Timestamp, decoded.Timestamp)
+	}
+	decoded.Timestamp = evt.Timestamp
+	if !reflect.DeepEqual(decoded, evt) {
+		t.Errorf("expected %+v, got %+v", evt, decoded)
+	}
+}
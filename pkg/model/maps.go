@@ -15,11 +15,18 @@ limitations under the License.
 */
 package model
 
+import (
+	"github.com/Gui774ume/ebpf"
+)
+
 const (
 	// FragmentsMap - This map holds the cache of resolved dentries for the path fragments method
 	PathFragmentsMap = "path_fragments"
 	// PathFragmentsSize - Size of the fragments used by the path fragments method
 	PathFragmentsSize = 256
+	// PathKeySize - Size of the path_key_t kernel structure, used as key by the inodes_filter, cached_inodes and
+	// single_fragments maps
+	PathKeySize = 16
 	// SingleFragmentSection - This map holds the cache of resolved dentries for the single fragment method
 	SingleFragmentsMap = "single_fragments"
 	// SingleFragmentSize - Size of the single fragment used by the single fragment method
@@ -37,6 +44,53 @@ const (
 	DentryCacheBuilderMap = "dentry_cache_builder"
 	// PathsBuilderMap - Array map used by the perf buffer method and the single fragment method to build paths
 	PathsBuilderMap = "paths_builder"
+	// SymlinkBuilderMap - Per CPU array map used by the fragments and single fragment methods to send the link target of
+	// the symlink events
+	SymlinkBuilderMap = "symlink_builder"
 	// InodesFilterMap - This map is used to push inode filters in kernel space.
 	InodesFilterMap = "inodes_filter"
+	// ExcludedNamesMap - This map is used to push the names of the directories that the recursive mode shouldn't watch
+	ExcludedNamesMap = "excluded_names"
+	// ExcludedNameSize - Maximum size (including the trailing NULL character) of the names of the excluded_names map
+	ExcludedNameSize = 64
+	// PidFilterMap - This map is used to push pid & tid filters in kernel space
+	PidFilterMap = "pid_filter"
+	// CommFilterMap - This map is used to push process name filters in kernel space
+	CommFilterMap = "comm_filter"
+	// UIDFilterMap - This map is used to push uid filters in kernel space
+	UIDFilterMap = "uid_filter"
+	// GIDFilterMap - This map is used to push gid filters in kernel space
+	GIDFilterMap = "gid_filter"
+	// CgroupFilterMap - This map is used to push cgroup ID filters in kernel space
+	CgroupFilterMap = "cgroup_filter"
+	// ProcessFiltersAllowCountMap - This map holds the number of allow entries of each process filter type
+	ProcessFiltersAllowCountMap = "process_filters_allow_count"
+	// ProcessEventsMap - Perf event buffer map used to retrieve process execution events in userspace
+	ProcessEventsMap = "process_events"
+	// ExecEventBuilderMap - Per CPU array map used to build process execution events
+	ExecEventBuilderMap = "exec_event_builder"
+	// ExecFilenameSize - Maximum size of the executable paths of the process execution events
+	ExecFilenameSize = 256
+	// ExecArgsSize - Maximum size of the arguments of the process execution events
+	ExecArgsSize = 512
 )
+
+// CountMapEntries - Returns the number of entries of an eBPF hashmap. The count is approximate if the map is updated
+// concurrently.
+func CountMapEntries(m *ebpf.Map) (int, error) {
+	var count int
+	var key interface{}
+	// Stop after MaxEntries keys, the iteration restarts from the first key if the current key is deleted
+	for count < int(m.ABI().MaxEntries) {
+		next, err := m.NextKeyBytes(key)
+		if err != nil {
+			return count, err
+		}
+		if next == nil {
+			break
+		}
+		key = next
+		count++
+	}
+	return count, nil
+}
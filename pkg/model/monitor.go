@@ -17,10 +17,10 @@ package model
 
 import (
 	"fmt"
-	"github.com/Gui774ume/fsprobe/pkg/utils"
 	"sync"
 
 	"github.com/Gui774ume/ebpf"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
@@ -30,20 +30,28 @@ type Monitor struct {
 	collection         *ebpf.Collection
 	ResolutionModeMaps map[DentryResolutionMode][]string
 	DentryResolver     DentryResolver
+	MountResolver      *MountResolver
+	ProcessCache       *ProcessCache
+	Excludes           *Excludes
+	Stats              *StatsCollector
 	FSProbe            FSProbe
 	InodeFilterSection string
 	Name               string
 	Options            *FSProbeOptions
 	Probes             map[EventName][]*Probe
 	PerfMaps           []*PerfMap
+	processFilters     processFilters
+	// MountNamespaceResolver - Translates the paths of the events of other mount namespaces, set when the
+	// ContainerPaths option is set
+	MountNamespaceResolver *MountNamespaceResolver
 }
 
 // Configure - Configures the probes using the provided options
 func (m *Monitor) Configure() {
 	if len(m.Options.Events) == 0 {
-		// Activate everything but the modification probe
+		// Activate everything but the modification probe and the process probes
 		for name, probes := range m.Probes {
-			if name == Modify {
+			if name == Modify || name == ProcessExecProbes || name == ProcessTreeProbes {
 				continue
 			}
 			for _, p := range probes {
@@ -62,8 +70,34 @@ func (m *Monitor) Configure() {
 			}
 		}
 	}
+	// Populate the process cache with the exec events
+	if m.Options.TrackExecs {
+		for _, p := range m.Probes[ProcessExecProbes] {
+			p.Enabled = true
+		}
+	}
+	// Track the descendants of the filtered processes
+	if m.Options.FollowForks {
+		for _, p := range m.Probes[ProcessTreeProbes] {
+			p.Enabled = true
+		}
+	}
 	// Setup dentry resolver
 	m.DentryResolver, _ = NewDentryResolver(m)
+	// Setup mount resolver
+	var err error
+	if m.MountResolver, err = NewMountResolver(); err != nil {
+		logrus.Warnf("couldn't create mount resolver, paths will be relative to their mount point: %v", err)
+	}
+	if m.Options.ContainerPaths {
+		if m.MountNamespaceResolver, err = NewMountNamespaceResolver(m.MountResolver); err != nil {
+			logrus.Warnf("couldn't create mount namespace resolver, the events won't have container paths: %v", err)
+		}
+	}
+	// Setup process cache
+	if m.ProcessCache, err = NewProcessCache(); err != nil {
+		logrus.Warnf("couldn't create process cache, the events won't have process context: %v", err)
+	}
 }
 
 // GetName - Returns the name of the monitor
@@ -71,8 +105,27 @@ func (m *Monitor) GetName() string {
 	return m.Name
 }
 
+// GetProbes - Returns the probes of the monitor
+func (m *Monitor) GetProbes() map[EventName][]*Probe {
+	return m.Probes
+}
+
+// GetResolutionModeMaps - Returns the maps used by each dentry resolution mode
+func (m *Monitor) GetResolutionModeMaps() map[DentryResolutionMode][]string {
+	return m.ResolutionModeMaps
+}
+
+// GetDentryResolver - Returns the dentry resolver of the monitor
+func (m *Monitor) GetDentryResolver() DentryResolver {
+	return m.DentryResolver
+}
+
 // GetMap - Returns the map at the provided section
 func (m *Monitor) GetMap(section string) *ebpf.Map {
+	if m.collection == nil {
+		// The monitor is replaying a capture
+		return nil
+	}
 	return m.collection.Maps[section]
 }
 
@@ -82,7 +135,18 @@ func (m *Monitor) Init(fs FSProbe) error {
 	m.wg = fs.GetWaitGroup()
 	m.Options = fs.GetOptions()
 	m.collection = fs.GetCollection()
+	m.Stats = fs.GetStatsCollector()
 	m.Configure()
+	// Setup excludes
+	var err error
+	if m.Excludes, err = NewExcludes(m.Options.ExcludePaths, m.Options.ExcludePatterns); err != nil {
+		return err
+	}
+	if m.Options.Recursive {
+		if err := m.pushExcludedNames(); err != nil {
+			return errors.Wrap(err, "couldn't push excluded names")
+		}
+	}
 	// Init probes
 	for _, probes := range m.Probes {
 		for _, p := range probes {
@@ -97,9 +161,64 @@ func (m *Monitor) Init(fs FSProbe) error {
 			return err
 		}
 	}
+	// Push the process filters before the probes are attached
+	if !m.Options.AllowProcesses.IsEmpty() {
+		if err := m.AddProcessFilter(ProcessFilterAllow, m.Options.AllowProcesses); err != nil {
+			return errors.Wrap(err, "couldn't push process allow list")
+		}
+	}
+	if !m.Options.DenyProcesses.IsEmpty() {
+		if err := m.AddProcessFilter(ProcessFilterDeny, m.Options.DenyProcesses); err != nil {
+			return errors.Wrap(err, "couldn't push process deny list")
+		}
+	}
 	return nil
 }
 
+// InitReplay - Initializes the monitor to replay the samples that follow the provided capture header, without eBPF.
+// The options of the capture override the options of FSProbe, and the dentry resolver is replaced by a ReplayResolver.
+func (m *Monitor) InitReplay(fs FSProbe, header *CaptureHeader) error {
+	m.FSProbe = fs
+	m.wg = fs.GetWaitGroup()
+	options := *fs.GetOptions()
+	header.Apply(&options)
+	options.Recorder = nil
+	m.Options = &options
+	m.collection = nil
+	m.Stats = fs.GetStatsCollector()
+	m.DentryResolver = NewReplayResolver(options.DentryResolutionMode, m.Stats)
+	m.MountResolver = NewStaticMountResolver(header.Mounts)
+	var err error
+	if m.ProcessCache, err = NewStaticProcessCache(); err != nil {
+		return err
+	}
+	m.Excludes, err = NewExcludes(options.ExcludePaths, options.ExcludePatterns)
+	return err
+}
+
+// ReplayRecord - Replays a record of a capture with the handlers of the perf maps of the monitor
+func (m *Monitor) ReplayRecord(record *CaptureRecord) {
+	switch record.Type {
+	case CaptureRecordSample, CaptureRecordLost:
+		for _, pm := range m.PerfMaps {
+			if pm.PerfOutputMapName != record.Map {
+				continue
+			}
+			if record.Type == CaptureRecordSample {
+				pm.DataHandler(record.Data, m)
+			} else if pm.LostHandler != nil {
+				pm.LostHandler(record.Count, record.Map, m)
+			}
+		}
+	case CaptureRecordCacheEntry:
+		if err := m.DentryResolver.AddCacheEntry(record.MountID, record.Inode, record.Path); err != nil {
+			logrus.Debugf("couldn't replay cache entry of %s: %v", record.Path, err)
+		}
+	case CaptureRecordCacheRemoval:
+		_ = m.DentryResolver.RemoveEntry(record.MountID, record.Inode)
+	}
+}
+
 // Start - Starts the monitor
 func (m *Monitor) Start() error {
 	// start probes
@@ -111,6 +230,12 @@ func (m *Monitor) Start() error {
 			}
 		}
 	}
+	// start watching mount points
+	if m.MountResolver != nil {
+		if err := m.MountResolver.Start(m.wg); err != nil {
+			logrus.Warnf("couldn't watch mount points: %v", err)
+		}
+	}
 	// start polling perf maps
 	for _, pm := range m.PerfMaps {
 		if err := pm.pollStart(); err != nil {
@@ -136,13 +261,45 @@ func (m *Monitor) Stop() error {
 			logrus.Errorf("couldn't close perf map %v gracefully: %v", pm.PerfOutputMapName, err)
 		}
 	}
+	// stop watching mount points
+	if m.MountResolver != nil {
+		if err := m.MountResolver.Stop(); err != nil {
+			logrus.Errorf("couldn't stop mount resolver gracefully: %v", err)
+		}
+	}
+	return nil
+}
+
+// pushExcludedNames - Pushes the excluded directory names in kernel space so that the recursive mode doesn't watch
+// the new directories with those names
+func (m *Monitor) pushExcludedNames() error {
+	names := m.Excludes.KernelNames()
+	if len(names) == 0 {
+		return nil
+	}
+	excludedNames := m.GetMap(ExcludedNamesMap)
+	if excludedNames == nil {
+		return fmt.Errorf("couldn't find %v map", ExcludedNamesMap)
+	}
+	var valueB byte
+	for _, name := range names {
+		key := make([]byte, ExcludedNameSize)
+		copy(key, name)
+		if err := excludedNames.Put(key, valueB); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func (m *Monitor) AddInodeFilter(inode uint32, path string) error {
+// AddInodeFilter - Adds an (inode, mount ID) couple in the in-kernel filter and caches the path of the inode
+func (m *Monitor) AddInodeFilter(mountID uint32, inode uint64, path string) error {
 	// Add file in caches
+	if m.Options.Recorder != nil {
+		m.Options.Recorder.RecordCacheEntry(mountID, inode, path)
+	}
 	if m.DentryResolver != nil {
-		if err := m.DentryResolver.AddCacheEntry(inode, path); err != nil {
+		if err := m.DentryResolver.AddCacheEntry(mountID, inode, path); err != nil {
 			return err
 		}
 	}
@@ -151,11 +308,49 @@ func (m *Monitor) AddInodeFilter(inode uint32, path string) error {
 	if filter == nil {
 		return fmt.Errorf("couldn't find %v map", m.InodeFilterSection)
 	}
-	keyB := make([]byte, 4)
-	utils.ByteOrder.PutUint32(keyB, inode)
 	var valueB byte
-	if err := filter.Put(keyB, valueB); err != nil {
-		return err
+	for _, id := range m.filterMountIDs(mountID) {
+		key := NewPathKey(id, inode)
+		if err := filter.Put(key.GetKeyBytes(), valueB); err != nil {
+			return err
+		}
 	}
 	return nil
 }
+
+// RemoveInodeFilter - Removes an inode from the in-kernel filter and drops the matching resolver cache entry
+func (m *Monitor) RemoveInodeFilter(mountID uint32, inode uint64, path string) error {
+	// Remove file from caches
+	if m.Options.Recorder != nil {
+		m.Options.Recorder.RecordCacheRemoval(mountID, inode)
+	}
+	if m.DentryResolver != nil {
+		if err := m.DentryResolver.RemoveEntry(mountID, inode); err != nil {
+			logrus.Debugf("couldn't remove cache entry of %s: %v", path, err)
+		}
+	}
+	// Remove inode filter
+	filter := m.GetMap(m.InodeFilterSection)
+	if filter == nil {
+		return fmt.Errorf("couldn't find %v map", m.InodeFilterSection)
+	}
+	// The directories created in a watched directory are only added by the kernel with the mount ID it reports, so
+	// only the provided key is expected to exist
+	for _, id := range m.filterMountIDs(mountID) {
+		key := NewPathKey(id, inode)
+		if err := filter.Delete(key.GetKeyBytes()); err != nil && id == mountID {
+			return err
+		}
+	}
+	return nil
+}
+
+// filterMountIDs - Returns the mount IDs under which an inode of the provided mount ID has to be added to the in-kernel
+// filter: the probes that only have access to an inode can't tell through which mount point of its filesystem it was
+// reached, so the inode is watched on all of them.
+func (m *Monitor) filterMountIDs(mountID uint32) []uint32 {
+	if m.MountResolver == nil {
+		return []uint32{mountID}
+	}
+	return m.MountResolver.GetSuperblockMounts(mountID)
+}
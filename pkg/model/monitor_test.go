@@ -0,0 +1,60 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"testing"
+)
+
+func TestConfigureProcessProbes(t *testing.T) {
+	tests := []struct {
+		options  FSProbeOptions
+		expected map[EventName]bool
+	}{
+		{
+			options:  FSProbeOptions{},
+			expected: map[EventName]bool{Open: true, Modify: false, ProcessExecProbes: false, ProcessTreeProbes: false},
+		},
+		{
+			options:  FSProbeOptions{Events: []EventName{Modify}},
+			expected: map[EventName]bool{Open: false, Modify: true, ProcessExecProbes: false, ProcessTreeProbes: false},
+		},
+		{
+			options:  FSProbeOptions{TrackExecs: true},
+			expected: map[EventName]bool{Open: true, Modify: false, ProcessExecProbes: true, ProcessTreeProbes: false},
+		},
+		{
+			options:  FSProbeOptions{Events: []EventName{Open}, FollowForks: true},
+			expected: map[EventName]bool{Open: true, Modify: false, ProcessExecProbes: false, ProcessTreeProbes: true},
+		},
+	}
+	for _, test := range tests {
+		options := test.options
+		m := &Monitor{
+			Options: &options,
+			Probes:  make(map[EventName][]*Probe),
+		}
+		for name := range test.expected {
+			m.Probes[name] = []*Probe{{Name: string(name)}}
+		}
+		m.Configure()
+		for name, enabled := range test.expected {
+			if got := m.Probes[name][0].Enabled; got != enabled {
+				t.Errorf("%+v: expected the %s probes to be enabled=%v, got %v", test.options, name, enabled, got)
+			}
+		}
+	}
+}
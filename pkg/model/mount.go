@@ -0,0 +1,366 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// MountInfoPath - Path to the mountinfo file of the current mount namespace
+	MountInfoPath = "/proc/self/mountinfo"
+	// mountRefreshRate - Minimum delay between two refreshes triggered by an unknown mount ID
+	mountRefreshRate = time.Second
+	// mountPollTimeout - Timeout (in ms) of the poll call used to detect mount changes
+	mountPollTimeout = 1000
+)
+
+// MountInfo - Mount point entry parsed from the mountinfo file
+type MountInfo struct {
+	MountID    uint32
+	ParentID   uint32
+	Device     string
+	Root       string
+	MountPoint string
+	FSType     string
+	Source     string
+	// SuperOptions - Options of the superblock of the mount point (lowerdir, upperdir and workdir for overlayfs)
+	SuperOptions string
+}
+
+// ResolvePath - Converts a path relative to the root of the filesystem into a path relative to the root of the
+// mount namespace
+func (mi *MountInfo) ResolvePath(p string) string {
+	if !mi.Reaches(p) {
+		return p
+	}
+	if mi.Root != "/" {
+		p = strings.TrimPrefix(p, mi.Root)
+	}
+	return path.Join(mi.MountPoint, p)
+}
+
+// Reaches - Returns true if the provided path, relative to the root of the filesystem, is reachable from the mount
+// point
+func (mi *MountInfo) Reaches(p string) bool {
+	return mi.Root == "/" || p == mi.Root || strings.HasPrefix(p, mi.Root+"/")
+}
+
+// OverlayDirs - Returns the upper and lower directories of an overlayfs mount point. Relative lower directories
+// (docker mounts its layers from its overlay2 directory to shorten the options) are resolved against the parent of
+// the layer of the upper directory.
+func (mi *MountInfo) OverlayDirs() (upper string, lowers []string) {
+	for _, option := range strings.Split(mi.SuperOptions, ",") {
+		switch {
+		case strings.HasPrefix(option, "upperdir="):
+			upper = strings.TrimPrefix(option, "upperdir=")
+		case strings.HasPrefix(option, "lowerdir="):
+			lowers = strings.Split(strings.TrimPrefix(option, "lowerdir="), ":")
+		}
+	}
+	for i, lower := range lowers {
+		if !path.IsAbs(lower) && upper != "" {
+			lowers[i] = path.Join(path.Dir(path.Dir(upper)), lower)
+		}
+	}
+	return upper, lowers
+}
+
+// parseMountInfo - Parses a line of the mountinfo file
+func parseMountInfo(line string) (*MountInfo, error) {
+	// 36 35 98:0 /mnt1 /mnt2 rw,noatime master:1 - ext3 /dev/root rw,errors=continue
+	fields := strings.Fields(line)
+	if len(fields) < 10 {
+		return nil, errors.Errorf("invalid mountinfo line: %s", line)
+	}
+	mountID, err := strconv.ParseUint(fields[0], 10, 32)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid mount ID: %s", fields[0])
+	}
+	parentID, err := strconv.ParseUint(fields[1], 10, 32)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid parent ID: %s", fields[1])
+	}
+	// Optional fields are terminated by a single hyphen
+	sep := 6
+	for sep < len(fields) && fields[sep] != "-" {
+		sep++
+	}
+	if sep+2 >= len(fields) {
+		return nil, errors.Errorf("invalid mountinfo line: %s", line)
+	}
+	mount := &MountInfo{
+		MountID:    uint32(mountID),
+		ParentID:   uint32(parentID),
+		Device:     fields[2],
+		Root:       unescapeMountInfo(fields[3]),
+		MountPoint: unescapeMountInfo(fields[4]),
+		FSType:     fields[sep+1],
+		Source:     unescapeMountInfo(fields[sep+2]),
+	}
+	if sep+3 < len(fields) {
+		mount.SuperOptions = unescapeMountInfo(fields[sep+3])
+	}
+	return mount, nil
+}
+
+// unescapeMountInfo - Decodes the octal escape sequences (\040 for spaces for example) of a mountinfo field
+func unescapeMountInfo(field string) string {
+	if !strings.Contains(field, "\\") {
+		return field
+	}
+	var b strings.Builder
+	for i := 0; i < len(field); i++ {
+		if field[i] == '\\' && i+3 < len(field) {
+			if c, err := strconv.ParseUint(field[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(c))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(field[i])
+	}
+	return b.String()
+}
+
+// MountResolver - Resolves mount IDs into mount points, and keeps track of mount and unmount changes
+type MountResolver struct {
+	lock        sync.RWMutex
+	mounts      map[uint32]*MountInfo
+	lastRefresh time.Time
+	stop        chan struct{}
+	// static - Set when the mount points were provided by NewStaticMountResolver and shouldn't be reloaded
+	static bool
+}
+
+// NewMountResolver - Returns a new MountResolver instance initialized with the current mount points
+func NewMountResolver() (*MountResolver, error) {
+	mr := &MountResolver{
+		mounts: make(map[uint32]*MountInfo),
+	}
+	if err := mr.Refresh(); err != nil {
+		return nil, err
+	}
+	return mr, nil
+}
+
+// NewStaticMountResolver - Returns a new MountResolver instance that only knows the provided mount points, used to
+// resolve the paths of a capture
+func NewStaticMountResolver(mounts []*MountInfo) *MountResolver {
+	mr := &MountResolver{
+		mounts: make(map[uint32]*MountInfo),
+		static: true,
+	}
+	for _, mount := range mounts {
+		mr.mounts[mount.MountID] = mount
+	}
+	return mr
+}
+
+// ReadMounts - Returns the mount points of the current mount namespace, sorted by mount ID
+func ReadMounts() ([]*MountInfo, error) {
+	mountsByID, err := readMountInfoFile(MountInfoPath)
+	if err != nil {
+		return nil, err
+	}
+	mounts := make([]*MountInfo, 0, len(mountsByID))
+	for _, mount := range mountsByID {
+		mounts = append(mounts, mount)
+	}
+	sort.Slice(mounts, func(i, j int) bool {
+		return mounts[i].MountID < mounts[j].MountID
+	})
+	return mounts, nil
+}
+
+// Refresh - Reloads the mount points from the mountinfo file
+func (mr *MountResolver) Refresh() error {
+	mounts, err := readMountInfoFile(MountInfoPath)
+	if err != nil {
+		return err
+	}
+	mr.lock.Lock()
+	mr.mounts = mounts
+	mr.lastRefresh = time.Now()
+	mr.lock.Unlock()
+	return nil
+}
+
+// readMountInfoFile - Reads all the mount points of the provided mountinfo file
+func readMountInfoFile(mountInfoPath string) (map[uint32]*MountInfo, error) {
+	f, err := os.Open(mountInfoPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't open %s", mountInfoPath)
+	}
+	defer f.Close()
+	return readMountInfo(f)
+}
+
+// readMountInfo - Reads all the mount points of a mountinfo file
+func readMountInfo(r io.Reader) (map[uint32]*MountInfo, error) {
+	mounts := make(map[uint32]*MountInfo)
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		mount, err := parseMountInfo(sc.Text())
+		if err != nil {
+			logrus.Debugf("couldn't parse mount point: %v", err)
+			continue
+		}
+		mounts[mount.MountID] = mount
+	}
+	if err := sc.Err(); err != nil {
+		return nil, errors.Wrapf(err, "couldn't read %s", MountInfoPath)
+	}
+	return mounts, nil
+}
+
+// GetMount - Returns the mount point of the provided mount ID. The mount points are reloaded (at most once per
+// second) when the mount ID is unknown.
+func (mr *MountResolver) GetMount(mountID uint32) (*MountInfo, bool) {
+	mr.lock.RLock()
+	mount, ok := mr.mounts[mountID]
+	lastRefresh := mr.lastRefresh
+	mr.lock.RUnlock()
+	if ok || mr.static || time.Since(lastRefresh) < mountRefreshRate {
+		return mount, ok
+	}
+	if err := mr.Refresh(); err != nil {
+		logrus.Warnf("couldn't refresh mount points: %v", err)
+		return nil, false
+	}
+	mr.lock.RLock()
+	defer mr.lock.RUnlock()
+	mount, ok = mr.mounts[mountID]
+	return mount, ok
+}
+
+// ResolvePath - Prefixes the provided path with the mount point of the provided mount ID
+func (mr *MountResolver) ResolvePath(mountID uint32, p string) string {
+	mount, ok := mr.GetMount(mountID)
+	if !ok || len(p) == 0 {
+		return p
+	}
+	return mount.ResolvePath(p)
+}
+
+// ResolveDevicePath - Converts a path relative to the root of the filesystem of the provided device into a path of
+// the current mount namespace. The mount point closest to the root of the filesystem is used, an empty string is
+// returned when the path isn't reachable from the mount points of the device.
+func (mr *MountResolver) ResolveDevicePath(device string, p string) string {
+	mr.lock.RLock()
+	defer mr.lock.RUnlock()
+	var best *MountInfo
+	for _, mount := range mr.mounts {
+		if mount.Device != device || !mount.Reaches(p) {
+			continue
+		}
+		if best == nil || len(mount.Root) < len(best.Root) || (len(mount.Root) == len(best.Root) && mount.MountID < best.MountID) {
+			best = mount
+		}
+	}
+	if best == nil {
+		return ""
+	}
+	return best.ResolvePath(p)
+}
+
+// GetSuperblockMounts - Returns the IDs of the mount points of the filesystem of the provided mount ID, sorted by mount
+// ID. The kernel probes that are only given an inode report the mount ID of the oldest mount of its superblock, which
+// isn't necessarily the mount point through which the file was reached (bind mounts, filesystems mounted twice).
+func (mr *MountResolver) GetSuperblockMounts(mountID uint32) []uint32 {
+	mount, ok := mr.GetMount(mountID)
+	if !ok {
+		return []uint32{mountID}
+	}
+	mr.lock.RLock()
+	defer mr.lock.RUnlock()
+	ids := []uint32{mountID}
+	for id, m := range mr.mounts {
+		if id != mountID && m.Device == mount.Device {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	return ids
+}
+
+// Start - Starts watching the mountinfo file for mount and unmount changes
+func (mr *MountResolver) Start(wg *sync.WaitGroup) error {
+	f, err := os.Open(MountInfoPath)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't open %s", MountInfoPath)
+	}
+	mr.stop = make(chan struct{})
+	wg.Add(1)
+	go mr.watch(f, wg, mr.stop)
+	return nil
+}
+
+// watch - Refreshes the mount points each time the kernel notifies a change in the mount namespace
+func (mr *MountResolver) watch(f *os.File, wg *sync.WaitGroup, stop <-chan struct{}) {
+	defer wg.Done()
+	defer f.Close()
+	fds := []unix.PollFd{{Fd: int32(f.Fd()), Events: unix.POLLPRI}}
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+		n, err := unix.Poll(fds, mountPollTimeout)
+		if err != nil {
+			if err == unix.EINTR {
+				continue
+			}
+			logrus.Warnf("couldn't poll %s: %v", MountInfoPath, err)
+			return
+		}
+		if n == 0 || fds[0].Revents&(unix.POLLPRI|unix.POLLERR) == 0 {
+			continue
+		}
+		if err := mr.Refresh(); err != nil {
+			logrus.Warnf("couldn't refresh mount points: %v", err)
+		}
+	}
+}
+
+// Stop - Stops watching the mountinfo file
+func (mr *MountResolver) Stop() error {
+	if mr.stop == nil {
+		return nil
+	}
+	select {
+	case <-mr.stop:
+		// Already stopped
+	default:
+		close(mr.stop)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+
+	"github.com/Gui774ume/fsprobe/pkg/utils"
+)
+
+// mountNamespaceCacheSize - Maximum number of mount namespaces in the cache of the MountNamespaceResolver
+const mountNamespaceCacheSize = 256
+
+// mountNamespace - Mount points of a mount namespace
+type mountNamespace struct {
+	mounts      map[uint32]*MountInfo
+	lastRefresh time.Time
+}
+
+// MountNamespaceResolver - Translates the paths of the events of the processes that don't share the mount namespace
+// of fsprobe (containers). The mount points of a mount namespace are read from /proc/<pid>/mountinfo when its first
+// event is received, and reloaded (at most once per second) when a mount ID is unknown.
+type MountNamespaceResolver struct {
+	lock       sync.Mutex
+	hostMntNs  uint32
+	host       *MountResolver
+	namespaces *lru.Cache
+}
+
+// NewMountNamespaceResolver - Returns a new MountNamespaceResolver instance. The host paths of the files that aren't
+// on an overlayfs are resolved with the provided MountResolver of the mount namespace of fsprobe.
+func NewMountNamespaceResolver(host *MountResolver) (*MountNamespaceResolver, error) {
+	hostMntNs := uint32(utils.GetMntnsFromPid(uint32(os.Getpid())))
+	if hostMntNs == 0 {
+		return nil, errors.New("couldn't read the mount namespace of fsprobe")
+	}
+	namespaces, err := lru.New(mountNamespaceCacheSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't create mount namespace cache")
+	}
+	return &MountNamespaceResolver{
+		hostMntNs:  hostMntNs,
+		host:       host,
+		namespaces: namespaces,
+	}, nil
+}
+
+// Resolve - Adds the container and host paths to an event. The paths of the events of the mount namespace of fsprobe
+// are left empty: they are already both.
+func (mnr *MountNamespaceResolver) Resolve(evt *FSEvent) {
+	if evt.MntNs == 0 || evt.MntNs == mnr.hostMntNs {
+		return
+	}
+	if evt.SrcFilename != "" {
+		evt.SrcContainerPath, evt.SrcHostPath = mnr.translate(evt.MntNs, evt.Pid, evt.SrcMountID, evt.SrcFilename)
+	}
+	switch evt.EventType {
+	case Link, Rename:
+		if evt.TargetFilename != "" {
+			evt.TargetContainerPath, evt.TargetHostPath = mnr.translate(evt.MntNs, evt.Pid, evt.TargetMountID, evt.TargetFilename)
+		}
+	}
+}
+
+// translate - Returns the container and host paths of a path relative to the root of its filesystem. The mounts of
+// the other mount namespaces are unknown to the MountResolver of fsprobe, so the paths of their events aren't
+// prefixed with a mount point.
+func (mnr *MountNamespaceResolver) translate(mntNs uint32, pid uint32, mountID uint32, p string) (string, string) {
+	mount := mnr.getMount(mntNs, pid, mountID)
+	if mount == nil || !mount.Reaches(p) {
+		return "", ""
+	}
+	return mount.ResolvePath(p), mnr.hostPath(mount, p)
+}
+
+// hostPath - Returns the path of a file in the mount namespace of fsprobe. The files of an overlayfs are looked up in
+// its upper directory and then in its lower directories, the files of the other filesystems are resolved through a
+// mount point of their device.
+func (mnr *MountNamespaceResolver) hostPath(mount *MountInfo, p string) string {
+	if mount.FSType != "overlay" {
+		if mnr.host == nil {
+			return ""
+		}
+		return mnr.host.ResolveDevicePath(mount.Device, p)
+	}
+	upper, lowers := mount.OverlayDirs()
+	dirs := lowers
+	if upper != "" {
+		dirs = append([]string{upper}, lowers...)
+	}
+	for _, dir := range dirs {
+		candidate := path.Join(dir, p)
+		if _, err := os.Lstat(candidate); err == nil {
+			return candidate
+		}
+	}
+	if upper != "" {
+		// The file was removed from the container
+		return path.Join(upper, p)
+	}
+	return ""
+}
+
+// getMount - Returns the mount point of a mount namespace, nil if it couldn't be read from /proc
+func (mnr *MountNamespaceResolver) getMount(mntNs uint32, pid uint32, mountID uint32) *MountInfo {
+	mnr.lock.Lock()
+	defer mnr.lock.Unlock()
+	if value, ok := mnr.namespaces.Get(mntNs); ok {
+		ns := value.(*mountNamespace)
+		if mount, ok := ns.mounts[mountID]; ok || time.Since(ns.lastRefresh) < mountRefreshRate {
+			return mount
+		}
+	}
+	mounts, err := readMountInfoFile(fmt.Sprintf("/proc/%d/mountinfo", pid))
+	if err != nil {
+		// The process exited
+		return nil
+	}
+	if uint32(utils.GetMntnsFromPid(pid)) != mntNs {
+		// The process moved to another mount namespace, or its pid was reused
+		return nil
+	}
+	mnr.namespaces.Add(mntNs, &mountNamespace{
+		mounts:      mounts,
+		lastRefresh: time.Now(),
+	})
+	return mounts[mountID]
+}
@@ -0,0 +1,85 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"os"
+	"path"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOverlayDirs(t *testing.T) {
+	mount, err := parseMountInfo("1201 1100 0:52 / / rw,relatime - overlay overlay rw,lowerdir=l/ABC:l/DEF,upperdir=/var/lib/docker/overlay2/1a2b/diff,workdir=/var/lib/docker/overlay2/1a2b/work")
+	if err != nil {
+		t.Fatal(err)
+	}
+	upper, lowers := mount.OverlayDirs()
+	if upper != "/var/lib/docker/overlay2/1a2b/diff" {
+		t.Errorf("unexpected upper directory: %s", upper)
+	}
+	if expected := []string{"/var/lib/docker/overlay2/l/ABC", "/var/lib/docker/overlay2/l/DEF"}; !reflect.DeepEqual(lowers, expected) {
+		t.Errorf("unexpected lower directories: %v", lowers)
+	}
+}
+
+func TestMountNamespaceResolver(t *testing.T) {
+	dir := t.TempDir()
+	upper, lower := path.Join(dir, "diff"), path.Join(dir, "lower")
+	for _, p := range []string{path.Join(upper, "etc"), path.Join(lower, "usr/bin")} {
+		if err := os.MkdirAll(p, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, p := range []string{path.Join(upper, "etc/hosts"), path.Join(lower, "usr/bin/sh")} {
+		if err := os.WriteFile(p, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	host := NewStaticMountResolver([]*MountInfo{
+		{MountID: 29, Device: "8:1", Root: "/", MountPoint: "/"},
+	})
+	mnr, err := NewMountNamespaceResolver(host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mnr.namespaces.Add(uint32(42), &mountNamespace{
+		mounts: map[uint32]*MountInfo{
+			1201: {MountID: 1201, Device: "0:52", Root: "/", MountPoint: "/", FSType: "overlay",
+				SuperOptions: "rw,lowerdir=" + lower + ",upperdir=" + upper + ",workdir=" + path.Join(dir, "work")},
+			1210: {MountID: 1210, Device: "8:1", Root: "/var/lib/docker/volumes/data/_data", MountPoint: "/data", FSType: "ext4"},
+		},
+		lastRefresh: time.Now(),
+	})
+
+	evt := &FSEvent{MntNs: 42, EventType: Rename, SrcMountID: 1201, SrcFilename: "/usr/bin/sh",
+		TargetMountID: 1201, TargetFilename: "/etc/hosts"}
+	mnr.Resolve(evt)
+	if evt.SrcContainerPath != "/usr/bin/sh" || evt.SrcHostPath != path.Join(lower, "usr/bin/sh") {
+		t.Errorf("unexpected src paths: %s, %s", evt.SrcContainerPath, evt.SrcHostPath)
+	}
+	if evt.TargetContainerPath != "/etc/hosts" || evt.TargetHostPath != path.Join(upper, "etc/hosts") {
+		t.Errorf("unexpected target paths: %s, %s", evt.TargetContainerPath, evt.TargetHostPath)
+	}
+
+	// Volumes are resolved through a mount point of their device
+	evt = &FSEvent{MntNs: 42, EventType: Open, SrcMountID: 1210, SrcFilename: "/var/lib/docker/volumes/data/_data/db"}
+	mnr.Resolve(evt)
+	if evt.SrcContainerPath != "/data/db" || evt.SrcHostPath != "/var/lib/docker/volumes/data/_data/db" {
+		t.Errorf("unexpected volume paths: %s, %s", evt.SrcContainerPath, evt.SrcHostPath)
+	}
+}
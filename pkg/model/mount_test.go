@@ -0,0 +1,160 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGetSuperblockMounts(t *testing.T) {
+	mr := NewStaticMountResolver([]*MountInfo{
+		{MountID: 21, Device: "8:1", Root: "/", MountPoint: "/"},
+		{MountID: 34, Device: "8:1", Root: "/etc/hosts", MountPoint: "/srv/hosts"},
+		{MountID: 27, Device: "8:2", Root: "/", MountPoint: "/data"},
+		{MountID: 42, Device: "8:1", Root: "/home", MountPoint: "/home"},
+	})
+	tests := []struct {
+		mountID  uint32
+		expected []uint32
+	}{
+		{mountID: 21, expected: []uint32{21, 34, 42}},
+		{mountID: 42, expected: []uint32{21, 34, 42}},
+		{mountID: 27, expected: []uint32{27}},
+		{mountID: 99, expected: []uint32{99}},
+	}
+	for _, tt := range tests {
+		if ids := mr.GetSuperblockMounts(tt.mountID); !reflect.DeepEqual(ids, tt.expected) {
+			t.Errorf("mount %d: expected %v, got %v", tt.mountID, tt.expected, ids)
+		}
+	}
+}
+
+func TestParseMountInfo(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected *MountInfo
+	}{
+		{
+			line: "36 35 98:0 /mnt1 /mnt2 rw,noatime master:1 - ext3 /dev/root rw,errors=continue",
+			expected: &MountInfo{
+				MountID:      36,
+				ParentID:     35,
+				Device:       "98:0",
+				Root:         "/mnt1",
+				MountPoint:   "/mnt2",
+				FSType:       "ext3",
+				Source:       "/dev/root",
+				SuperOptions: "rw,errors=continue",
+			},
+		},
+		{
+			line: "22 1 8:1 / / rw,relatime shared:1 master:2 - ext4 /dev/sda1 rw",
+			expected: &MountInfo{
+				MountID:      22,
+				ParentID:     1,
+				Device:       "8:1",
+				Root:         "/",
+				MountPoint:   "/",
+				FSType:       "ext4",
+				Source:       "/dev/sda1",
+				SuperOptions: "rw",
+			},
+		},
+		{
+			line: `40 22 8:1 /my\040dir /mnt/my\040dir rw - ext4 /dev/sda1 rw`,
+			expected: &MountInfo{
+				MountID:      40,
+				ParentID:     22,
+				Device:       "8:1",
+				Root:         "/my dir",
+				MountPoint:   "/mnt/my dir",
+				FSType:       "ext4",
+				Source:       "/dev/sda1",
+				SuperOptions: "rw",
+			},
+		},
+		{line: "36 35 98:0 /mnt1 /mnt2 rw,noatime - ext3"},
+		{line: "36 35 98:0 /mnt1 /mnt2 rw,noatime master:1 shared:2 private ext3 /dev/root"},
+		{line: "abc 35 98:0 /mnt1 /mnt2 rw,noatime master:1 - ext3 /dev/root rw"},
+		{line: "36 -1 98:0 /mnt1 /mnt2 rw,noatime master:1 - ext3 /dev/root rw"},
+	}
+	for _, tt := range tests {
+		mount, err := parseMountInfo(tt.line)
+		if tt.expected == nil {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %+v", tt.line, mount)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.line, err)
+			continue
+		}
+		if !reflect.DeepEqual(mount, tt.expected) {
+			t.Errorf("%s: expected %+v, got %+v", tt.line, tt.expected, mount)
+		}
+	}
+}
+
+func TestReadMountInfo(t *testing.T) {
+	mounts, err := readMountInfo(strings.NewReader(`22 1 8:1 / / rw,relatime shared:1 - ext4 /dev/sda1 rw
+invalid line
+27 22 8:2 / /data rw,relatime shared:2 - ext4 /dev/sda2 rw
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mounts) != 2 || mounts[22] == nil || mounts[27] == nil {
+		t.Fatalf("unexpected mount points: %v", mounts)
+	}
+	if mounts[27].MountPoint != "/data" {
+		t.Errorf("unexpected mount point: %s", mounts[27].MountPoint)
+	}
+}
+
+func TestMountResolverStartStop(t *testing.T) {
+	mr, err := NewMountResolver()
+	if err != nil {
+		t.Skipf("couldn't read the mount points: %v", err)
+	}
+	wg := &sync.WaitGroup{}
+	if err := mr.Start(wg); err != nil {
+		t.Fatal(err)
+	}
+	// Let the watcher block in poll
+	time.Sleep(100 * time.Millisecond)
+	if err := mr.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	// Stopping twice is a no-op
+	if err := mr.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * mountPollTimeout * time.Millisecond):
+		t.Fatal("the mountinfo watcher didn't stop")
+	}
+}
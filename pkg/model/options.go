@@ -26,4 +26,44 @@ type FSProbeOptions struct {
 	FollowRenames        bool
 	EventChan            chan *FSEvent
 	LostChan             chan *LostEvt
+	// Backpressure - Behavior of EventChan when it is full. The events dropped in user space are reported on
+	// LostChan with the LostReasonUserSpace reason.
+	Backpressure BackpressurePolicy
+	// SpillDir - Directory of the on-disk queues of the BackpressureSpill policy. Defaults to the temporary directory.
+	SpillDir string
+	// SpillMaxSize - Maximum size in bytes of an on-disk queue of the BackpressureSpill policy
+	SpillMaxSize int64
+	// AllowProcesses - When set, only the events of the matching processes are sent to user space. Each type of
+	// process attribute is checked independently: an event is dropped as soon as one of the non-empty allow lists
+	// doesn't match.
+	AllowProcesses ProcessFilter
+	// DenyProcesses - The events of the matching processes are dropped in kernel space
+	DenyProcesses ProcessFilter
+	// FollowForks - When set, the processes forked by a process matching a pid of AllowProcesses or DenyProcesses
+	// inherit its filter, along with their own descendants. The inherited filters are removed in kernel space when
+	// the processes exit.
+	FollowForks bool
+	// TrackExecs - When set, the kernel sends the executable and the arguments of the processes when they call execve,
+	// so that the context of the short lived processes is resolved even if they exit before /proc/<pid> is read.
+	// Otherwise, the process cache only reads /proc/<pid> when the first event of a process is received.
+	TrackExecs bool
+	// ContainerPaths - When set, the paths of the events of the processes of other mount namespaces are also
+	// translated into their path in the mount namespace of the process and into their path on the host (see
+	// MountNamespaceResolver)
+	ContainerPaths bool
+	// ExcludePaths - Paths that shouldn't be watched, along with their subtrees
+	ExcludePaths []string
+	// ExcludePatterns - Glob patterns of the paths that shouldn't be watched, along with their subtrees. Patterns
+	// without a "/" are matched against the base name of the paths (".git", "node_modules", "*.cache").
+	ExcludePatterns []string
+	// RuntimeCompilation - When set, the eBPF programs are compiled at runtime against the headers of the running
+	// kernel. The embedded programs are used if the compilation fails.
+	RuntimeCompilation bool
+	// RuntimeCompilationCacheDir - Directory used to cache the eBPF programs compiled at runtime
+	RuntimeCompilationCacheDir string
+	// KernelHeadersPath - Path to the headers of the running kernel. Defaults to /lib/modules/$(uname -r)/build.
+	KernelHeadersPath string
+	// Recorder - When set, the raw samples received from the kernel are recorded along with what is needed to replay
+	// them without eBPF (see CaptureWriter and FSProbe.Replay)
+	Recorder Recorder
 }
@@ -22,9 +22,21 @@ import (
 	"github.com/pkg/errors"
 )
 
+// LostReason - Reason why events were lost
+type LostReason string
+
+const (
+	// LostReasonPerfBuffer - The events were lost in kernel space because a perf ring buffer was full
+	LostReasonPerfBuffer LostReason = "perf_buffer"
+	// LostReasonUserSpace - The events were dropped in user space by the backpressure policy of the event channel
+	LostReasonUserSpace LostReason = "user_space"
+)
+
+// LostEvt - Notification of lost events
 type LostEvt struct {
-	Count uint64
-	Map   string
+	Count  uint64
+	Map    string
+	Reason LostReason
 }
 
 // PerfMap - Definition of a perf map, used to bring data back to user space
@@ -79,13 +91,13 @@ func (pm *PerfMap) pollStart() error {
 	if err != nil {
 		return errors.Wrapf(err, "couldn't start map %s", pm.PerfOutputMapName)
 	}
+	pm.monitor.wg.Add(1)
 	go pm.listen()
 	return nil
 }
 
 // listen - Listen for new events from the kernel
 func (pm *PerfMap) listen() {
-	pm.monitor.wg.Add(1)
 	var sample *ebpf.PerfSample
 	var ok bool
 	var lostCount uint64
@@ -99,12 +111,18 @@ func (pm *PerfMap) listen() {
 				pm.monitor.wg.Done()
 				return
 			}
+			if recorder := pm.monitor.Options.Recorder; recorder != nil {
+				recorder.RecordSample(pm.PerfOutputMapName, sample.Data)
+			}
 			pm.DataHandler(sample.Data, pm.monitor)
 		case lostCount, ok = <-pm.perfReader.LostRecords:
 			if !ok {
 				pm.monitor.wg.Done()
 				return
 			}
+			if recorder := pm.monitor.Options.Recorder; recorder != nil {
+				recorder.RecordLost(pm.PerfOutputMapName, lostCount)
+			}
 			if pm.LostHandler != nil {
 				pm.LostHandler(lostCount, pm.PerfOutputMapName, pm.monitor)
 			}
@@ -114,7 +132,12 @@ func (pm *PerfMap) listen() {
 
 // pollStop - Stop a perf map listener
 func (m *PerfMap) pollStop() error {
+	if m.perfReader == nil {
+		// The perf map wasn't started
+		return nil
+	}
 	err := m.perfReader.FlushAndClose()
 	close(m.stop)
+	m.perfReader = nil
 	return err
 }
@@ -0,0 +1,345 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"bytes"
+	"math"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+
+	"github.com/Gui774ume/fsprobe/pkg/utils"
+)
+
+// ProcessExecProbes - Name of the probes populating the process cache. It isn't an event, the probes are always
+// activated.
+const ProcessExecProbes EventName = "process_exec"
+
+const (
+	// LoginUIDUnset - Login UID of the processes that don't belong to a login session
+	LoginUIDUnset uint32 = math.MaxUint32
+	// ProcessCacheSize - Maximum number of processes in the process cache
+	ProcessCacheSize = 8192
+	// processRefreshRate - Minimum delay between two refreshes of a process from /proc
+	processRefreshRate = time.Second
+	// cgroupCacheSize - Maximum number of cgroups in the cgroup cache of the process cache
+	cgroupCacheSize = 1024
+	// threadCacheSize - Maximum number of threads in the thread cache of the process cache
+	threadCacheSize = 8192
+)
+
+// ExecEvent - Process execution event sent by the kernel, see struct exec_event_t in ebpf/structs.h
+type ExecEvent struct {
+	Pid      uint32
+	Tid      uint32
+	Ppid     uint32
+	UID      uint32
+	GID      uint32
+	Comm     string
+	MntNs    uint32
+	PidNs    uint32
+	CgroupID uint64
+	Filename string
+	Argv     []string
+	// ArgvTruncated - Set when the arguments didn't fit in the event
+	ArgvTruncated bool
+}
+
+// UnmarshalBinary - Parses an exec event
+func (e *ExecEvent) UnmarshalBinary(data []byte) (int, error) {
+	if len(data) < 72+ExecFilenameSize+ExecArgsSize {
+		return 0, errors.Errorf("not enough data: %d", len(data))
+	}
+	// Process context data
+	e.Pid = utils.ByteOrder.Uint32(data[8:12])
+	e.Tid = utils.ByteOrder.Uint32(data[12:16])
+	e.UID = utils.ByteOrder.Uint32(data[16:20])
+	e.GID = utils.ByteOrder.Uint32(data[20:24])
+	e.Comm = string(bytes.Trim(data[24:40], "\x00"))
+	e.Ppid = utils.ByteOrder.Uint32(data[40:44])
+	e.MntNs = utils.ByteOrder.Uint32(data[44:48])
+	e.PidNs = utils.ByteOrder.Uint32(data[48:52])
+	e.CgroupID = utils.ByteOrder.Uint64(data[56:64])
+	// Exec event data
+	filenameLength := utils.ByteOrder.Uint32(data[64:68])
+	argsLength := utils.ByteOrder.Uint32(data[68:72])
+	if filenameLength > ExecFilenameSize || argsLength >= ExecArgsSize {
+		return 0, errors.Errorf("invalid exec event lengths: %d, %d", filenameLength, argsLength)
+	}
+	filename := data[72 : 72+filenameLength]
+	e.Filename = string(bytes.TrimRight(filename, "\x00"))
+	args := data[72+ExecFilenameSize : 72+ExecFilenameSize+argsLength]
+	e.Argv = utils.SplitArgs(args)
+	// The kernel copies at most ExecArgsSize - 1 bytes, the last argument is NULL terminated otherwise
+	e.ArgvTruncated = argsLength == ExecArgsSize-1 && args[argsLength-1] != 0
+	return 72 + ExecFilenameSize + ExecArgsSize, nil
+}
+
+// ProcessCacheEntry - Context of a process, resolved from its exec event and from /proc/<pid>. Entries are never
+// modified once they are added to the cache.
+type ProcessCacheEntry struct {
+	Pid      uint32
+	Ppid     uint32
+	Exe      string
+	Argv     []string
+	Cwd      string
+	LoginUID uint32
+	// CgroupPath - Path of the cgroup of the process, in the unified hierarchy when it is mounted
+	CgroupPath  string
+	ContainerID string
+	// NsPid - PID of the process in its own pid namespace
+	NsPid uint32
+	// unified - Set when CgroupPath is the path of the cgroup v2 of the process
+	unified bool
+	// refreshed - Last time the entry was refreshed from /proc, or the time of the last attempt if the process exited
+	refreshed time.Time
+}
+
+// ProcessCache - Resolves the context of the processes of the events. The entries are added when a process calls
+// execve, or when the first event of a process is received, and they are refreshed from /proc/<pid> at most once per
+// second. The entries of the processes that exited are kept until they are evicted, so that the events that were
+// still queued when a process exited can be resolved.
+type ProcessCache struct {
+	lock    sync.Mutex
+	entries *lru.Cache
+	// cgroups - Cgroup v2 IDs resolved to their cgroupCacheEntry, used for the processes that exited before they
+	// could be read from /proc
+	cgroups *lru.Cache
+	// threads - TIDs resolved to their TID in the pid namespace of their process
+	threads *lru.Cache
+	// static - Set when the entries only come from exec events and /proc shouldn't be read (replays)
+	static bool
+}
+
+// NewProcessCache - Returns a new ProcessCache instance
+func NewProcessCache() (*ProcessCache, error) {
+	entries, err := lru.New(ProcessCacheSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't create process cache")
+	}
+	cgroups, err := lru.New(cgroupCacheSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't create cgroup cache")
+	}
+	threads, err := lru.New(threadCacheSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't create thread cache")
+	}
+	return &ProcessCache{
+		entries: entries,
+		cgroups: cgroups,
+		threads: threads,
+	}, nil
+}
+
+// cgroupCacheEntry - Cgroup of a cgroup v2 ID
+type cgroupCacheEntry struct {
+	path        string
+	containerID string
+}
+
+// NewStaticProcessCache - Returns a ProcessCache instance that doesn't read /proc. The processes are only resolved
+// from their exec events.
+func NewStaticProcessCache() (*ProcessCache, error) {
+	pc, err := NewProcessCache()
+	if err != nil {
+		return nil, err
+	}
+	pc.static = true
+	return pc, nil
+}
+
+// AddExec - Replaces the entry of a process that called execve. The current working directory, the login UID and the
+// cgroup don't change on execve, they are copied from the previous entry of the process or from the entry of its
+// parent.
+func (pc *ProcessCache) AddExec(evt *ExecEvent) {
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+	entry := &ProcessCacheEntry{
+		Pid:      evt.Pid,
+		Ppid:     evt.Ppid,
+		Exe:      evt.Filename,
+		Argv:     evt.Argv,
+		LoginUID: LoginUIDUnset,
+	}
+	previous := pc.get(evt.Pid)
+	if previous != nil {
+		entry.NsPid = previous.NsPid
+	} else {
+		previous = pc.get(evt.Ppid)
+	}
+	if previous != nil {
+		entry.Cwd = previous.Cwd
+		entry.LoginUID = previous.LoginUID
+		entry.copyCgroup(previous)
+	}
+	if !pc.static {
+		// The arguments are replaced by the complete ones if they were truncated and the process is still alive
+		entry = pc.refresh(entry)
+	}
+	pc.entries.Add(evt.Pid, entry)
+}
+
+// Get - Returns the context of a process. An unknown process is resolved from /proc/<pid>, or from the entry of its
+// parent when it already exited (the forked processes share the context of their parent until they call execve).
+func (pc *ProcessCache) Get(pid uint32, ppid uint32) *ProcessCacheEntry {
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+	entry := pc.get(pid)
+	if entry == nil {
+		entry = &ProcessCacheEntry{
+			Pid:      pid,
+			Ppid:     ppid,
+			LoginUID: LoginUIDUnset,
+		}
+		if parent := pc.get(ppid); parent != nil && ppid != 0 {
+			entry.Exe = parent.Exe
+			entry.Argv = parent.Argv
+			entry.Cwd = parent.Cwd
+			entry.LoginUID = parent.LoginUID
+			entry.copyCgroup(parent)
+		}
+	} else if pc.static || time.Since(entry.refreshed) < processRefreshRate {
+		return entry
+	}
+	if !pc.static {
+		entry = pc.refresh(entry)
+	}
+	pc.entries.Add(pid, entry)
+	return entry
+}
+
+// Resolve - Adds the context of its process to an event. The cgroup of the event is resolved from its cgroup v2 ID
+// when the process couldn't be read from /proc.
+func (pc *ProcessCache) Resolve(evt *FSEvent) {
+	entry := pc.Get(evt.Pid, evt.Ppid)
+	if evt.Ppid == 0 {
+		// Some kernels don't report the parent of the current task
+		evt.Ppid = entry.Ppid
+	}
+	evt.Exe = entry.Exe
+	evt.Argv = entry.Argv
+	evt.Cwd = entry.Cwd
+	evt.LoginUID = entry.LoginUID
+	evt.CgroupPath = entry.CgroupPath
+	evt.ContainerID = entry.ContainerID
+	evt.NsPid = entry.NsPid
+	if evt.CgroupID != 0 {
+		pc.resolveCgroup(evt, entry)
+	}
+	if evt.NsPid != 0 {
+		evt.NsTid = pc.getNsTid(evt.Pid, evt.Tid, evt.NsPid)
+	}
+}
+
+// resolveCgroup - Records the cgroup v2 ID of the event, or resolves the cgroup of the event from its ID when its
+// process didn't have one. With cgroup v1, the ID of the cgroup v2 is the same for all the processes and isn't
+// recorded.
+func (pc *ProcessCache) resolveCgroup(evt *FSEvent, entry *ProcessCacheEntry) {
+	if entry.unified {
+		if !pc.cgroups.Contains(evt.CgroupID) {
+			pc.cgroups.Add(evt.CgroupID, &cgroupCacheEntry{
+				path:        entry.CgroupPath,
+				containerID: entry.ContainerID,
+			})
+		}
+		return
+	}
+	if entry.CgroupPath != "" {
+		return
+	}
+	if value, ok := pc.cgroups.Get(evt.CgroupID); ok {
+		cgroup := value.(*cgroupCacheEntry)
+		evt.CgroupPath = cgroup.path
+		evt.ContainerID = cgroup.containerID
+	}
+}
+
+// getNsTid - Returns the TID of a thread in the pid namespace of its process, 0 if the thread exited before it could
+// be read from /proc
+func (pc *ProcessCache) getNsTid(pid uint32, tid uint32, nsPid uint32) uint32 {
+	if tid == pid {
+		return nsPid
+	}
+	if nsPid == pid {
+		// The process is in the pid namespace of fsprobe
+		return tid
+	}
+	if value, ok := pc.threads.Get(tid); ok {
+		return value.(uint32)
+	}
+	if pc.static {
+		return 0
+	}
+	nsTid := utils.GetNsTidFromTid(pid, tid)
+	if nsTid != 0 {
+		pc.threads.Add(tid, nsTid)
+	}
+	return nsTid
+}
+
+// get - Returns the entry of a process, nil if the process is unknown. The caller must hold the lock.
+func (pc *ProcessCache) get(pid uint32) *ProcessCacheEntry {
+	value, ok := pc.entries.Get(pid)
+	if !ok {
+		return nil
+	}
+	return value.(*ProcessCacheEntry)
+}
+
+// refresh - Returns a copy of the provided entry refreshed from /proc/<pid>. The entry is returned unchanged when the
+// process exited.
+func (pc *ProcessCache) refresh(entry *ProcessCacheEntry) *ProcessCacheEntry {
+	refreshed := *entry
+	refreshed.refreshed = time.Now()
+	loginUID, err := utils.GetLoginUIDFromPid(entry.Pid)
+	if err != nil {
+		// The process exited
+		return &refreshed
+	}
+	refreshed.LoginUID = loginUID
+	if ppid := utils.GetPpid(entry.Pid); ppid != 0 {
+		refreshed.Ppid = ppid
+	}
+	if exe := utils.GetExeFromPid(entry.Pid); exe != "" {
+		refreshed.Exe = exe
+	}
+	if argv := utils.GetArgvFromPid(entry.Pid); len(argv) > 0 {
+		refreshed.Argv = argv
+	}
+	if cwd := utils.GetCwdFromPid(entry.Pid); cwd != "" {
+		refreshed.Cwd = cwd
+	}
+	if path, unified := utils.GetCgroupPathFromPid(entry.Pid); path != "" {
+		refreshed.CgroupPath = path
+		refreshed.ContainerID = ParseContainerID(path)
+		refreshed.unified = unified
+	}
+	if nsPid := utils.GetNsPidFromPid(entry.Pid); nsPid != 0 {
+		refreshed.NsPid = nsPid
+	}
+	return &refreshed
+}
+
+// copyCgroup - Copies the cgroup of the provided entry
+func (pce *ProcessCacheEntry) copyCgroup(from *ProcessCacheEntry) {
+	pce.CgroupPath = from.CgroupPath
+	pce.ContainerID = from.ContainerID
+	pce.unified = from.unified
+}
@@ -0,0 +1,304 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/Gui774ume/fsprobe/pkg/utils"
+)
+
+// TaskCommLen - Size of the comm field of the kernel task_struct (TASK_COMM_LEN)
+const TaskCommLen = 16
+
+// ProcessFilter - Process attributes used to allow or deny events in kernel space
+type ProcessFilter struct {
+	// Pids - Process IDs (tgid) or thread IDs
+	Pids []uint32
+	// Comms - Process names. Names are truncated to 15 characters by the kernel.
+	Comms []string
+	// UIDs - User IDs
+	UIDs []uint32
+	// GIDs - Group IDs
+	GIDs []uint32
+	// CgroupIDs - Cgroup v2 IDs, i.e. the inode numbers of the cgroup directories
+	CgroupIDs []uint64
+}
+
+// IsEmpty - Returns true if the filter doesn't contain any process attribute
+func (pf ProcessFilter) IsEmpty() bool {
+	return len(pf.Pids) == 0 && len(pf.Comms) == 0 && len(pf.UIDs) == 0 && len(pf.GIDs) == 0 && len(pf.CgroupIDs) == 0
+}
+
+// Merge - Appends the process attributes of the provided filter
+func (pf *ProcessFilter) Merge(other ProcessFilter) {
+	pf.Pids = append(pf.Pids, other.Pids...)
+	pf.Comms = append(pf.Comms, other.Comms...)
+	pf.UIDs = append(pf.UIDs, other.UIDs...)
+	pf.GIDs = append(pf.GIDs, other.GIDs...)
+	pf.CgroupIDs = append(pf.CgroupIDs, other.CgroupIDs...)
+}
+
+// Without - Returns a copy of the filter without the process attributes of the provided filter
+func (pf ProcessFilter) Without(other ProcessFilter) ProcessFilter {
+	var out ProcessFilter
+	for _, pid := range pf.Pids {
+		if !containsUint32(other.Pids, pid) {
+			out.Pids = append(out.Pids, pid)
+		}
+	}
+	for _, comm := range pf.Comms {
+		if !containsString(other.Comms, comm) {
+			out.Comms = append(out.Comms, comm)
+		}
+	}
+	for _, uid := range pf.UIDs {
+		if !containsUint32(other.UIDs, uid) {
+			out.UIDs = append(out.UIDs, uid)
+		}
+	}
+	for _, gid := range pf.GIDs {
+		if !containsUint32(other.GIDs, gid) {
+			out.GIDs = append(out.GIDs, gid)
+		}
+	}
+	for _, id := range pf.CgroupIDs {
+		if !containsUint64(other.CgroupIDs, id) {
+			out.CgroupIDs = append(out.CgroupIDs, id)
+		}
+	}
+	return out
+}
+
+func containsUint32(list []uint32, value uint32) bool {
+	for _, elem := range list {
+		if elem == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsUint64(list []uint64, value uint64) bool {
+	for _, elem := range list {
+		if elem == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, value string) bool {
+	for _, elem := range list {
+		if elem == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ProcessFilterAction - Action applied to the events of the processes matching a process filter
+type ProcessFilterAction uint8
+
+const (
+	// ProcessFilterAllow - Only the events of the allowed processes are sent to user space
+	ProcessFilterAllow ProcessFilterAction = 1
+	// ProcessFilterDeny - The events of the denied processes are dropped in kernel space
+	ProcessFilterDeny ProcessFilterAction = 2
+)
+
+func (pfa ProcessFilterAction) String() string {
+	switch pfa {
+	case ProcessFilterAllow:
+		return "allow"
+	case ProcessFilterDeny:
+		return "deny"
+	default:
+		return fmt.Sprintf("ProcessFilterAction(%d)", pfa)
+	}
+}
+
+// ProcessTreeProbes - Name of the probes tracking the process tree when FollowForks is set. It isn't an event.
+const ProcessTreeProbes EventName = "process_tree"
+
+// ProcessFilterType - Type of process filter, see enum process_filter_type in ebpf/structs.h
+type ProcessFilterType uint32
+
+const (
+	// ProcessFilterPid - Pid & tid filter
+	ProcessFilterPid ProcessFilterType = iota
+	// ProcessFilterComm - Process name filter
+	ProcessFilterComm
+	// ProcessFilterUID - UID filter
+	ProcessFilterUID
+	// ProcessFilterGID - GID filter
+	ProcessFilterGID
+	// ProcessFilterCgroup - Cgroup ID filter
+	ProcessFilterCgroup
+)
+
+// Section - Returns the section of the map holding this type of filter
+func (pft ProcessFilterType) Section() string {
+	switch pft {
+	case ProcessFilterPid:
+		return PidFilterMap
+	case ProcessFilterComm:
+		return CommFilterMap
+	case ProcessFilterUID:
+		return UIDFilterMap
+	case ProcessFilterGID:
+		return GIDFilterMap
+	default:
+		return CgroupFilterMap
+	}
+}
+
+// processFilterKey - In-kernel process filter entry
+type processFilterKey struct {
+	filterType ProcessFilterType
+	key        string
+}
+
+// processFilters - State of the in-kernel process filters. The state is needed to maintain the number of allow
+// entries of each filter type.
+type processFilters struct {
+	sync.Mutex
+	actions     map[processFilterKey]ProcessFilterAction
+	allowCounts map[ProcessFilterType]uint32
+}
+
+// set - Records the action of an in-kernel filter entry and updates the number of allow entries of its type
+func (pf *processFilters) set(key processFilterKey, action ProcessFilterAction) {
+	if pf.actions == nil {
+		pf.actions = make(map[processFilterKey]ProcessFilterAction)
+		pf.allowCounts = make(map[ProcessFilterType]uint32)
+	}
+	if previous, ok := pf.actions[key]; ok && previous == ProcessFilterAllow {
+		pf.allowCounts[key.filterType]--
+	}
+	if action == ProcessFilterAllow {
+		pf.allowCounts[key.filterType]++
+	}
+	pf.actions[key] = action
+}
+
+// remove - Forgets an in-kernel filter entry and updates the number of allow entries of its type
+func (pf *processFilters) remove(key processFilterKey) {
+	previous, ok := pf.actions[key]
+	if !ok {
+		return
+	}
+	if previous == ProcessFilterAllow {
+		pf.allowCounts[key.filterType]--
+	}
+	delete(pf.actions, key)
+}
+
+// processFilterKeys - Returns the in-kernel keys of the provided filter
+func processFilterKeys(filter ProcessFilter) []processFilterKey {
+	var keys []processFilterKey
+	for _, pid := range filter.Pids {
+		keys = append(keys, processFilterKey{filterType: ProcessFilterPid, key: string(uint32Bytes(pid))})
+	}
+	for _, comm := range filter.Comms {
+		keys = append(keys, processFilterKey{filterType: ProcessFilterComm, key: string(commBytes(comm))})
+	}
+	for _, uid := range filter.UIDs {
+		keys = append(keys, processFilterKey{filterType: ProcessFilterUID, key: string(uint32Bytes(uid))})
+	}
+	for _, gid := range filter.GIDs {
+		keys = append(keys, processFilterKey{filterType: ProcessFilterGID, key: string(uint32Bytes(gid))})
+	}
+	for _, id := range filter.CgroupIDs {
+		keyB := make([]byte, 8)
+		utils.ByteOrder.PutUint64(keyB, id)
+		keys = append(keys, processFilterKey{filterType: ProcessFilterCgroup, key: string(keyB)})
+	}
+	return keys
+}
+
+func uint32Bytes(value uint32) []byte {
+	keyB := make([]byte, 4)
+	utils.ByteOrder.PutUint32(keyB, value)
+	return keyB
+}
+
+// commBytes - Returns the comm_key_t representation of a process name
+func commBytes(comm string) []byte {
+	keyB := make([]byte, TaskCommLen)
+	// The last byte is always the NULL character
+	copy(keyB[:TaskCommLen-1], comm)
+	return keyB
+}
+
+// AddProcessFilter - Pushes the provided process filter in kernel space
+func (m *Monitor) AddProcessFilter(action ProcessFilterAction, filter ProcessFilter) error {
+	m.processFilters.Lock()
+	defer m.processFilters.Unlock()
+	updated := make(map[ProcessFilterType]bool)
+	for _, key := range processFilterKeys(filter) {
+		filterMap := m.GetMap(key.filterType.Section())
+		if filterMap == nil {
+			return fmt.Errorf("couldn't find %v map", key.filterType.Section())
+		}
+		if err := filterMap.Put([]byte(key.key), uint8(action)); err != nil {
+			return err
+		}
+		m.processFilters.set(key, action)
+		updated[key.filterType] = true
+	}
+	return m.pushAllowCounts(updated)
+}
+
+// RemoveProcessFilter - Removes the provided process attributes from the in-kernel allow and deny lists
+func (m *Monitor) RemoveProcessFilter(filter ProcessFilter) error {
+	m.processFilters.Lock()
+	defer m.processFilters.Unlock()
+	updated := make(map[ProcessFilterType]bool)
+	for _, key := range processFilterKeys(filter) {
+		if _, ok := m.processFilters.actions[key]; !ok {
+			continue
+		}
+		filterMap := m.GetMap(key.filterType.Section())
+		if filterMap == nil {
+			return fmt.Errorf("couldn't find %v map", key.filterType.Section())
+		}
+		if err := filterMap.Delete([]byte(key.key)); err != nil {
+			return err
+		}
+		m.processFilters.remove(key)
+		updated[key.filterType] = true
+	}
+	return m.pushAllowCounts(updated)
+}
+
+// pushAllowCounts - Updates the number of allow entries of the provided filter types in kernel space
+func (m *Monitor) pushAllowCounts(filterTypes map[ProcessFilterType]bool) error {
+	if len(filterTypes) == 0 {
+		return nil
+	}
+	countMap := m.GetMap(ProcessFiltersAllowCountMap)
+	if countMap == nil {
+		return fmt.Errorf("couldn't find %v map", ProcessFiltersAllowCountMap)
+	}
+	for filterType := range filterTypes {
+		if err := countMap.Put(uint32(filterType), m.processFilters.allowCounts[filterType]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProcessFilterMerge(t *testing.T) {
+	filter := ProcessFilter{
+		Pids:  []uint32{1},
+		Comms: []string{"sshd"},
+	}
+	filter.Merge(ProcessFilter{
+		Pids:      []uint32{2, 3},
+		UIDs:      []uint32{0},
+		GIDs:      []uint32{100},
+		CgroupIDs: []uint64{4026531835},
+	})
+	filter.Merge(ProcessFilter{})
+	expected := ProcessFilter{
+		Pids:      []uint32{1, 2, 3},
+		Comms:     []string{"sshd"},
+		UIDs:      []uint32{0},
+		GIDs:      []uint32{100},
+		CgroupIDs: []uint64{4026531835},
+	}
+	if !reflect.DeepEqual(filter, expected) {
+		t.Errorf("expected %+v, got %+v", expected, filter)
+	}
+	if filter.IsEmpty() || !(ProcessFilter{}).IsEmpty() {
+		t.Error("unexpected IsEmpty result")
+	}
+}
+
+func TestProcessFilterWithout(t *testing.T) {
+	filter := ProcessFilter{
+		Pids:      []uint32{1, 2, 3},
+		Comms:     []string{"sshd", "cron"},
+		UIDs:      []uint32{0, 1000},
+		GIDs:      []uint32{100},
+		CgroupIDs: []uint64{10, 20},
+	}
+	out := filter.Without(ProcessFilter{
+		Pids:      []uint32{2, 4},
+		Comms:     []string{"cron"},
+		UIDs:      []uint32{1000},
+		GIDs:      []uint32{100},
+		CgroupIDs: []uint64{30},
+	})
+	expected := ProcessFilter{
+		Pids:      []uint32{1, 3},
+		Comms:     []string{"sshd"},
+		UIDs:      []uint32{0},
+		CgroupIDs: []uint64{10, 20},
+	}
+	if !reflect.DeepEqual(out, expected) {
+		t.Errorf("expected %+v, got %+v", expected, out)
+	}
+	// The original filter is left untouched
+	if len(filter.Pids) != 3 || len(filter.GIDs) != 1 {
+		t.Errorf("Without modified the filter: %+v", filter)
+	}
+	if !filter.Without(filter).IsEmpty() {
+		t.Error("a filter without itself should be empty")
+	}
+}
+
+func TestProcessFilterKeys(t *testing.T) {
+	keys := processFilterKeys(ProcessFilter{
+		Pids:      []uint32{1},
+		Comms:     []string{"a-very-long-process-name"},
+		CgroupIDs: []uint64{42},
+	})
+	if len(keys) != 3 {
+		t.Fatalf("expected 3 keys, got %d", len(keys))
+	}
+	if keys[0].filterType != ProcessFilterPid || len(keys[0].key) != 4 {
+		t.Errorf("unexpected pid key: %+v", keys[0])
+	}
+	// Process names are truncated to 15 characters and NULL terminated, like the kernel does
+	if keys[1].filterType != ProcessFilterComm || keys[1].key != "a-very-long-pro\x00" {
+		t.Errorf("unexpected comm key: %q", keys[1].key)
+	}
+	if keys[2].filterType != ProcessFilterCgroup || len(keys[2].key) != 8 {
+		t.Errorf("unexpected cgroup key: %+v", keys[2])
+	}
+}
+
+func TestProcessFiltersAllowCounts(t *testing.T) {
+	var pf processFilters
+	pid1 := processFilterKeys(ProcessFilter{Pids: []uint32{1}})[0]
+	pid2 := processFilterKeys(ProcessFilter{Pids: []uint32{2}})[0]
+	uid := processFilterKeys(ProcessFilter{UIDs: []uint32{0}})[0]
+
+	steps := []struct {
+		name     string
+		apply    func()
+		expected map[ProcessFilterType]uint32
+	}{
+		{
+			name:     "allow pid 1",
+			apply:    func() { pf.set(pid1, ProcessFilterAllow) },
+			expected: map[ProcessFilterType]uint32{ProcessFilterPid: 1},
+		},
+		{
+			name:     "allow pid 1 twice",
+			apply:    func() { pf.set(pid1, ProcessFilterAllow) },
+			expected: map[ProcessFilterType]uint32{ProcessFilterPid: 1},
+		},
+		{
+			name:     "allow pid 2",
+			apply:    func() { pf.set(pid2, ProcessFilterAllow) },
+			expected: map[ProcessFilterType]uint32{ProcessFilterPid: 2},
+		},
+		{
+			name:     "deny uid 0",
+			apply:    func() { pf.set(uid, ProcessFilterDeny) },
+			expected: map[ProcessFilterType]uint32{ProcessFilterPid: 2},
+		},
+		{
+			name:     "deny pid 1",
+			apply:    func() { pf.set(pid1, ProcessFilterDeny) },
+			expected: map[ProcessFilterType]uint32{ProcessFilterPid: 1},
+		},
+		{
+			name:     "allow uid 0",
+			apply:    func() { pf.set(uid, ProcessFilterAllow) },
+			expected: map[ProcessFilterType]uint32{ProcessFilterPid: 1, ProcessFilterUID: 1},
+		},
+		{
+			name:     "remove pid 2",
+			apply:    func() { pf.remove(pid2) },
+			expected: map[ProcessFilterType]uint32{ProcessFilterPid: 0, ProcessFilterUID: 1},
+		},
+		{
+			name:     "remove pid 2 twice",
+			apply:    func() { pf.remove(pid2) },
+			expected: map[ProcessFilterType]uint32{ProcessFilterPid: 0, ProcessFilterUID: 1},
+		},
+		{
+			name:     "remove denied pid 1",
+			apply:    func() { pf.remove(pid1) },
+			expected: map[ProcessFilterType]uint32{ProcessFilterPid: 0, ProcessFilterUID: 1},
+		},
+		{
+			name:     "remove uid 0",
+			apply:    func() { pf.remove(uid) },
+			expected: map[ProcessFilterType]uint32{ProcessFilterPid: 0, ProcessFilterUID: 0},
+		},
+	}
+	for _, step := range steps {
+		step.apply()
+		if !reflect.DeepEqual(pf.allowCounts, step.expected) {
+			t.Errorf("%s: expected allow counts %v, got %v", step.name, step.expected, pf.allowCounts)
+		}
+	}
+	if len(pf.actions) != 0 {
+		t.Errorf("expected no filter entry, got %v", pf.actions)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestProcessCacheProc(t *testing.T) {
+	pc, err := NewProcessCache()
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry := pc.Get(uint32(os.Getpid()), 0)
+	exe, _ := os.Executable()
+	cwd, _ := os.Getwd()
+	if entry.Exe != exe || entry.Cwd != cwd || !reflect.DeepEqual(entry.Argv, os.Args) {
+		t.Errorf("unexpected entry: %+v", entry)
+	}
+	if entry.Ppid != uint32(os.Getppid()) {
+		t.Errorf("expected ppid %d, got %d", os.Getppid(), entry.Ppid)
+	}
+	if entry.CgroupPath == "" || entry.NsPid == 0 {
+		t.Errorf("expected cgroup and namespace PID to be resolved: %+v", entry)
+	}
+}
+
+func TestProcessCacheExitedProcess(t *testing.T) {
+	pc, err := NewStaticProcessCache()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pc.AddExec(&ExecEvent{Pid: 100, Ppid: 1, Filename: "/bin/sh", Argv: []string{"sh", "-c", "touch a"}})
+	// The forked process exited before its first event was handled, it inherits the context of its parent
+	evt := &FSEvent{Pid: 101, Ppid: 100}
+	pc.Resolve(evt)
+	if evt.Exe != "/bin/sh" || !reflect.DeepEqual(evt.Argv, []string{"sh", "-c", "touch a"}) || evt.LoginUID != LoginUIDUnset {
+		t.Errorf("unexpected process context: %+v", evt)
+	}
+	// The exec event of the forked process replaces its entry
+	pc.AddExec(&ExecEvent{Pid: 101, Ppid: 100, Filename: "/usr/bin/touch", Argv: []string{"touch", "a"}})
+	if entry := pc.Get(101, 0); entry.Exe != "/usr/bin/touch" || entry.Ppid != 100 {
+		t.Errorf("unexpected entry: %+v", entry)
+	}
+}
+
+func TestProcessCacheCgroupID(t *testing.T) {
+	pc, err := NewStaticProcessCache()
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := "3f4c4a5b0d1e2f30415263748596a7b8c9dae0f1021324354657687980a1b2c3"
+	pc.entries.Add(uint32(100), &ProcessCacheEntry{
+		Pid:         100,
+		CgroupPath:  "/system.slice/docker-" + id + ".scope",
+		ContainerID: id,
+		NsPid:       1,
+		unified:     true,
+	})
+	evt := &FSEvent{Pid: 100, Tid: 100, CgroupID: 42}
+	pc.Resolve(evt)
+	if evt.ContainerID != id || evt.NsPid != 1 || evt.NsTid != 1 {
+		t.Errorf("unexpected container context: %+v", evt)
+	}
+	// A process of the same cgroup that exited before it could be read from /proc
+	evt = &FSEvent{Pid: 101, Tid: 101, CgroupID: 42}
+	pc.Resolve(evt)
+	if evt.ContainerID != id || evt.CgroupPath != "/system.slice/docker-"+id+".scope" {
+		t.Errorf("expected the cgroup to be resolved from its ID: %+v", evt)
+	}
+}
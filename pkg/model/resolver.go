@@ -33,54 +33,63 @@ import (
 type DentryResolver interface {
 	ResolveInode(mountID uint32, inode uint64) (string, error)
 	RemoveInode(mountID uint32, inode uint64) error
-	ResolveKey(key uint32, length uint32) (string, error)
-	RemoveEntry(key uint32) error
-	AddCacheEntry(key uint32, value interface{}) error
+	ResolveKey(mountID uint32, key uint64, length uint32) (string, error)
+	RemoveEntry(mountID uint32, key uint64) error
+	AddCacheEntry(mountID uint32, key uint64, value interface{}) error
+	CacheLen() (int, error)
 }
 
-// PathFragmentsKey - Key of a dentry cache hashmap
-type PathFragmentsKey struct {
+// PathKey - Key of the dentry hashmaps, mirrors the path_key_t kernel structure
+type PathKey struct {
 	inode   uint64
 	mountID uint32
 }
 
-func (pfk *PathFragmentsKey) Set(mountID uint32, inode uint64) {
-	pfk.mountID = mountID
-	pfk.inode = inode
+// NewPathKey - Returns a new PathKey instance for the provided mount id and inode
+func NewPathKey(mountID uint32, inode uint64) PathKey {
+	return PathKey{
+		inode:   inode,
+		mountID: mountID,
+	}
+}
+
+func (pk *PathKey) Set(mountID uint32, inode uint64) {
+	pk.mountID = mountID
+	pk.inode = inode
 }
 
-func (pfk *PathFragmentsKey) Write(buffer []byte) {
-	utils.ByteOrder.PutUint64(buffer[0:8], pfk.inode)
-	utils.ByteOrder.PutUint32(buffer[8:12], pfk.mountID)
+func (pk *PathKey) Write(buffer []byte) {
+	utils.ByteOrder.PutUint64(buffer[0:8], pk.inode)
+	utils.ByteOrder.PutUint32(buffer[8:12], pk.mountID)
 	utils.ByteOrder.PutUint32(buffer[12:16], 0)
 }
 
-func (pfk *PathFragmentsKey) GetKeyBytes() []byte {
-	keyB := make([]byte, 16)
-	pfk.Write(keyB)
+func (pk *PathKey) GetKeyBytes() []byte {
+	keyB := make([]byte, PathKeySize)
+	pk.Write(keyB)
 	return keyB[:]
 }
 
-func (pfk *PathFragmentsKey) Read(buffer []byte) int {
-	pfk.inode = utils.ByteOrder.Uint64(buffer[0:8])
-	pfk.mountID = utils.ByteOrder.Uint32(buffer[8:12])
-	return 16
+func (pk *PathKey) Read(buffer []byte) int {
+	pk.inode = utils.ByteOrder.Uint64(buffer[0:8])
+	pk.mountID = utils.ByteOrder.Uint32(buffer[8:12])
+	return PathKeySize
 }
 
-func (pfk *PathFragmentsKey) IsNull() bool {
-	return pfk.inode == 0 && pfk.mountID == 0
+func (pk *PathKey) IsNull() bool {
+	return pk.inode == 0 && pk.mountID == 0
 }
 
-func (pfk *PathFragmentsKey) HasEmptyInode() bool {
-	return pfk.inode == 0
+func (pk *PathKey) HasEmptyInode() bool {
+	return pk.inode == 0
 }
 
-func (pfk *PathFragmentsKey) String() string {
-	return fmt.Sprintf("%x/%x", pfk.mountID, pfk.inode)
+func (pk *PathKey) String() string {
+	return fmt.Sprintf("%x/%x", pk.mountID, pk.inode)
 }
 
 type PathFragmentsValue struct {
-	parent   PathFragmentsKey
+	parent   PathKey
 	Fragment [PathFragmentsSize]byte
 }
 
@@ -105,7 +114,7 @@ func (pfv *PathFragmentsValue) GetString() string {
 // PathFragmentsResolver - Dentry resolver of the path fragments method
 type PathFragmentsResolver struct {
 	cache *ebpf.Map
-	key   *PathFragmentsKey
+	key   *PathKey
 	value *PathFragmentsValue
 }
 
@@ -117,7 +126,7 @@ func NewPathFragmentsResolver(monitor *Monitor) (*PathFragmentsResolver, error)
 	}
 	return &PathFragmentsResolver{
 		cache: cache,
-		key:   &PathFragmentsKey{},
+		key:   &PathKey{},
 		value: &PathFragmentsValue{},
 	}, nil
 }
@@ -136,7 +145,7 @@ func (pfr *PathFragmentsResolver) ResolveInode(mountID uint32, inode uint64) (fi
 	// Fetch path recursively
 	for !done {
 		if valueB, err = pfr.cache.GetBytes(keyB); err != nil || len(valueB) == 0 {
-			filename = "*ERROR*" + filename
+			filename = ResolutionErrorPrefix + filename
 			break
 		}
 		// Read next key from valueB
@@ -180,45 +189,23 @@ func (pfr *PathFragmentsResolver) RemoveInode(mountID uint32, inode uint64) erro
 }
 
 // ResolveKey - Does nothing
-func (pfr *PathFragmentsResolver) ResolveKey(key uint32, length uint32) (string, error) {
+func (pfr *PathFragmentsResolver) ResolveKey(mountID uint32, key uint64, length uint32) (string, error) {
 	return "", nil
 }
 
 // AddCacheEntry - Adds a new entry in the user space cache
-func (pfr *PathFragmentsResolver) AddCacheEntry(key uint32, value interface{}) error {
+func (pfr *PathFragmentsResolver) AddCacheEntry(mountID uint32, key uint64, value interface{}) error {
 	return nil
 }
 
 // RemoveEntry - Removes an entry from the cache
-func (pfr *PathFragmentsResolver) RemoveEntry(key uint32) error {
+func (pfr *PathFragmentsResolver) RemoveEntry(mountID uint32, key uint64) error {
 	return nil
 }
 
-// SingleFragmentKey - Key of a dentry cache hashmap
-type SingleFragmentKey struct {
-	key uint32
-}
-
-func (sfk *SingleFragmentKey) Set(key uint32) {
-	sfk.key = key
-}
-
-func (sfk *SingleFragmentKey) Write(buffer []byte) {
-	utils.ByteOrder.PutUint32(buffer[0:4], sfk.key)
-}
-
-func (sfk *SingleFragmentKey) GetKeyBytes() []byte {
-	keyB := make([]byte, 4)
-	sfk.Write(keyB)
-	return keyB
-}
-
-func (sfk *SingleFragmentKey) IsNull() bool {
-	return sfk.key == 0
-}
-
-func (sfk *SingleFragmentKey) String() string {
-	return fmt.Sprintf("%x", sfk.key)
+// CacheLen - Returns the number of entries in the kernel space cache
+func (pfr *PathFragmentsResolver) CacheLen() (int, error) {
+	return CountMapEntries(pfr.cache)
 }
 
 type SingleFragmentValue struct {
@@ -240,7 +227,7 @@ func (sfv *SingleFragmentValue) GetString(length uint32) string {
 
 type SingleFragmentResolver struct {
 	cache *ebpf.Map
-	key   *SingleFragmentKey
+	key   *PathKey
 	value *SingleFragmentValue
 }
 
@@ -252,7 +239,7 @@ func NewSingleFragmentResolver(monitor *Monitor) (*SingleFragmentResolver, error
 	}
 	return &SingleFragmentResolver{
 		cache: cache,
-		key:   &SingleFragmentKey{},
+		key:   &PathKey{},
 		value: &SingleFragmentValue{},
 	}, nil
 }
@@ -268,10 +255,10 @@ func (sfr *SingleFragmentResolver) RemoveInode(mountID uint32, inode uint64) err
 }
 
 // Resolve - Resolves a pathname from the provided mount id and inode
-func (sfr *SingleFragmentResolver) ResolveKey(key uint32, length uint32) (filename string, err error) {
+func (sfr *SingleFragmentResolver) ResolveKey(mountID uint32, key uint64, length uint32) (filename string, err error) {
 	// Don't resolve path if pathnameKey isn't valid
-	sfr.key.Set(key)
-	if sfr.key.IsNull() {
+	sfr.key.Set(mountID, key)
+	if sfr.key.HasEmptyInode() {
 		return "", fmt.Errorf("invalid key: %s", sfr.key.String())
 	}
 	// Generate hashmap key
@@ -279,13 +266,13 @@ func (sfr *SingleFragmentResolver) ResolveKey(key uint32, length uint32) (filena
 	valueB := []byte{}
 	// Fetch hashmap value
 	if valueB, err = sfr.cache.GetBytes(keyB); err != nil || len(valueB) == 0 {
-		filename = "*ERROR*"
+		filename = ResolutionErrorPrefix
 		err = errors.Wrap(err, "failed to query value")
 		return
 	}
 	// Read fragment from valueB
 	if err = sfr.value.Read(valueB); err != nil {
-		filename = "*ERROR*"
+		filename = ResolutionErrorPrefix
 		err = errors.Wrap(err, "failed to decode fragment")
 		return
 	}
@@ -296,16 +283,21 @@ func (sfr *SingleFragmentResolver) ResolveKey(key uint32, length uint32) (filena
 	return
 }
 
+// CacheLen - Returns the number of entries in the kernel space cache
+func (sfr *SingleFragmentResolver) CacheLen() (int, error) {
+	return CountMapEntries(sfr.cache)
+}
+
 // AddCacheEntry - Adds a new entry in the user space cache
-func (sfr *SingleFragmentResolver) AddCacheEntry(key uint32, value interface{}) error {
+func (sfr *SingleFragmentResolver) AddCacheEntry(mountID uint32, key uint64, value interface{}) error {
 	return nil
 }
 
 // RemoveEntry - Removes an entry from the cache
-func (sfr *SingleFragmentResolver) RemoveEntry(key uint32) error {
+func (sfr *SingleFragmentResolver) RemoveEntry(mountID uint32, key uint64) error {
 	// Don't resolve path if pathnameKey isn't valid
-	sfr.key.Set(key)
-	if sfr.key.IsNull() {
+	sfr.key.Set(mountID, key)
+	if sfr.key.HasEmptyInode() {
 		return fmt.Errorf("invalid key: %s", sfr.key.String())
 	}
 	// Generate hashmap key
@@ -317,19 +309,35 @@ func (sfr *SingleFragmentResolver) RemoveEntry(key uint32) error {
 	return nil
 }
 
+// kernelCache - Kernel space map mirrored by a user space cache
+type kernelCache interface {
+	Put(key, value interface{}) error
+	Delete(key interface{}) error
+}
+
 type PerfBufferResolver struct {
-	kernelLRU *ebpf.Map
+	kernelLRU kernelCache
 	lru       *lru.Cache
+	stats     *StatsCollector
 }
 
 // NewPerfBufferResolver - Returns a new PerfBufferResolver instance
 func NewPerfBufferResolver(monitor *Monitor) (*PerfBufferResolver, error) {
-	var err error
-	pbr := PerfBufferResolver{}
-	pbr.kernelLRU = monitor.GetMap(CachedInodesMap)
-	if pbr.kernelLRU == nil {
+	cachedInodes := monitor.GetMap(CachedInodesMap)
+	if cachedInodes == nil {
 		return nil, fmt.Errorf("%s eBPF map doesn't exist", CachedInodesMap)
 	}
+	return newPerfBufferResolver(cachedInodes, monitor.Stats)
+}
+
+// newPerfBufferResolver - Returns a new PerfBufferResolver instance that mirrors its entries in the provided kernel
+// space cache
+func newPerfBufferResolver(kernelLRU kernelCache, stats *StatsCollector) (*PerfBufferResolver, error) {
+	var err error
+	pbr := PerfBufferResolver{
+		kernelLRU: kernelLRU,
+		stats:     stats,
+	}
 	pbr.lru, err = lru.NewWithEvict(PerfBufferCachedInodesSize, pbr.onCachedInodeEvicted)
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't create a new PerfBufferResolver LRU")
@@ -348,37 +356,49 @@ func (pbr *PerfBufferResolver) RemoveInode(mountID uint32, inode uint64) error {
 }
 
 // Resolve - Resolves a pathname from the provided key (length is not used)
-func (pbr *PerfBufferResolver) ResolveKey(key uint32, length uint32) (string, error) {
+func (pbr *PerfBufferResolver) ResolveKey(mountID uint32, key uint64, length uint32) (string, error) {
 	// Select the inode path from the lru
-	value, ok := pbr.lru.Get(key)
+	value, ok := pbr.lru.Get(NewPathKey(mountID, key))
 	if ok {
+		pbr.stats.CountCacheHit()
 		return value.(string), nil
 	}
 	if key == 2 {
 		return "/", nil
 	}
-	return "", fmt.Errorf("%v not found", key)
+	pbr.stats.CountCacheMiss()
+	return "", fmt.Errorf("%x/%x not found", mountID, key)
 }
 
 // AddCacheEntry - Adds a new entry in the LRU cache
-func (pbr *PerfBufferResolver) AddCacheEntry(key uint32, value interface{}) error {
+func (pbr *PerfBufferResolver) AddCacheEntry(mountID uint32, key uint64, value interface{}) error {
+	pathKey := NewPathKey(mountID, key)
 	// Add entry in user space LRU
-	pbr.lru.Add(key, value)
+	if evicted := pbr.lru.Add(pathKey, value); evicted {
+		pbr.stats.CountCacheEviction()
+	}
 	// Add entry in the kernel space cache
-	keyB := make([]byte, 4)
-	utils.ByteOrder.PutUint32(keyB, key)
 	var valueB byte
-	if err := pbr.kernelLRU.Put(keyB, valueB); err != nil {
+	if err := pbr.kernelLRU.Put(pathKey.GetKeyBytes(), valueB); err != nil {
 		return err
 	}
 	return nil
 }
 
+// CacheLen - Returns the number of entries in the user space LRU cache
+func (pbr *PerfBufferResolver) CacheLen() (int, error) {
+	return pbr.lru.Len(), nil
+}
+
 // RemoveEntry - Removes an entry from the cache
-func (pbr *PerfBufferResolver) RemoveEntry(key uint32) error {
-	keyB := make([]byte, 4)
-	utils.ByteOrder.PutUint32(keyB, key)
-	if err := pbr.kernelLRU.Delete(keyB); err != nil {
+func (pbr *PerfBufferResolver) RemoveEntry(mountID uint32, key uint64) error {
+	pathKey := NewPathKey(mountID, key)
+	// Removing the entry from the user space LRU also removes it from the kernel space cache
+	if pbr.lru.Contains(pathKey) {
+		pbr.lru.Remove(pathKey)
+		return nil
+	}
+	if err := pbr.kernelLRU.Delete(pathKey.GetKeyBytes()); err != nil {
 		return errors.Wrap(err, "failed to delete entry from cached_inodes eBPF map")
 	}
 	return nil
@@ -386,17 +406,81 @@ func (pbr *PerfBufferResolver) RemoveEntry(key uint32) error {
 
 // onCachedInodeEvicted - Removes the input inode from the kernel space cache
 func (pbr *PerfBufferResolver) onCachedInodeEvicted(key, value interface{}) {
-	keyB := make([]byte, 4)
-	keyU, ok := key.(uint32)
+	pathKey, ok := key.(PathKey)
 	if !ok {
-		logrus.Warnf("failed to delete entry from cached_inodes eBPF map: key is not uint32: %v", key)
+		logrus.Warnf("failed to delete entry from cached_inodes eBPF map: key is not a PathKey: %v", key)
+		return
 	}
-	utils.ByteOrder.PutUint32(keyB, keyU)
-	if err := pbr.kernelLRU.Delete(keyB); err != nil {
+	if err := pbr.kernelLRU.Delete(pathKey.GetKeyBytes()); err != nil {
 		logrus.Warnf("failed to delete entry from cached_inodes eBPF map: %v", err)
 	}
 }
 
+// ReplayResolver - Stand-in dentry resolver used to replay a capture. It mirrors the user space cache of the
+// PerfBufferResolver without its kernel space counterpart, so that the paths of the perf_buffer mode are resolved the
+// same way. The other modes read the paths from eBPF maps that aren't recorded, their paths can't be resolved.
+type ReplayResolver struct {
+	mode  DentryResolutionMode
+	lru   *lru.Cache
+	stats *StatsCollector
+}
+
+// NewReplayResolver - Returns a new ReplayResolver instance for the provided resolution mode
+func NewReplayResolver(mode DentryResolutionMode, stats *StatsCollector) *ReplayResolver {
+	cache, _ := lru.New(PerfBufferCachedInodesSize)
+	return &ReplayResolver{
+		mode:  mode,
+		lru:   cache,
+		stats: stats,
+	}
+}
+
+// ResolveInode - Paths of the fragments mode can't be resolved without the kernel space cache
+func (rr *ReplayResolver) ResolveInode(mountID uint32, inode uint64) (string, error) {
+	return ResolutionErrorPrefix, nil
+}
+
+// RemoveInode - Does nothing
+func (rr *ReplayResolver) RemoveInode(mountID uint32, inode uint64) error {
+	return nil
+}
+
+// ResolveKey - Resolves a pathname from the provided key, see PerfBufferResolver.ResolveKey
+func (rr *ReplayResolver) ResolveKey(mountID uint32, key uint64, length uint32) (string, error) {
+	if rr.mode != DentryResolutionPerfBuffer {
+		return ResolutionErrorPrefix, nil
+	}
+	value, ok := rr.lru.Get(NewPathKey(mountID, key))
+	if ok {
+		rr.stats.CountCacheHit()
+		return value.(string), nil
+	}
+	if key == 2 {
+		return "/", nil
+	}
+	rr.stats.CountCacheMiss()
+	return "", fmt.Errorf("%x/%x not found", mountID, key)
+}
+
+// AddCacheEntry - Adds a new entry in the LRU cache. Evictions are counted like the ones of the PerfBufferResolver.
+func (rr *ReplayResolver) AddCacheEntry(mountID uint32, key uint64, value interface{}) error {
+	if evicted := rr.lru.Add(NewPathKey(mountID, key), value); evicted {
+		rr.stats.CountCacheEviction()
+	}
+	return nil
+}
+
+// RemoveEntry - Removes an entry from the LRU cache
+func (rr *ReplayResolver) RemoveEntry(mountID uint32, key uint64) error {
+	rr.lru.Remove(NewPathKey(mountID, key))
+	return nil
+}
+
+// CacheLen - Returns the number of entries in the LRU cache
+func (rr *ReplayResolver) CacheLen() (int, error) {
+	return rr.lru.Len(), nil
+}
+
 // NewDentryResolver - Returns a new resolver configured for the selected resolution method
 func NewDentryResolver(monitor *Monitor) (DentryResolver, error) {
 	switch monitor.Options.DentryResolutionMode {
@@ -0,0 +1,97 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"errors"
+	"testing"
+)
+
+// fakeKernelCache - Kernel space cache backed by a map of the encoded keys
+type fakeKernelCache map[string]interface{}
+
+func (f fakeKernelCache) Put(key, value interface{}) error {
+	f[string(key.([]byte))] = value
+	return nil
+}
+
+func (f fakeKernelCache) Delete(key interface{}) error {
+	k := string(key.([]byte))
+	if _, ok := f[k]; !ok {
+		return errors.New("key does not exist")
+	}
+	delete(f, k)
+	return nil
+}
+
+func (f fakeKernelCache) contains(mountID uint32, inode uint64) bool {
+	key := NewPathKey(mountID, inode)
+	_, ok := f[string(key.GetKeyBytes())]
+	return ok
+}
+
+func TestPerfBufferResolverRemoveEntry(t *testing.T) {
+	kernel := fakeKernelCache{}
+	pbr, err := newPerfBufferResolver(kernel, NewStatsCollector())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The same inode number on two mount points
+	if err := pbr.AddCacheEntry(21, 42, "/etc/passwd"); err != nil {
+		t.Fatal(err)
+	}
+	if err := pbr.AddCacheEntry(22, 42, "/home/user/file"); err != nil {
+		t.Fatal(err)
+	}
+	if !kernel.contains(21, 42) || !kernel.contains(22, 42) {
+		t.Fatal("the entries weren't added to the kernel space cache")
+	}
+
+	// Removing an entry drops it from both caches, and leaves the entry of the other mount point
+	if err := pbr.RemoveEntry(21, 42); err != nil {
+		t.Fatal(err)
+	}
+	if kernel.contains(21, 42) {
+		t.Error("the removed entry is still in the kernel space cache")
+	}
+	if _, err := pbr.ResolveKey(21, 42, 0); err == nil {
+		t.Error("the removed entry is still in the user space cache")
+	}
+	if !kernel.contains(22, 42) {
+		t.Error("the entry of the other mount point was removed from the kernel space cache")
+	}
+	if p, err := pbr.ResolveKey(22, 42, 0); err != nil || p != "/home/user/file" {
+		t.Errorf("expected /home/user/file, got %q (%v)", p, err)
+	}
+	if n, _ := pbr.CacheLen(); n != 1 {
+		t.Errorf("expected 1 cached entry, got %d", n)
+	}
+
+	// Entries only known in kernel space are removed from the kernel space cache
+	key := NewPathKey(23, 7)
+	_ = kernel.Put(key.GetKeyBytes(), byte(0))
+	if err := pbr.RemoveEntry(23, 7); err != nil {
+		t.Fatal(err)
+	}
+	if kernel.contains(23, 7) {
+		t.Error("the kernel space entry wasn't removed")
+	}
+
+	// Unknown entries are reported
+	if err := pbr.RemoveEntry(21, 42); err == nil {
+		t.Error("expected an error when removing an unknown entry")
+	}
+}
@@ -0,0 +1,264 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// ResolutionErrorPrefix - Prefix of the paths that couldn't be resolved
+	ResolutionErrorPrefix = "*ERROR*"
+	// MaxEventLabelSets - Maximum number of (event type, comm, retval) counters. The events of the new processes are
+	// counted with the OtherComm process name once the limit is reached.
+	MaxEventLabelSets = 4096
+	// OtherComm - Process name of the events that exceed MaxEventLabelSets
+	OtherComm = "other"
+)
+
+// EventLabels - Labels of the detailed event counters
+type EventLabels struct {
+	Event  EventName
+	Comm   string
+	Retval int32
+}
+
+// Stats - Self-monitoring statistics of FSProbe
+type Stats struct {
+	// Events - Number of events received from the kernel, by event type
+	Events map[EventName]uint64
+	// ParseFailures - Number of events that couldn't be parsed
+	ParseFailures uint64
+	// ResolutionFailures - Number of events with a path that couldn't be resolved
+	ResolutionFailures uint64
+	// LostSamples - Number of samples lost in kernel space, by perf map
+	LostSamples map[string]uint64
+	// UserSpaceDrops - Number of events dropped by the backpressure policy of the event channel
+	UserSpaceDrops uint64
+	// ResolverCache - Statistics of the user space cache of the perf buffer dentry resolver
+	ResolverCache CacheStats
+	// Channels - Fill levels of the user space channels
+	Channels []ChannelStats
+}
+
+// CacheStats - Statistics of a cache
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// ChannelStats - Fill level of a user space channel
+type ChannelStats struct {
+	// Name - Name of the channel
+	Name string
+	// Len - Number of buffered events
+	Len int
+	// Cap - Size of the buffer
+	Cap int
+	// Dropped - Number of events dropped by the backpressure policy of the channel
+	Dropped uint64
+}
+
+// String - Returns a one line summary of the statistics
+func (s Stats) String() string {
+	var b strings.Builder
+	b.WriteString("events:")
+	for _, name := range sortedKeys(s.Events) {
+		fmt.Fprintf(&b, " %s=%d", name, s.Events[EventName(name)])
+	}
+	fmt.Fprintf(&b, " | parse_failures=%d resolution_failures=%d user_space_drops=%d", s.ParseFailures, s.ResolutionFailures, s.UserSpaceDrops)
+	b.WriteString(" | lost:")
+	if len(s.LostSamples) == 0 {
+		b.WriteString(" none")
+	}
+	for _, name := range sortedKeys(s.LostSamples) {
+		fmt.Fprintf(&b, " %s=%d", name, s.LostSamples[name])
+	}
+	fmt.Fprintf(&b, " | resolver_cache: hits=%d misses=%d evictions=%d", s.ResolverCache.Hits, s.ResolverCache.Misses, s.ResolverCache.Evictions)
+	b.WriteString(" | channels:")
+	if len(s.Channels) == 0 {
+		b.WriteString(" none")
+	}
+	for _, c := range s.Channels {
+		fmt.Fprintf(&b, " %s=%d/%d", c.Name, c.Len, c.Cap)
+		if c.Dropped > 0 {
+			fmt.Fprintf(&b, " (%d dropped)", c.Dropped)
+		}
+	}
+	return b.String()
+}
+
+// sortedKeys - Returns the sorted keys of a map of counters
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch counters := m.(type) {
+	case map[EventName]uint64:
+		for key := range counters {
+			keys = append(keys, string(key))
+		}
+	case map[string]uint64:
+		for key := range counters {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// StatsCollector - Counters updated by the monitors, the resolvers and the dispatch of the events. All the methods
+// are thread safe and can be called on a nil collector.
+type StatsCollector struct {
+	events             map[EventName]*uint64
+	parseFailures      uint64
+	resolutionFailures uint64
+	cacheHits          uint64
+	cacheMisses        uint64
+	cacheEvictions     uint64
+	lock               sync.Mutex
+	lostSamples        map[string]uint64
+	eventLabels        map[EventLabels]uint64
+}
+
+// NewStatsCollector - Returns a new StatsCollector instance
+func NewStatsCollector() *StatsCollector {
+	sc := &StatsCollector{
+		events:      make(map[EventName]*uint64),
+		lostSamples: make(map[string]uint64),
+		eventLabels: make(map[EventLabels]uint64),
+	}
+	for i := uint32(0); ; i++ {
+		evt := GetEventType(i)
+		sc.events[evt] = new(uint64)
+		if evt == Unknown {
+			break
+		}
+	}
+	return sc
+}
+
+// CountEvent - Counts an event received from the kernel, and its resolution failures
+func (sc *StatsCollector) CountEvent(evt *FSEvent) {
+	if sc == nil {
+		return
+	}
+	counter, ok := sc.events[evt.EventType]
+	if !ok {
+		counter = sc.events[Unknown]
+	}
+	atomic.AddUint64(counter, 1)
+	sc.countEventLabels(EventLabels{Event: evt.EventType, Comm: evt.Comm, Retval: evt.Retval})
+	if strings.Contains(evt.SrcFilename, ResolutionErrorPrefix) || strings.Contains(evt.TargetFilename, ResolutionErrorPrefix) {
+		atomic.AddUint64(&sc.resolutionFailures, 1)
+	}
+}
+
+// countEventLabels - Increments the detailed counter of an event
+func (sc *StatsCollector) countEventLabels(labels EventLabels) {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+	if _, ok := sc.eventLabels[labels]; !ok && len(sc.eventLabels) >= MaxEventLabelSets {
+		labels.Comm = OtherComm
+	}
+	sc.eventLabels[labels]++
+}
+
+// EventCounters - Returns the number of events received from the kernel, by event type, process name and return value
+func (sc *StatsCollector) EventCounters() map[EventLabels]uint64 {
+	counters := make(map[EventLabels]uint64)
+	if sc == nil {
+		return counters
+	}
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+	for labels, count := range sc.eventLabels {
+		counters[labels] = count
+	}
+	return counters
+}
+
+// CountParseFailure - Counts an event that couldn't be parsed
+func (sc *StatsCollector) CountParseFailure() {
+	if sc == nil {
+		return
+	}
+	atomic.AddUint64(&sc.parseFailures, 1)
+}
+
+// CountLostSamples - Counts the samples lost by a perf map
+func (sc *StatsCollector) CountLostSamples(perfMap string, count uint64) {
+	if sc == nil {
+		return
+	}
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+	sc.lostSamples[perfMap] += count
+}
+
+// CountCacheHit - Counts a hit of the resolver cache
+func (sc *StatsCollector) CountCacheHit() {
+	if sc == nil {
+		return
+	}
+	atomic.AddUint64(&sc.cacheHits, 1)
+}
+
+// CountCacheMiss - Counts a miss of the resolver cache
+func (sc *StatsCollector) CountCacheMiss() {
+	if sc == nil {
+		return
+	}
+	atomic.AddUint64(&sc.cacheMisses, 1)
+}
+
+// CountCacheEviction - Counts an eviction of the resolver cache
+func (sc *StatsCollector) CountCacheEviction() {
+	if sc == nil {
+		return
+	}
+	atomic.AddUint64(&sc.cacheEvictions, 1)
+}
+
+// Snapshot - Returns the current value of the counters
+func (sc *StatsCollector) Snapshot() Stats {
+	stats := Stats{
+		Events:      make(map[EventName]uint64),
+		LostSamples: make(map[string]uint64),
+	}
+	if sc == nil {
+		return stats
+	}
+	for name, counter := range sc.events {
+		stats.Events[name] = atomic.LoadUint64(counter)
+	}
+	stats.ParseFailures = atomic.LoadUint64(&sc.parseFailures)
+	stats.ResolutionFailures = atomic.LoadUint64(&sc.resolutionFailures)
+	stats.ResolverCache = CacheStats{
+		Hits:      atomic.LoadUint64(&sc.cacheHits),
+		Misses:    atomic.LoadUint64(&sc.cacheMisses),
+		Evictions: atomic.LoadUint64(&sc.cacheEvictions),
+	}
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+	for name, count := range sc.lostSamples {
+		stats.LostSamples[name] = count
+	}
+	return stats
+}
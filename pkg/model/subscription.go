@@ -0,0 +1,35 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+// SubscriptionRequest - Subscription message sent by the clients of the fsprobe server, as a single JSON line
+type SubscriptionRequest struct {
+	// Paths - Paths watched for the client. Only the events on these paths are sent to the client.
+	Paths []string `json:"paths"`
+	// Events - Event types sent to the client. All the event types are sent if empty.
+	Events []EventName `json:"events,omitempty"`
+	// Filter - Filter expression, only the matching events are sent to the client
+	Filter string `json:"filter,omitempty"`
+	// BufferSize - Number of events buffered for the client. Defaults to the channel size of the server.
+	BufferSize int `json:"buffer_size,omitempty"`
+	// Backpressure - Behavior of the client buffer when it is full, either "drop" (default) or "drop_oldest"
+	Backpressure string `json:"backpressure,omitempty"`
+}
+
+// SubscriptionError - Message sent to a client before its connection is closed, when its subscription is rejected
+type SubscriptionError struct {
+	Error string `json:"error"`
+}
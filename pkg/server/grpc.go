@@ -0,0 +1,160 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Gui774ume/fsprobe/pkg/api"
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// lostReportInterval - Maximum delay before the events lost by a stream are reported to its gRPC client
+const lostReportInterval = time.Second
+
+// NewGRPCServer - Returns a gRPC server exposing the FSProbe service (see pkg/api) on top of the server. The watches
+// of the gRPC clients share the reference counts of the socket clients.
+func (s *Server) NewGRPCServer(opts ...grpc.ServerOption) *grpc.Server {
+	gs := grpc.NewServer(opts...)
+	api.RegisterFSProbeServer(gs, &grpcService{server: s})
+	return gs
+}
+
+// grpcService - Implementation of the FSProbe gRPC service
+type grpcService struct {
+	api.UnimplementedFSProbeServer
+	server *Server
+}
+
+// Watch - Watches the provided paths until they are unwatched
+func (gs *grpcService) Watch(ctx context.Context, req *api.WatchRequest) (*api.WatchResponse, error) {
+	paths, err := cleanPaths(req.GetPaths())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := gs.server.watchExplicit(paths); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.WatchResponse{}, nil
+}
+
+// Unwatch - Releases the paths watched with Watch
+func (gs *grpcService) Unwatch(ctx context.Context, req *api.UnwatchRequest) (*api.UnwatchResponse, error) {
+	paths, err := cleanPaths(req.GetPaths())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := gs.server.unwatchExplicit(paths); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &api.UnwatchResponse{}, nil
+}
+
+// ListWatches - Lists the watched paths
+func (gs *grpcService) ListWatches(ctx context.Context, req *api.ListWatchesRequest) (*api.ListWatchesResponse, error) {
+	resp := &api.ListWatchesResponse{}
+	for p, count := range gs.server.Watches() {
+		resp.Watches = append(resp.Watches, &api.Watch{Path: p, References: uint32(count)})
+	}
+	sort.Slice(resp.Watches, func(i, j int) bool {
+		return resp.Watches[i].Path < resp.Watches[j].Path
+	})
+	return resp, nil
+}
+
+// StreamEvents - Streams the events matching the request until the client cancels the call or FSProbe stops
+func (gs *grpcService) StreamEvents(req *api.StreamEventsRequest, srv api.FSProbe_StreamEventsServer) error {
+	options, paths, err := gs.server.subscribeOptions(model.SubscriptionRequest{
+		Paths:        req.GetPaths(),
+		Events:       eventNames(req.GetEvents()),
+		Filter:       req.GetFilter(),
+		BufferSize:   int(req.GetBufferSize()),
+		Backpressure: req.GetBackpressure(),
+	})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Subscribe before watching the paths so that no event is missed
+	stream := gs.server.probe.SubscribeWithOptions(options)
+	defer stream.Close()
+	if err := gs.server.watch(paths); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer gs.server.unwatch(paths)
+
+	var lost, dropped uint64
+	// reportLost - Notifies the client of the events lost since the previous notification
+	reportLost := func() error {
+		if l := stream.Lost(); l > lost {
+			lostEvt := &model.LostEvt{Count: l - lost, Reason: model.LostReasonPerfBuffer}
+			lost = l
+			if err := srv.Send(&api.StreamEventsResponse{Payload: &api.StreamEventsResponse_Lost{Lost: api.NewLostEvt(lostEvt)}}); err != nil {
+				return err
+			}
+		}
+		if d := stream.Dropped(); d > dropped {
+			lostEvt := &model.LostEvt{Count: d - dropped, Reason: model.LostReasonUserSpace}
+			dropped = d
+			if err := srv.Send(&api.StreamEventsResponse{Payload: &api.StreamEventsResponse_Lost{Lost: api.NewLostEvt(lostEvt)}}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	ctx := srv.Context()
+	for {
+		nextCtx, cancel := context.WithTimeout(ctx, lostReportInterval)
+		evt, err := stream.Next(nextCtx)
+		cancel()
+		if err == context.DeadlineExceeded && ctx.Err() == nil {
+			if err := reportLost(); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			// The stream is closed when FSProbe stops
+			_ = reportLost()
+			return nil
+		}
+		if err := reportLost(); err != nil {
+			return err
+		}
+		if err := srv.Send(&api.StreamEventsResponse{Payload: &api.StreamEventsResponse_Event{Event: api.NewFSEvent(evt)}}); err != nil {
+			return err
+		}
+	}
+}
+
+// GetStats - Returns the self-monitoring statistics of FSProbe
+func (gs *grpcService) GetStats(ctx context.Context, req *api.GetStatsRequest) (*api.GetStatsResponse, error) {
+	return api.NewGetStatsResponse(gs.server.probe.Stats()), nil
+}
+
+func eventNames(names []string) []model.EventName {
+	var events []model.EventName
+	for _, name := range names {
+		events = append(events, model.EventName(name))
+	}
+	return events
+}
@@ -0,0 +1,160 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Gui774ume/fsprobe/pkg/client"
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+func newTestGRPCClient(t *testing.T) (*Server, *fakeProbe, *client.Client) {
+	srv, probe, _ := newTestServer(t)
+	addr := filepath.Join(t.TempDir(), "fsprobe-grpc.sock")
+	listener, err := net.Listen("unix", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gs := srv.NewGRPCServer()
+	go gs.Serve(listener)
+	t.Cleanup(gs.Stop)
+	c, err := client.Dial("unix://" + addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return srv, probe, c
+}
+
+func TestGRPCWatches(t *testing.T) {
+	_, probe, c := newTestGRPCClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Watch(ctx, "/tmp", "/etc/"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Watch(ctx, "relative"); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected an invalid argument error, got %v", err)
+	}
+	watches, err := c.ListWatches(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(watches, map[string]uint32{"/tmp": 1, "/etc": 1}) {
+		t.Errorf("unexpected watches: %v", watches)
+	}
+
+	if err := c.Unwatch(ctx, "/etc"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Unwatch(ctx, "/etc"); status.Code(err) != codes.NotFound {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+	if watched := probe.watchedPaths(); !reflect.DeepEqual(watched, map[string]int{"/tmp": 1}) {
+		t.Errorf("unexpected watched paths: %v", watched)
+	}
+}
+
+func TestGRPCStreamEvents(t *testing.T) {
+	srv, probe, c := newTestGRPCClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := c.StreamEvents(ctx, client.StreamOptions{
+		Paths:  []string{"/tmp"},
+		Events: []model.EventName{model.Rename},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, func() bool { return srv.Watches()["/tmp"] == 1 })
+
+	expected := &model.FSEvent{
+		Timestamp:      time.Date(2020, 6, 7, 13, 25, 41, 123456789, time.UTC),
+		EventType:      model.Rename,
+		Pid:            42,
+		Comm:           "mv",
+		SrcFilename:    "/tmp/a",
+		TargetFilename: "/tmp/b",
+	}
+	probe.DispatchEvent(&model.FSEvent{EventType: model.Open, SrcFilename: "/tmp/a"})
+	probe.DispatchEvent(expected)
+
+	evt, lost, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lost != nil || !reflect.DeepEqual(evt, expected) {
+		t.Errorf("expected %+v, got %+v (lost %+v)", expected, evt, lost)
+	}
+
+	// Lost events are reported even if no other event is streamed
+	probe.DispatchLost(&model.LostEvt{Map: "fs_events", Count: 3, Reason: model.LostReasonPerfBuffer})
+	_, lost, err = stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lost == nil || lost.Count != 3 || lost.Reason != model.LostReasonPerfBuffer {
+		t.Errorf("expected a lost event notification, got %+v", lost)
+	}
+
+	stats, err := c.GetStats(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Events[model.Rename] != 1 || stats.LostSamples["fs_events"] != 3 {
+		t.Errorf("unexpected stats: %s", stats)
+	}
+
+	// The paths of the stream are released when the client cancels it
+	cancel()
+	waitFor(t, func() bool { return len(srv.Watches()) == 0 })
+}
+
+func TestGRPCRejectsInvalidSubscriptions(t *testing.T) {
+	srv, _, c := newTestGRPCClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for _, options := range []client.StreamOptions{
+		{},
+		{Paths: []string{"relative"}},
+		{Paths: []string{"/tmp"}, Events: []model.EventName{"chmod"}},
+		{Paths: []string{"/tmp"}, Filter: "uid =="},
+		{Paths: []string{"/tmp"}, Backpressure: model.BackpressureSpill},
+	} {
+		stream, err := c.StreamEvents(ctx, options)
+		if err == nil {
+			_, _, err = stream.Recv()
+		}
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%+v: expected an invalid argument error, got %v", options, err)
+		}
+	}
+	if watches := srv.Watches(); len(watches) != 0 {
+		t.Errorf("the rejected subscriptions watched %v", watches)
+	}
+}
@@ -0,0 +1,343 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
+	"path"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Gui774ume/fsprobe/pkg/filter"
+	"github.com/Gui774ume/fsprobe/pkg/fsprobe"
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+const (
+	// maxRequestSize - Maximum size of a subscription request
+	maxRequestSize = 1 << 20
+	// maxBufferSize - Maximum number of events buffered for a client
+	maxBufferSize = 1 << 20
+)
+
+// ErrServerClosed - Returned by Serve once the server is closed
+var ErrServerClosed = errors.New("server closed")
+
+// Probe - Functions of FSProbe used by the server
+type Probe interface {
+	GetOptions() *model.FSProbeOptions
+	Watch(paths ...string) error
+	Unwatch(paths ...string) error
+	SubscribeWithOptions(options fsprobe.SubscribeOptions) *fsprobe.Stream
+	Stats() model.Stats
+}
+
+// Server - Streams the events of a shared FSProbe instance to the clients connected to its listeners. Each client sends
+// a subscription request (see model.SubscriptionRequest) and then receives the matching events as newline delimited
+// JSON. The paths of the clients are reference counted, so that they are unwatched once the last client watching them
+// disconnects. The same server can also be exposed as a gRPC service, see NewGRPCServer.
+type Server struct {
+	probe   Probe
+	lock    sync.Mutex
+	watches map[string]int
+	// explicitWatches - References of the paths watched with the Watch RPC
+	explicitWatches map[string]int
+	listeners       map[net.Listener]struct{}
+	clients         map[net.Conn]context.CancelFunc
+	closed          bool
+	wg              sync.WaitGroup
+}
+
+// NewServer - Returns a new server for the provided probe
+func NewServer(probe Probe) *Server {
+	return &Server{
+		probe:           probe,
+		watches:         make(map[string]int),
+		explicitWatches: make(map[string]int),
+		listeners:       make(map[net.Listener]struct{}),
+		clients:         make(map[net.Conn]context.CancelFunc),
+	}
+}
+
+// Serve - Accepts the clients of the provided listener. Serve blocks until the listener fails or the server is closed,
+// in which case ErrServerClosed is returned.
+func (s *Server) Serve(listener net.Listener) error {
+	s.lock.Lock()
+	if s.closed {
+		s.lock.Unlock()
+		return ErrServerClosed
+	}
+	s.listeners[listener] = struct{}{}
+	s.lock.Unlock()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			s.lock.Lock()
+			closed := s.closed
+			delete(s.listeners, listener)
+			s.lock.Unlock()
+			if closed {
+				return ErrServerClosed
+			}
+			return err
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		s.lock.Lock()
+		if s.closed {
+			s.lock.Unlock()
+			cancel()
+			conn.Close()
+			return ErrServerClosed
+		}
+		s.clients[conn] = cancel
+		s.wg.Add(1)
+		s.lock.Unlock()
+		go s.handle(ctx, conn)
+	}
+}
+
+// Close - Closes the listeners and disconnects the clients
+func (s *Server) Close() error {
+	s.lock.Lock()
+	s.closed = true
+	for listener := range s.listeners {
+		listener.Close()
+	}
+	for conn, cancel := range s.clients {
+		cancel()
+		conn.Close()
+	}
+	s.lock.Unlock()
+	s.wg.Wait()
+	return nil
+}
+
+// Watches - Returns the reference count of the paths watched for the clients
+func (s *Server) Watches() map[string]int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	watches := make(map[string]int, len(s.watches))
+	for p, count := range s.watches {
+		watches[p] = count
+	}
+	return watches
+}
+
+// handle - Reads the subscription request of a client and streams the matching events until the client disconnects
+func (s *Server) handle(ctx context.Context, conn net.Conn) {
+	defer s.wg.Done()
+	defer func() {
+		s.lock.Lock()
+		if cancel, ok := s.clients[conn]; ok {
+			cancel()
+			delete(s.clients, conn)
+		}
+		s.lock.Unlock()
+		conn.Close()
+	}()
+
+	reader := bufio.NewReader(io.LimitReader(conn, maxRequestSize))
+	line, err := reader.ReadBytes('\n')
+	if err != nil && (err != io.EOF || len(line) == 0) {
+		logrus.Debugf("couldn't read subscription request from %s: %v", conn.RemoteAddr(), err)
+		return
+	}
+	var req model.SubscriptionRequest
+	if err := json.Unmarshal(line, &req); err != nil {
+		writeError(conn, errors.Wrap(err, "invalid subscription request"))
+		return
+	}
+	options, paths, err := s.subscribeOptions(req)
+	if err != nil {
+		writeError(conn, err)
+		return
+	}
+
+	// Subscribe before watching the paths so that no event is missed
+	stream := s.probe.SubscribeWithOptions(options)
+	defer stream.Close()
+	if err := s.watch(paths); err != nil {
+		writeError(conn, err)
+		return
+	}
+	defer s.unwatch(paths)
+
+	// The connection is closed when the client disconnects, the rest of its input is ignored
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		_, _ = io.Copy(ioutil.Discard, reader)
+		_, _ = io.Copy(ioutil.Discard, conn)
+		cancel()
+	}()
+
+	writer := bufio.NewWriter(conn)
+	encoder := json.NewEncoder(writer)
+	for {
+		evt, err := stream.Next(ctx)
+		if err != nil {
+			return
+		}
+		if err = encoder.Encode(evt); err == nil {
+			err = writer.Flush()
+		}
+		if err != nil {
+			logrus.Debugf("couldn't send event to %s: %v", conn.RemoteAddr(), err)
+			return
+		}
+	}
+}
+
+// subscribeOptions - Validates a subscription request and returns the matching subscribe options, along with the
+// cleaned paths to watch
+func (s *Server) subscribeOptions(req model.SubscriptionRequest) (fsprobe.SubscribeOptions, []string, error) {
+	options := fsprobe.SubscribeOptions{
+		BufferSize:   req.BufferSize,
+		Backpressure: model.BackpressureDropNewest,
+	}
+	paths, err := cleanPaths(req.Paths)
+	if err != nil {
+		return options, nil, err
+	}
+	if len(paths) == 0 && s.probe.GetOptions().PathsFiltering {
+		return options, nil, errors.New("paths filtering is activated but no path was provided")
+	}
+	options.PathPrefixes = paths
+	for _, name := range req.Events {
+		evt, err := model.ParseEventName(string(name))
+		if err != nil {
+			return options, nil, err
+		}
+		options.Events = append(options.Events, evt)
+	}
+	if req.Filter != "" {
+		f, err := filter.Parse(req.Filter)
+		if err != nil {
+			return options, nil, errors.Wrap(err, "invalid filter")
+		}
+		options.Filter = f
+	}
+	if req.BufferSize < 0 || req.BufferSize > maxBufferSize {
+		return options, nil, errors.Errorf("buffer size must be between 0 and %d", maxBufferSize)
+	}
+	if req.Backpressure != "" {
+		policy, err := model.ParseBackpressurePolicy(req.Backpressure)
+		if err != nil {
+			return options, nil, err
+		}
+		// A client shouldn't be able to delay the other clients, nor to fill the disk of the server
+		if policy != model.BackpressureDropNewest && policy != model.BackpressureDropOldest {
+			return options, nil, errors.Errorf("unsupported backpressure policy: %s", policy)
+		}
+		options.Backpressure = policy
+	}
+	return options, paths, nil
+}
+
+// cleanPaths - Checks that the provided paths are absolute and cleans them
+func cleanPaths(paths []string) ([]string, error) {
+	var cleaned []string
+	for _, p := range paths {
+		if !filepath.IsAbs(p) {
+			return nil, errors.Errorf("path %s isn't absolute", p)
+		}
+		cleaned = append(cleaned, path.Clean(p))
+	}
+	return cleaned, nil
+}
+
+// watch - Watches the provided paths, or increments their reference count if they are already watched
+func (s *Server) watch(paths []string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for i, p := range paths {
+		if s.watches[p] == 0 {
+			if err := s.probe.Watch(p); err != nil {
+				s.unwatchLocked(paths[:i])
+				return errors.Wrapf(err, "couldn't watch %s", p)
+			}
+		}
+		s.watches[p]++
+	}
+	return nil
+}
+
+// watchExplicit - Watches the provided paths until they are released with unwatchExplicit
+func (s *Server) watchExplicit(paths []string) error {
+	if err := s.watch(paths); err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, p := range paths {
+		s.explicitWatches[p]++
+	}
+	return nil
+}
+
+// unwatchExplicit - Releases the paths watched with watchExplicit. The paths of the event streams can't be released.
+func (s *Server) unwatchExplicit(paths []string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	released := make(map[string]int)
+	for _, p := range paths {
+		if released[p]++; s.explicitWatches[p] < released[p] {
+			return errors.Errorf("%s isn't watched", p)
+		}
+	}
+	for _, p := range paths {
+		if s.explicitWatches[p]--; s.explicitWatches[p] == 0 {
+			delete(s.explicitWatches, p)
+		}
+	}
+	s.unwatchLocked(paths)
+	return nil
+}
+
+// unwatch - Decrements the reference count of the provided paths, and unwatches the paths that are no longer used
+func (s *Server) unwatch(paths []string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.unwatchLocked(paths)
+}
+
+// unwatchLocked - See unwatch, the caller must hold the lock
+func (s *Server) unwatchLocked(paths []string) {
+	for _, p := range paths {
+		if s.watches[p]--; s.watches[p] > 0 {
+			continue
+		}
+		delete(s.watches, p)
+		if err := s.probe.Unwatch(p); err != nil {
+			logrus.Warnf("couldn't unwatch %s: %v", p, err)
+		}
+	}
+}
+
+// writeError - Sends the reason why a subscription was rejected
+func writeError(conn net.Conn, err error) {
+	data, _ := json.Marshal(model.SubscriptionError{Error: err.Error()})
+	_, _ = conn.Write(append(data, '\n'))
+}
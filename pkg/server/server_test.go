@@ -0,0 +1,175 @@
+/*
+Copyright © 2020 GUILLAUME FOURNIER
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Gui774ume/fsprobe/pkg/fsprobe"
+	"github.com/Gui774ume/fsprobe/pkg/model"
+)
+
+// fakeProbe - Real FSProbe instance for the subscriptions, which records the watched paths instead of loading the
+// eBPF programs
+type fakeProbe struct {
+	*fsprobe.FSProbe
+	lock    sync.Mutex
+	watched map[string]int
+}
+
+func (fp *fakeProbe) Watch(paths ...string) error {
+	fp.lock.Lock()
+	defer fp.lock.Unlock()
+	for _, p := range paths {
+		fp.watched[p]++
+	}
+	return nil
+}
+
+func (fp *fakeProbe) Unwatch(paths ...string) error {
+	fp.lock.Lock()
+	defer fp.lock.Unlock()
+	for _, p := range paths {
+		delete(fp.watched, p)
+	}
+	return nil
+}
+
+func (fp *fakeProbe) watchedPaths() map[string]int {
+	fp.lock.Lock()
+	defer fp.lock.Unlock()
+	watched := make(map[string]int)
+	for p, count := range fp.watched {
+		watched[p] = count
+	}
+	return watched
+}
+
+func newTestServer(t *testing.T) (*Server, *fakeProbe, string) {
+	probe := &fakeProbe{
+		FSProbe: fsprobe.NewFSProbeWithOptions(model.FSProbeOptions{PathsFiltering: true}),
+		watched: make(map[string]int),
+	}
+	srv := NewServer(probe)
+	addr := filepath.Join(t.TempDir(), "fsprobe.sock")
+	listener, err := net.Listen("unix", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go srv.Serve(listener)
+	t.Cleanup(func() { srv.Close() })
+	return srv, probe, addr
+}
+
+// subscribe - Connects to the server and sends a subscription request
+func subscribe(t *testing.T, addr string, req model.SubscriptionRequest) (net.Conn, *bufio.Reader) {
+	conn, err := net.Dial("unix", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	data, _ := json.Marshal(req)
+	if _, err := conn.Write(append(data, '\n')); err != nil {
+		t.Fatal(err)
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	return conn, bufio.NewReader(conn)
+}
+
+// waitFor - Waits until the condition is true
+func waitFor(t *testing.T, condition func() bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("timeout")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestServerStreamsMatchingEvents(t *testing.T) {
+	srv, probe, addr := newTestServer(t)
+	_, reader := subscribe(t, addr, model.SubscriptionRequest{
+		Paths:  []string{"/tmp/"},
+		Events: []model.EventName{model.Open},
+		Filter: "uid != 0",
+	})
+	waitFor(t, func() bool { return srv.Watches()["/tmp"] == 1 })
+
+	for _, evt := range []*model.FSEvent{
+		{EventType: model.Open, UID: 1000, SrcFilename: "/etc/passwd"},
+		{EventType: model.Mkdir, UID: 1000, SrcFilename: "/tmp/dir"},
+		{EventType: model.Open, UID: 0, SrcFilename: "/tmp/root"},
+		{EventType: model.Open, UID: 1000, SrcFilename: "/tmp/file"},
+	} {
+		probe.DispatchEvent(evt)
+	}
+	line, err := reader.ReadBytes('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+	var evt model.FSEvent
+	if err := json.Unmarshal(line, &evt); err != nil {
+		t.Fatal(err)
+	}
+	if evt.SrcFilename != "/tmp/file" {
+		t.Errorf("unexpected event: %s", line)
+	}
+}
+
+func TestServerReferenceCountsWatches(t *testing.T) {
+	srv, probe, addr := newTestServer(t)
+	first, _ := subscribe(t, addr, model.SubscriptionRequest{Paths: []string{"/tmp", "/etc"}})
+	subscribe(t, addr, model.SubscriptionRequest{Paths: []string{"/tmp"}})
+	waitFor(t, func() bool { return srv.Watches()["/tmp"] == 2 })
+	if watched := probe.watchedPaths(); !reflect.DeepEqual(watched, map[string]int{"/tmp": 1, "/etc": 1}) {
+		t.Errorf("each path should be watched once, got %v", watched)
+	}
+
+	first.Close()
+	waitFor(t, func() bool { return srv.Watches()["/tmp"] == 1 })
+	if watched := probe.watchedPaths(); !reflect.DeepEqual(watched, map[string]int{"/tmp": 1}) {
+		t.Errorf("/etc should be unwatched, got %v", watched)
+	}
+}
+
+func TestServerRejectsInvalidSubscriptions(t *testing.T) {
+	_, _, addr := newTestServer(t)
+	for _, req := range []model.SubscriptionRequest{
+		{},
+		{Paths: []string{"relative"}},
+		{Paths: []string{"/tmp"}, Events: []model.EventName{"chmod"}},
+		{Paths: []string{"/tmp"}, Filter: "uid =="},
+		{Paths: []string{"/tmp"}, Backpressure: "block"},
+	} {
+		_, reader := subscribe(t, addr, req)
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			t.Fatalf("%+v: %v", req, err)
+		}
+		var resp model.SubscriptionError
+		if err := json.Unmarshal(line, &resp); err != nil || resp.Error == "" {
+			t.Errorf("%+v: expected an error, got %s", req, line)
+		}
+	}
+}
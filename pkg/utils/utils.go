@@ -27,6 +27,8 @@ import (
 	"strconv"
 	"strings"
 	"unsafe"
+
+	"golang.org/x/sys/unix"
 )
 
 // GetPpid is a fallback to read the parent PID from /proc.
@@ -124,6 +126,135 @@ func GetCommFromPid(pid uint32) string {
 	return strings.Replace(string(raw), "\n", "", -1)
 }
 
+// GetExeFromPid - Returns the path of the executable of a process
+func GetExeFromPid(pid uint32) string {
+	exe, err := os.Readlink(fmt.Sprintf("/proc/%d/exe", pid))
+	if err != nil {
+		return ""
+	}
+	return exe
+}
+
+// GetCwdFromPid - Returns the current working directory of a process
+func GetCwdFromPid(pid uint32) string {
+	cwd, err := os.Readlink(fmt.Sprintf("/proc/%d/cwd", pid))
+	if err != nil {
+		return ""
+	}
+	return cwd
+}
+
+// GetArgvFromPid - Returns the command line arguments of a process
+func GetArgvFromPid(pid uint32) []string {
+	raw, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid))
+	if err != nil || len(raw) == 0 {
+		return nil
+	}
+	return SplitArgs(raw)
+}
+
+// GetLoginUIDFromPid - Returns the login UID (audit UID) of a process. 4294967295 means that the login UID isn't set.
+func GetLoginUIDFromPid(pid uint32) (uint32, error) {
+	raw, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/loginuid", pid))
+	if err != nil {
+		return 0, err
+	}
+	auid, err := strconv.ParseUint(strings.TrimSpace(string(raw)), 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(auid), nil
+}
+
+// GetCgroupPathFromPid - Returns the cgroup path of a process, read from /proc/<pid>/cgroup. The path of the unified
+// hierarchy (cgroup v2) is preferred, unified is set when it was found. Otherwise the path of the systemd hierarchy, or of
+// the first hierarchy, is returned.
+func GetCgroupPathFromPid(pid uint32) (path string, unified bool) {
+	raw, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/cgroup", pid))
+	if err != nil {
+		return "", false
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(raw)), "\n") {
+		// hierarchy-ID:controller-list:cgroup-path
+		fields := strings.SplitN(line, ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		switch {
+		case fields[0] == "0" && fields[1] == "":
+			return fields[2], true
+		case fields[1] == "name=systemd":
+			path = fields[2]
+		case path == "":
+			path = fields[2]
+		}
+	}
+	return path, false
+}
+
+// GetNsPidFromPid - Returns the PID of a process in its own pid namespace, 0 if the process exited
+func GetNsPidFromPid(pid uint32) uint32 {
+	return getNsID(fmt.Sprintf("/proc/%d/status", pid), "NStgid:")
+}
+
+// GetNsTidFromTid - Returns the TID of a thread in the pid namespace of its process, 0 if the thread exited
+func GetNsTidFromTid(pid uint32, tid uint32) uint32 {
+	return getNsID(fmt.Sprintf("/proc/%d/task/%d/status", pid, tid), "NSpid:")
+}
+
+// getNsID - Returns the last ID of the provided status field, i.e. the ID in the innermost pid namespace
+func getNsID(statusPath string, field string) uint32 {
+	raw, err := ioutil.ReadFile(statusPath)
+	if err != nil {
+		return 0
+	}
+	for _, line := range strings.Split(string(raw), "\n") {
+		if !strings.HasPrefix(line, field) {
+			continue
+		}
+		f := strings.Fields(line)
+		id, _ := strconv.ParseUint(f[len(f)-1], 10, 32)
+		return uint32(id)
+	}
+	return 0
+}
+
+// SplitArgs - Splits NULL separated arguments, as found in /proc/<pid>/cmdline
+func SplitArgs(raw []byte) []string {
+	raw = bytes.TrimRight(raw, "\x00")
+	if len(raw) == 0 {
+		return nil
+	}
+	return strings.Split(string(raw), "\x00")
+}
+
+// GetMountID - Returns the mount ID of the provided path, as reported by the kernel
+func GetMountID(path string) (uint32, error) {
+	var stat unix.Statx_t
+	err := unix.Statx(unix.AT_FDCWD, path, unix.AT_SYMLINK_NOFOLLOW, unix.STATX_MNT_ID, &stat)
+	if err == nil && stat.Mask&unix.STATX_MNT_ID == unix.STATX_MNT_ID {
+		return uint32(stat.Mnt_id), nil
+	}
+	// STATX_MNT_ID is only available on kernels 5.8+, fallback to name_to_handle_at
+	_, mountID, err := unix.NameToHandleAt(unix.AT_FDCWD, path, 0)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(mountID), nil
+}
+
+// GetCgroupID - Returns the ID of the cgroup v2 at the provided path, i.e. the inode number of its directory
+func GetCgroupID(path string) (uint64, error) {
+	var stat unix.Stat_t
+	if err := unix.Stat(path, &stat); err != nil {
+		return 0, err
+	}
+	if stat.Mode&unix.S_IFMT != unix.S_IFDIR {
+		return 0, fmt.Errorf("%s is not a cgroup directory", path)
+	}
+	return stat.Ino, nil
+}
+
 // InterfaceToBytes - Tranforms an interface into a C bytes array
 func InterfaceToBytes(data interface{}, byteOrder binary.ByteOrder) ([]byte, error) {
 	var buf bytes.Buffer